@@ -18,12 +18,13 @@ const (
 )
 
 type actorContextExtras struct {
-	children            PIDSet
-	receiveTimeoutTimer *time.Timer
-	rs                  *RestartStatistics
-	stash               *linkedliststack.Stack
-	watchers            PIDSet
-	context             Context
+	children               PIDSet
+	receiveTimeoutTimer    Timer
+	receiveTimeoutDeadline time.Time
+	rs                     *RestartStatistics
+	stash                  *linkedliststack.Stack
+	watchers               PIDSet
+	context                Context
 }
 
 func newActorContextExtras(context Context) *actorContextExtras {
@@ -42,7 +43,7 @@ func (ctxExt *actorContextExtras) restartStats() *RestartStatistics {
 	return ctxExt.rs
 }
 
-func (ctxExt *actorContextExtras) initReceiveTimeoutTimer(timer *time.Timer) {
+func (ctxExt *actorContextExtras) initReceiveTimeoutTimer(timer Timer) {
 	ctxExt.receiveTimeoutTimer = timer
 }
 
@@ -141,6 +142,17 @@ func (ctx *actorContext) ReceiveTimeout() time.Duration {
 	return ctx.receiveTimeout
 }
 
+func (ctx *actorContext) ReceiveTimeoutRemaining() time.Duration {
+	if ctx.receiveTimeout <= 0 || ctx.extras == nil {
+		return 0
+	}
+	remaining := ctx.extras.receiveTimeoutDeadline.Sub(ctx.props.getClock().Now())
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
 func (ctx *actorContext) Children() []*PID {
 	if ctx.extras == nil {
 		return make([]*PID, 0)
@@ -202,8 +214,9 @@ func (ctx *actorContext) SetReceiveTimeout(d time.Duration) {
 	ctx.ensureExtras()
 	ctx.extras.stopReceiveTimeoutTimer()
 	if d > 0 {
+		ctx.extras.receiveTimeoutDeadline = ctx.props.getClock().Now().Add(d)
 		if ctx.extras.receiveTimeoutTimer == nil {
-			ctx.extras.initReceiveTimeoutTimer(time.AfterFunc(d, ctx.receiveTimeoutHandler))
+			ctx.extras.initReceiveTimeoutTimer(ctx.props.getClock().AfterFunc(d, ctx.receiveTimeoutHandler))
 		} else {
 			ctx.extras.resetReceiveTimeoutTimer(d)
 		}
@@ -219,11 +232,10 @@ func (ctx *actorContext) CancelReceiveTimeout() {
 	ctx.receiveTimeout = 0
 }
 
+// receiveTimeoutHandler runs on the goroutine of the timer, the timeout is cancelled when the actor receives
+// the message
 func (ctx *actorContext) receiveTimeoutHandler() {
-	if ctx.extras != nil && ctx.extras.receiveTimeoutTimer != nil {
-		ctx.CancelReceiveTimeout()
-		ctx.Send(ctx.self, receiveTimeoutMessage)
-	}
+	ctx.Send(ctx.self, receiveTimeoutMessage)
 }
 
 func (ctx *actorContext) Forward(pid *PID) {
@@ -296,6 +308,7 @@ func (ctx *actorContext) RequestWithCustomSender(pid *PID, message interface{},
 
 func (ctx *actorContext) RequestFuture(pid *PID, message interface{}, timeout time.Duration) *Future {
 	future := NewFuture(timeout)
+	future.trackRequest(pid, message)
 	env := &MessageEnvelope{
 		Header:  nil,
 		Message: message,
@@ -417,12 +430,19 @@ func (ctx *actorContext) InvokeUserMessage(md interface{}) {
 		return
 	}
 
+	if UnwrapEnvelopeMessage(md) == receiveTimeoutMessage {
+		// the timeout expired, it stays off until set again
+		ctx.CancelReceiveTimeout()
+	}
+
 	influenceTimeout := true
 	if ctx.receiveTimeout > 0 {
-		_, influenceTimeout = md.(NotInfluenceReceiveTimeout)
+		_, influenceTimeout = UnwrapEnvelopeMessage(md).(NotInfluenceReceiveTimeout)
 		influenceTimeout = !influenceTimeout
 		if influenceTimeout {
+			// the timer restarts once the message is processed
 			ctx.extras.stopReceiveTimeoutTimer()
+			ctx.extras.receiveTimeoutDeadline = ctx.props.getClock().Now().Add(ctx.receiveTimeout)
 		}
 	}
 
@@ -430,6 +450,7 @@ func (ctx *actorContext) InvokeUserMessage(md interface{}) {
 
 	if ctx.receiveTimeout > 0 && influenceTimeout {
 		ctx.extras.resetReceiveTimeoutTimer(ctx.receiveTimeout)
+		ctx.extras.receiveTimeoutDeadline = ctx.props.getClock().Now().Add(ctx.receiveTimeout)
 	}
 }
 
@@ -451,6 +472,10 @@ func (ctx *actorContext) processMessage(m interface{}) {
 
 func (ctx *actorContext) incarnateActor() {
 	atomic.StoreInt32(&ctx.state, stateAlive)
+	if ctx.producer != nil {
+		ctx.actor = ctx.producer()
+		return
+	}
 	ctx.actor = ctx.props.producer()
 }
 
@@ -474,6 +499,10 @@ func (ctx *actorContext) InvokeSystemMessage(message interface{}) {
 		ctx.handleFailure(msg)
 	case *Restart:
 		ctx.handleRestart(msg)
+	case *diagnose:
+		msg.result <- ctx.diagnose()
+	case *swap:
+		ctx.handleSwap(msg)
 	default:
 		plog.Error("unknown system message", log.Message(msg))
 	}
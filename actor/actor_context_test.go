@@ -252,3 +252,48 @@ func TestActorContinueFutureInActor(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "done", res)
 }
+
+// taggingContext tags the messages sent by the actor
+type taggingContext struct {
+	Context
+}
+
+func (ctx *taggingContext) Send(pid *PID, message interface{}) {
+	ctx.Context.Send(pid, fmt.Sprintf("tagged %v", message))
+}
+
+func TestActorContext_ContextDecorator_ComposesWithSenderMiddleware(t *testing.T) {
+	received := make(chan interface{}, 1)
+	target := rootContext.Spawn(PropsFromFunc(func(ctx Context) {
+		if msg, ok := ctx.Message().(string); ok {
+			received <- msg
+		}
+	}))
+	defer rootContext.Stop(target)
+
+	pid := rootContext.Spawn(PropsFromFunc(func(ctx Context) {
+		if msg, ok := ctx.Message().(string); ok {
+			ctx.Send(target, msg)
+		}
+	}).
+		WithContextDecorator(func(next ContextDecoratorFunc) ContextDecoratorFunc {
+			return func(ctx Context) Context {
+				return next(&taggingContext{Context: ctx})
+			}
+		}).
+		WithSenderMiddleware(func(next SenderFunc) SenderFunc {
+			return func(ctx SenderContext, target *PID, envelope *MessageEnvelope) {
+				envelope.Message = fmt.Sprintf("%v via middleware", envelope.Message)
+				next(ctx, target, envelope)
+			}
+		}))
+	defer rootContext.Stop(pid)
+
+	rootContext.Send(pid, "hello")
+	select {
+	case msg := <-received:
+		assert.Equal(t, "tagged hello via middleware", msg)
+	case <-time.After(time.Second):
+		assert.Fail(t, "message should be received")
+	}
+}
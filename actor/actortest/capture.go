@@ -0,0 +1,198 @@
+package actortest
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+)
+
+// Direction tells if a captured message was sent or received
+type Direction int
+
+const (
+	Received Direction = iota
+	Sent
+)
+
+// CapturedMessage is a message recorded by a Capture
+type CapturedMessage struct {
+	Direction Direction
+	// Self is the actor which received or sent the message
+	Self *actor.PID
+	// Target is the receiver of a sent message
+	Target  *actor.PID
+	Sender  *actor.PID
+	Message interface{}
+	Header  map[string]string
+	Time    time.Time
+}
+
+// Capture records the messages passing its middleware, add Capture.ReceiverMiddleware and Capture.SenderMiddleware
+// to the props of the actors under test
+type Capture struct {
+	mu       sync.Mutex
+	messages []*CapturedMessage
+	// Timeout is the time the assertions wait for the expected messages, DefaultTimeout unless changed
+	Timeout time.Duration
+}
+
+// NewCapture returns an empty capture
+func NewCapture() *Capture {
+	return &Capture{Timeout: DefaultTimeout}
+}
+
+// ReceiverMiddleware records the messages received by the actor
+func (c *Capture) ReceiverMiddleware(next actor.ReceiverFunc) actor.ReceiverFunc {
+	return func(ctx actor.ReceiverContext, envelope *actor.MessageEnvelope) {
+		c.add(&CapturedMessage{
+			Direction: Received,
+			Self:      ctx.Self(),
+			Sender:    envelope.Sender,
+			Message:   envelope.Message,
+			Header:    envelope.Header.ToMap(),
+		})
+		next(ctx, envelope)
+	}
+}
+
+// SenderMiddleware records the messages sent by the actor
+func (c *Capture) SenderMiddleware(next actor.SenderFunc) actor.SenderFunc {
+	return func(ctx actor.SenderContext, target *actor.PID, envelope *actor.MessageEnvelope) {
+		c.add(&CapturedMessage{
+			Direction: Sent,
+			Self:      ctx.Self(),
+			Target:    target,
+			Sender:    envelope.Sender,
+			Message:   envelope.Message,
+			Header:    envelope.Header.ToMap(),
+		})
+		next(ctx, target, envelope)
+	}
+}
+
+func (c *Capture) add(msg *CapturedMessage) {
+	msg.Time = time.Now()
+	c.mu.Lock()
+	c.messages = append(c.messages, msg)
+	c.mu.Unlock()
+}
+
+// Messages returns the captured messages in the order they were captured
+func (c *Capture) Messages() []*CapturedMessage {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*CapturedMessage(nil), c.messages...)
+}
+
+// Where returns the captured messages for which fn returns true
+func (c *Capture) Where(fn func(msg *CapturedMessage) bool) []*CapturedMessage {
+	var res []*CapturedMessage
+	for _, msg := range c.Messages() {
+		if fn(msg) {
+			res = append(res, msg)
+		}
+	}
+	return res
+}
+
+// ReceivedBy returns the messages received by the actor, without the lifecycle messages
+func (c *Capture) ReceivedBy(pid *actor.PID) []interface{} {
+	return c.messagesOf(Received, pid)
+}
+
+// SentBy returns the messages sent by the actor
+func (c *Capture) SentBy(pid *actor.PID) []interface{} {
+	return c.messagesOf(Sent, pid)
+}
+
+// OfType returns the captured messages with the type of prototype, e.g. OfType((*MyMessage)(nil))
+func (c *Capture) OfType(prototype interface{}) []*CapturedMessage {
+	expected := reflect.TypeOf(prototype)
+	return c.Where(func(msg *CapturedMessage) bool {
+		return reflect.TypeOf(msg.Message) == expected
+	})
+}
+
+// Reset forgets the captured messages
+func (c *Capture) Reset() {
+	c.mu.Lock()
+	c.messages = nil
+	c.mu.Unlock()
+}
+
+// AssertReceivedInOrder waits until the actor has received the expected messages in the given order,
+// other messages may have been received in between
+func (c *Capture) AssertReceivedInOrder(t testing.TB, pid *actor.PID, expected ...interface{}) bool {
+	t.Helper()
+	return c.eventually(t, pid, expected, containsInOrder)
+}
+
+// AssertReceivedInAnyOrder waits until the actor has received the expected messages in any order
+func (c *Capture) AssertReceivedInAnyOrder(t testing.TB, pid *actor.PID, expected ...interface{}) bool {
+	t.Helper()
+	return c.eventually(t, pid, expected, containsInAnyOrder)
+}
+
+func (c *Capture) eventually(t testing.TB, pid *actor.PID, expected []interface{}, matches func(actual, expected []interface{}) bool) bool {
+	t.Helper()
+	deadline := time.Now().Add(c.Timeout)
+	for {
+		actual := c.ReceivedBy(pid)
+		if matches(actual, expected) {
+			return true
+		}
+		if time.Now().After(deadline) {
+			t.Errorf("%v did not receive %v within %v, received %v", pid, expected, c.Timeout, actual)
+			return false
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func (c *Capture) messagesOf(direction Direction, pid *actor.PID) []interface{} {
+	var res []interface{}
+	for _, msg := range c.Messages() {
+		if msg.Direction != direction || !msg.Self.Equal(pid) {
+			continue
+		}
+		if _, ok := msg.Message.(actor.SystemMessage); ok {
+			continue
+		}
+		if _, ok := msg.Message.(actor.AutoReceiveMessage); ok {
+			continue
+		}
+		res = append(res, msg.Message)
+	}
+	return res
+}
+
+func containsInOrder(actual, expected []interface{}) bool {
+	i := 0
+	for _, msg := range actual {
+		if i < len(expected) && reflect.DeepEqual(expected[i], msg) {
+			i++
+		}
+	}
+	return i == len(expected)
+}
+
+func containsInAnyOrder(actual, expected []interface{}) bool {
+	matched := make([]bool, len(actual))
+	for _, e := range expected {
+		found := false
+		for i, msg := range actual {
+			if !matched[i] && reflect.DeepEqual(e, msg) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,56 @@
+package actortest
+
+import (
+	"testing"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapture(t *testing.T) {
+	capture := NewCapture()
+	probe := NewTestProbe(t)
+	defer probe.Stop()
+
+	props := actor.PropsFromFunc(func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(*ping); ok {
+			ctx.Send(probe.PID(), &pong{n: msg.n})
+		}
+	}).
+		WithReceiverMiddleware(capture.ReceiverMiddleware).
+		WithSenderMiddleware(capture.SenderMiddleware)
+	pid := rootContext.Spawn(props)
+	defer rootContext.Stop(pid)
+
+	rootContext.Send(pid, &actor.MessageEnvelope{Header: map[string]string{"trace": "1"}, Message: &ping{n: 1}})
+	rootContext.Send(pid, &ping{n: 2})
+	rootContext.Send(pid, &ping{n: 3})
+	probe.ExpectMsg(&pong{n: 1})
+	probe.ExpectMsg(&pong{n: 2})
+	probe.ExpectMsg(&pong{n: 3})
+
+	capture.AssertReceivedInOrder(t, pid, &ping{n: 1}, &ping{n: 3})
+	capture.AssertReceivedInAnyOrder(t, pid, &ping{n: 3}, &ping{n: 1}, &ping{n: 2})
+	assert.Equal(t, []interface{}{&pong{n: 1}, &pong{n: 2}, &pong{n: 3}}, capture.SentBy(pid))
+
+	received := capture.OfType((*ping)(nil))
+	if assert.Len(t, received, 3) {
+		assert.Equal(t, "1", received[0].Header["trace"])
+		assert.False(t, received[0].Time.IsZero())
+	}
+	sent := capture.Where(func(msg *CapturedMessage) bool { return msg.Direction == Sent })
+	if assert.Len(t, sent, 3) {
+		assert.Equal(t, probe.PID(), sent[0].Target)
+	}
+
+	capture.Reset()
+	assert.Empty(t, capture.Messages())
+}
+
+func TestCapture_matching(t *testing.T) {
+	actual := []interface{}{1, 2, 3, 2}
+	assert.True(t, containsInOrder(actual, []interface{}{1, 3, 2}))
+	assert.False(t, containsInOrder(actual, []interface{}{3, 1}))
+	assert.True(t, containsInAnyOrder(actual, []interface{}{2, 2, 1}))
+	assert.False(t, containsInAnyOrder(actual, []interface{}{3, 3}))
+}
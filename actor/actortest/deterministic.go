@@ -0,0 +1,75 @@
+package actortest
+
+import (
+	"sync"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+)
+
+// DeterministicDispatcher runs the mailboxes one at a time, in the order they were scheduled, on the goroutine calling
+// Step or RunUntilIdle, so the interleaving of the actors is the same on every run
+type DeterministicDispatcher struct {
+	mu    sync.Mutex
+	queue []func()
+}
+
+// NewDeterministicDispatcher returns a dispatcher running nothing until Step or RunUntilIdle is called
+func NewDeterministicDispatcher() *DeterministicDispatcher {
+	return &DeterministicDispatcher{}
+}
+
+func (d *DeterministicDispatcher) Schedule(fn func()) {
+	d.mu.Lock()
+	d.queue = append(d.queue, fn)
+	d.mu.Unlock()
+}
+
+// Throughput is only a hint when to yield the goroutine, which does not change the order the mailboxes are run in
+func (d *DeterministicDispatcher) Throughput() int {
+	return 300
+}
+
+// Step runs the next scheduled mailbox until it is empty, it returns false if no mailbox is scheduled
+func (d *DeterministicDispatcher) Step() bool {
+	d.mu.Lock()
+	if len(d.queue) == 0 {
+		d.mu.Unlock()
+		return false
+	}
+	fn := d.queue[0]
+	d.queue = d.queue[1:]
+	d.mu.Unlock()
+	fn()
+	return true
+}
+
+// RunUntilIdle runs the scheduled mailboxes until no messages are left and returns the number of mailbox runs
+func (d *DeterministicDispatcher) RunUntilIdle() int {
+	steps := 0
+	for d.Step() {
+		steps++
+	}
+	return steps
+}
+
+// DeterministicSystem runs the actors spawned with its props on a DeterministicDispatcher, the children of these actors
+// have to be spawned with its props too. Futures are only completed while RunUntilIdle runs, so a test has to call
+// RunUntilIdle before waiting for the result of a request
+type DeterministicSystem struct {
+	*DeterministicDispatcher
+}
+
+// NewDeterministicSystem returns a system running nothing until Step or RunUntilIdle is called
+func NewDeterministicSystem() *DeterministicSystem {
+	return &DeterministicSystem{DeterministicDispatcher: NewDeterministicDispatcher()}
+}
+
+// Props returns a copy of the props running the actor on the dispatcher of the system
+func (s *DeterministicSystem) Props(props *actor.Props) *actor.Props {
+	return props.WithDispatcher(s.DeterministicDispatcher)
+}
+
+// Close runs the remaining mailboxes, so the actors stopped by the test terminate
+func (s *DeterministicSystem) Close() {
+	s.RunUntilIdle()
+}
@@ -0,0 +1,64 @@
+package actortest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeterministicSystem(t *testing.T) {
+	system := NewDeterministicSystem()
+	defer system.Close()
+
+	// every actor logs the message and forwards it to the other actors, the log has the same order on every run
+	var log []string
+	var pids []*actor.PID
+	for _, name := range []string{"a", "b", "c"} {
+		name := name
+		pids = append(pids, rootContext.Spawn(system.Props(actor.PropsFromFunc(func(ctx actor.Context) {
+			switch msg := ctx.Message().(type) {
+			case string:
+				ctx.Respond(name + msg)
+			case int:
+				log = append(log, name)
+				if msg > 0 {
+					for _, pid := range pids {
+						if pid != ctx.Self() {
+							ctx.Send(pid, msg-1)
+						}
+					}
+				}
+			}
+		}))))
+	}
+	system.RunUntilIdle()
+
+	rootContext.Send(pids[0], 2)
+	assert.Empty(t, log, "nothing runs until the system is pumped")
+	system.RunUntilIdle()
+	assert.Equal(t, []string{"a", "b", "c", "c", "a", "a", "b"}, log)
+
+	f := rootContext.RequestFuture(pids[1], "!", time.Second)
+	assert.True(t, system.Step())
+	assert.False(t, system.Step())
+	res, err := f.Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "b!", res)
+
+	// the actors spawned with other props are not affected
+	other := rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(string); ok {
+			ctx.Respond(msg)
+		}
+	}))
+	res, err = rootContext.RequestFuture(other, "?", time.Second).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "?", res)
+	rootContext.Stop(other)
+
+	for _, pid := range pids {
+		rootContext.Stop(pid)
+	}
+}
@@ -0,0 +1,168 @@
+// Package actortest contains helpers for testing actors
+package actortest
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+)
+
+var rootContext = actor.EmptyRootContext
+
+// DefaultTimeout is the time the expectations of a probe wait for a message
+const DefaultTimeout = 3 * time.Second
+
+type watch struct {
+	pid *actor.PID
+}
+
+// TestProbe is an actor recording the messages it receives, so tests can pass its PID as the target or the sender
+// of messages and assert on what the actors under test send to it
+type TestProbe struct {
+	t        testing.TB
+	pid      *actor.PID
+	messages chan *actor.MessageEnvelope
+	sender   *actor.PID
+	// Timeout is the time the expectations wait for a message, DefaultTimeout unless changed
+	Timeout time.Duration
+}
+
+// NewTestProbe spawns a probe, the expectations fail the test t
+func NewTestProbe(t testing.TB) *TestProbe {
+	p := &TestProbe{
+		t:        t,
+		messages: make(chan *actor.MessageEnvelope, 1000),
+		Timeout:  DefaultTimeout,
+	}
+	props := actor.PropsFromFunc(func(ctx actor.Context) {
+		switch msg := ctx.Message().(type) {
+		case *actor.Started, *actor.Stopping, *actor.Stopped, *actor.Restarting:
+		case *watch:
+			ctx.Watch(msg.pid)
+			ctx.Respond(msg)
+		default:
+			envelope := &actor.MessageEnvelope{Message: msg, Sender: ctx.Sender()}
+			if header := ctx.MessageHeader(); header != nil {
+				envelope.Header = header.ToMap()
+			}
+			p.messages <- envelope
+		}
+	})
+	p.pid = rootContext.Spawn(props)
+	return p
+}
+
+// PID returns the PID of the probe
+func (p *TestProbe) PID() *actor.PID {
+	return p.pid
+}
+
+// Sender returns the sender of the last message taken by an expectation
+func (p *TestProbe) Sender() *actor.PID {
+	return p.sender
+}
+
+// Send sends the message to the target with the probe as the sender
+func (p *TestProbe) Send(target *actor.PID, message interface{}) {
+	rootContext.RequestWithCustomSender(target, message, p.pid)
+}
+
+// Reply sends the message to the sender of the last message taken by an expectation
+func (p *TestProbe) Reply(message interface{}) {
+	if p.sender == nil {
+		p.t.Fatalf("no sender to reply %v to", message)
+		return
+	}
+	p.Send(p.sender, message)
+}
+
+// Watch makes the probe receive *actor.Terminated when the actor stops
+func (p *TestProbe) Watch(pid *actor.PID) {
+	rootContext.RequestFuture(p.pid, &watch{pid: pid}, p.Timeout).Wait()
+}
+
+// Stop stops the probe
+func (p *TestProbe) Stop() {
+	rootContext.StopFuture(p.pid).Wait()
+}
+
+// ExpectMsg waits for the next message and fails the test unless it equals expected
+func (p *TestProbe) ExpectMsg(expected interface{}) interface{} {
+	p.t.Helper()
+	msg, ok := p.receive(p.Timeout)
+	if !ok {
+		p.t.Fatalf("timeout after %v waiting for %v", p.Timeout, describe(expected))
+		return nil
+	}
+	if !reflect.DeepEqual(expected, msg) {
+		p.t.Fatalf("expected %v, received %v", describe(expected), describe(msg))
+	}
+	return msg
+}
+
+// ExpectMsgType waits for the next message and fails the test unless it has the type of prototype,
+// e.g. ExpectMsgType((*actor.Terminated)(nil))
+func (p *TestProbe) ExpectMsgType(prototype interface{}) interface{} {
+	p.t.Helper()
+	expected := reflect.TypeOf(prototype)
+	msg, ok := p.receive(p.Timeout)
+	if !ok {
+		p.t.Fatalf("timeout after %v waiting for a %v", p.Timeout, expected)
+		return nil
+	}
+	if reflect.TypeOf(msg) != expected {
+		p.t.Fatalf("expected a %v, received %v", expected, describe(msg))
+	}
+	return msg
+}
+
+// ExpectNoMsg fails the test if a message is received within the duration
+func (p *TestProbe) ExpectNoMsg(within time.Duration) {
+	p.t.Helper()
+	if msg, ok := p.receive(within); ok {
+		p.t.Fatalf("expected no message within %v, received %v", within, describe(msg))
+	}
+}
+
+// FishForMessage skips the messages until fn returns true for a message and returns that message.
+// It fails the test if no such message is received within the timeout of the probe
+func (p *TestProbe) FishForMessage(fn func(msg interface{}) bool) interface{} {
+	p.t.Helper()
+	deadline := time.Now().Add(p.Timeout)
+	for {
+		msg, ok := p.receive(time.Until(deadline))
+		if !ok {
+			p.t.Fatalf("timeout after %v fishing for a message", p.Timeout)
+			return nil
+		}
+		if fn(msg) {
+			return msg
+		}
+	}
+}
+
+// ReceiveEnvelope waits for the next message with its header and sender, it returns nil on timeout
+func (p *TestProbe) ReceiveEnvelope(timeout time.Duration) *actor.MessageEnvelope {
+	select {
+	case envelope := <-p.messages:
+		p.sender = envelope.Sender
+		return envelope
+	case <-time.After(timeout):
+		return nil
+	}
+}
+
+func (p *TestProbe) receive(timeout time.Duration) (interface{}, bool) {
+	envelope := p.ReceiveEnvelope(timeout)
+	if envelope == nil {
+		return nil, false
+	}
+	return envelope.Message, true
+}
+
+func describe(msg interface{}) string {
+	return fmt.Sprintf("%T %+v", msg, msg)
+}
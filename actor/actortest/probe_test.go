@@ -0,0 +1,70 @@
+package actortest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+)
+
+type ping struct{ n int }
+type pong struct{ n int }
+
+func TestProbe_RequestAndReply(t *testing.T) {
+	probe := NewTestProbe(t)
+	defer probe.Stop()
+
+	echo := rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(*ping); ok {
+			ctx.Respond(&pong{n: msg.n})
+		}
+	}))
+	defer rootContext.Stop(echo)
+
+	probe.Send(echo, &ping{n: 1})
+	probe.ExpectMsg(&pong{n: 1})
+
+	probe.Send(echo, &ping{n: 2})
+	res := probe.ExpectMsgType((*pong)(nil))
+	assert.Equal(t, 2, res.(*pong).n)
+	probe.ExpectNoMsg(10 * time.Millisecond)
+}
+
+func TestProbe_Reply(t *testing.T) {
+	probe := NewTestProbe(t)
+	defer probe.Stop()
+
+	f := rootContext.RequestFuture(probe.PID(), &ping{n: 1}, time.Second)
+	probe.ExpectMsg(&ping{n: 1})
+	probe.Reply(&pong{n: 1})
+	res, err := f.Result()
+	assert.NoError(t, err)
+	assert.Equal(t, &pong{n: 1}, res)
+}
+
+func TestProbe_FishForMessage(t *testing.T) {
+	probe := NewTestProbe(t)
+	defer probe.Stop()
+
+	for i := 0; i < 5; i++ {
+		rootContext.Send(probe.PID(), &ping{n: i})
+	}
+	msg := probe.FishForMessage(func(msg interface{}) bool {
+		return msg.(*ping).n == 3
+	})
+	assert.Equal(t, &ping{n: 3}, msg)
+	probe.ExpectMsg(&ping{n: 4})
+}
+
+func TestProbe_Watch(t *testing.T) {
+	probe := NewTestProbe(t)
+	defer probe.Stop()
+
+	pid := rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {}))
+	probe.Watch(pid)
+	rootContext.StopFuture(pid).Wait()
+
+	terminated := probe.ExpectMsgType((*actor.Terminated)(nil)).(*actor.Terminated)
+	assert.Equal(t, pid.Id, terminated.Who.Id)
+}
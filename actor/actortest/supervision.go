@@ -0,0 +1,129 @@
+package actortest
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+)
+
+// SupervisionProbe records the supervisor events published on the event stream, so tests can verify the
+// supervisor strategies applied to failing children
+type SupervisionProbe struct {
+	t            testing.TB
+	subscription *eventstream.Subscription
+	mu           sync.Mutex
+	events       []*actor.SupervisorEvent
+	// Timeout is the time the expectations wait for the supervisor events, DefaultTimeout unless changed
+	Timeout time.Duration
+}
+
+// NewSupervisionProbe subscribes a probe to the supervisor events, the expectations fail the test t
+func NewSupervisionProbe(t testing.TB) *SupervisionProbe {
+	p := &SupervisionProbe{t: t, Timeout: DefaultTimeout}
+	p.subscription = eventstream.Subscribe(func(evt interface{}) {
+		p.mu.Lock()
+		p.events = append(p.events, evt.(*actor.SupervisorEvent))
+		p.mu.Unlock()
+	}).WithPredicate(func(evt interface{}) bool {
+		_, ok := evt.(*actor.SupervisorEvent)
+		return ok
+	})
+	return p
+}
+
+// Stop unsubscribes the probe from the event stream
+func (p *SupervisionProbe) Stop() {
+	eventstream.Unsubscribe(p.subscription)
+}
+
+// Events returns the supervisor events of the child in the order they were published
+func (p *SupervisionProbe) Events(child *actor.PID) []*actor.SupervisorEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var res []*actor.SupervisorEvent
+	for _, evt := range p.events {
+		if evt.Child.Equal(child) {
+			res = append(res, evt)
+		}
+	}
+	return res
+}
+
+// Count returns the number of times the directive was applied to the child
+func (p *SupervisionProbe) Count(child *actor.PID, directive actor.Directive) int {
+	n := 0
+	for _, evt := range p.Events(child) {
+		if evt.Directive == directive {
+			n++
+		}
+	}
+	return n
+}
+
+// ExpectRestarted waits until the child has been restarted the given number of times,
+// it fails the test if it is restarted more often
+func (p *SupervisionProbe) ExpectRestarted(child *actor.PID, times int) {
+	p.t.Helper()
+	p.expect(child, actor.RestartDirective, times)
+}
+
+// ExpectResumed waits until the child has been resumed
+func (p *SupervisionProbe) ExpectResumed(child *actor.PID) {
+	p.t.Helper()
+	p.expect(child, actor.ResumeDirective, 1)
+}
+
+// ExpectStopped waits until the child has been stopped by its supervisor,
+// either by the decider or because it exceeded the restarts of the strategy
+func (p *SupervisionProbe) ExpectStopped(child *actor.PID) {
+	p.t.Helper()
+	p.expect(child, actor.StopDirective, 1)
+}
+
+// ExpectEscalated waits until a failure escalated by the supervisor has been handled by its own supervisor.
+// Escalating supervisors do not publish events, the events are published for the supervisor by its parent
+func (p *SupervisionProbe) ExpectEscalated(supervisor *actor.PID) {
+	p.t.Helper()
+	deadline := time.Now().Add(p.Timeout)
+	for len(p.Events(supervisor)) == 0 {
+		if time.Now().After(deadline) {
+			p.t.Fatalf("no failure of %v escalated within %v", supervisor, p.Timeout)
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// ExpectNoFailure fails the test if the supervisor of the child applies a directive within the duration,
+// the directives applied before it was called are ignored
+func (p *SupervisionProbe) ExpectNoFailure(child *actor.PID, within time.Duration) {
+	p.t.Helper()
+	before := len(p.Events(child))
+	time.Sleep(within)
+	if events := p.Events(child); len(events) > before {
+		p.t.Fatalf("expected no failure of %v within %v, %v was applied", child, within, events[before].Directive)
+	}
+}
+
+func (p *SupervisionProbe) expect(child *actor.PID, directive actor.Directive, times int) {
+	p.t.Helper()
+	deadline := time.Now().Add(p.Timeout)
+	for {
+		n := p.Count(child, directive)
+		if n > times {
+			p.t.Fatalf("expected %v %v %v times, it was applied %v times", child, directive, times, n)
+			return
+		}
+		if n == times {
+			return
+		}
+		if time.Now().After(deadline) {
+			p.t.Fatalf("expected %v %v %v times within %v, it was applied %v times", child, directive, times, p.Timeout, n)
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
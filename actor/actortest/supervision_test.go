@@ -0,0 +1,72 @@
+package actortest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+)
+
+type fail struct {
+	directive actor.Directive
+}
+
+// spawnSupervised spawns a parent with the strategy and returns the PID of its child, which panics on *fail
+func spawnSupervised(strategy actor.SupervisorStrategy) (parent, child *actor.PID) {
+	children := make(chan *actor.PID, 1)
+	parent = rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		if _, ok := ctx.Message().(*actor.Started); ok {
+			children <- ctx.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+				if msg, ok := ctx.Message().(*fail); ok {
+					panic(msg)
+				}
+			}))
+		}
+	}).WithSupervisor(strategy))
+	return parent, <-children
+}
+
+func decideFailure(reason interface{}) actor.Directive {
+	return reason.(*fail).directive
+}
+
+func TestSupervisionProbe_restart_and_resume(t *testing.T) {
+	probe := NewSupervisionProbe(t)
+	defer probe.Stop()
+	parent, child := spawnSupervised(actor.NewOneForOneStrategy(10, time.Second, decideFailure))
+	defer rootContext.Stop(parent)
+
+	rootContext.Send(child, &fail{directive: actor.RestartDirective})
+	rootContext.Send(child, &fail{directive: actor.RestartDirective})
+	probe.ExpectRestarted(child, 2)
+
+	rootContext.Send(child, &fail{directive: actor.ResumeDirective})
+	probe.ExpectResumed(child)
+	probe.ExpectRestarted(child, 2)
+
+	// the failures before are not taken into account
+	probe.ExpectNoFailure(child, 10*time.Millisecond)
+}
+
+func TestSupervisionProbe_stop_after_max_restarts(t *testing.T) {
+	probe := NewSupervisionProbe(t)
+	defer probe.Stop()
+	parent, child := spawnSupervised(actor.NewOneForOneStrategy(1, time.Second, decideFailure))
+	defer rootContext.Stop(parent)
+
+	rootContext.Send(child, &fail{directive: actor.RestartDirective})
+	rootContext.Send(child, &fail{directive: actor.RestartDirective})
+	probe.ExpectRestarted(child, 1)
+	probe.ExpectStopped(child)
+}
+
+func TestSupervisionProbe_escalate(t *testing.T) {
+	probe := NewSupervisionProbe(t)
+	defer probe.Stop()
+	parent, child := spawnSupervised(actor.NewOneForOneStrategy(10, time.Second, decideFailure))
+	defer rootContext.Stop(parent)
+
+	probe.ExpectNoFailure(parent, 10*time.Millisecond)
+	rootContext.Send(child, &fail{directive: actor.EscalateDirective})
+	probe.ExpectEscalated(parent)
+}
@@ -0,0 +1,122 @@
+package actortest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+)
+
+// VirtualClock is an actor.Clock whose time only moves when Advance is called, so tests of timers run instantly and
+// deterministically. Pass it to Props.WithClock for the receive timeout and to scheduler.WithClock for the scheduler
+type VirtualClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	seq    uint64
+	timers []*virtualTimer
+}
+
+type virtualTimer struct {
+	clock  *VirtualClock
+	at     time.Time
+	seq    uint64
+	fn     func()
+	active bool
+}
+
+// NewVirtualClock returns a clock starting at the given time
+func NewVirtualClock(start time.Time) *VirtualClock {
+	return &VirtualClock{now: start}
+}
+
+// Now returns the virtual time
+func (c *VirtualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// AfterFunc calls fn once the clock has been advanced by the duration
+func (c *VirtualClock) AfterFunc(d time.Duration, fn func()) actor.Timer {
+	t := &virtualTimer{clock: c, fn: fn}
+	t.Reset(d)
+	return t
+}
+
+// Advance moves the time forward by the duration, calling the functions of the timers due in the order of their
+// due time. The functions are called on the calling goroutine, timers they start are called too if they are due
+func (c *VirtualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	target := c.now.Add(d)
+	c.mu.Unlock()
+	for {
+		c.mu.Lock()
+		next := c.nextDue(target)
+		if next == nil {
+			c.now = target
+			c.mu.Unlock()
+			return
+		}
+		c.now = next.at
+		next.active = false
+		c.mu.Unlock()
+		next.fn()
+	}
+}
+
+// Pending returns the number of timers which have not fired or been stopped
+func (c *VirtualClock) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.compact()
+	return len(c.timers)
+}
+
+// nextDue returns the earliest active timer due at target, timers due at the same time in the order they were started
+func (c *VirtualClock) nextDue(target time.Time) *virtualTimer {
+	c.compact()
+	var next *virtualTimer
+	for _, t := range c.timers {
+		if t.at.After(target) {
+			continue
+		}
+		if next == nil || t.at.Before(next.at) || t.at.Equal(next.at) && t.seq < next.seq {
+			next = t
+		}
+	}
+	return next
+}
+
+func (c *VirtualClock) compact() {
+	active := c.timers[:0]
+	for _, t := range c.timers {
+		if t.active {
+			active = append(active, t)
+		}
+	}
+	c.timers = active
+}
+
+func (t *virtualTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	wasActive := t.active
+	t.active = false
+	return wasActive
+}
+
+func (t *virtualTimer) Reset(d time.Duration) bool {
+	c := t.clock
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	wasActive := t.active
+	c.seq++
+	t.at = c.now.Add(d)
+	t.seq = c.seq
+	if !wasActive {
+		c.compact()
+		t.active = true
+		c.timers = append(c.timers, t)
+	}
+	return wasActive
+}
@@ -0,0 +1,117 @@
+package actortest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/scheduler"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVirtualClock_Advance(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewVirtualClock(start)
+	var fired []string
+	clock.AfterFunc(2*time.Second, func() { fired = append(fired, "b") })
+	clock.AfterFunc(time.Second, func() { fired = append(fired, "a") })
+	stopped := clock.AfterFunc(time.Second, func() { fired = append(fired, "stopped") })
+	assert.True(t, stopped.Stop())
+
+	clock.Advance(1500 * time.Millisecond)
+	assert.Equal(t, []string{"a"}, fired)
+	assert.Equal(t, start.Add(1500*time.Millisecond), clock.Now())
+	assert.Equal(t, 1, clock.Pending())
+
+	clock.Advance(time.Second)
+	assert.Equal(t, []string{"a", "b"}, fired)
+	assert.Equal(t, 0, clock.Pending())
+}
+
+func TestVirtualClock_Scheduler(t *testing.T) {
+	clock := NewVirtualClock(time.Now())
+	probe := NewTestProbe(t)
+	defer probe.Stop()
+
+	s := scheduler.NewTimerScheduler(scheduler.WithClock(clock))
+	cancel := s.SendRepeatedly(time.Minute, time.Hour, probe.PID(), "tick")
+
+	clock.Advance(59 * time.Second)
+	probe.ExpectNoMsg(10 * time.Millisecond)
+	clock.Advance(3 * time.Hour)
+	probe.ExpectMsg("tick")
+	probe.ExpectMsg("tick")
+	probe.ExpectMsg("tick")
+	probe.ExpectNoMsg(10 * time.Millisecond)
+
+	cancel()
+	clock.Advance(3 * time.Hour)
+	probe.ExpectNoMsg(10 * time.Millisecond)
+}
+
+func TestVirtualClock_ReceiveTimeout(t *testing.T) {
+	clock := NewVirtualClock(time.Now())
+	probe := NewTestProbe(t)
+	defer probe.Stop()
+
+	props := actor.PropsFromFunc(func(ctx actor.Context) {
+		switch ctx.Message().(type) {
+		case *actor.Started:
+			ctx.SetReceiveTimeout(time.Minute)
+		case *actor.ReceiveTimeout:
+			ctx.Send(probe.PID(), "timeout")
+		}
+	}).WithClock(clock)
+	pid := rootContext.Spawn(props)
+	defer rootContext.Stop(pid)
+
+	// wait for the actor to start
+	for clock.Pending() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	clock.Advance(30 * time.Second)
+	probe.ExpectNoMsg(10 * time.Millisecond)
+	clock.Advance(30 * time.Second)
+	probe.ExpectMsg("timeout")
+}
+
+type heartbeat struct{}
+
+func (*heartbeat) NotInfluenceReceiveTimeout() {}
+
+func TestVirtualClock_ReceiveTimeoutRemaining(t *testing.T) {
+	clock := NewVirtualClock(time.Now())
+	probe := NewTestProbe(t)
+	defer probe.Stop()
+
+	props := actor.PropsFromFunc(func(ctx actor.Context) {
+		switch ctx.Message().(type) {
+		case *actor.Started:
+			ctx.SetReceiveTimeout(time.Minute)
+		case *heartbeat, string:
+			ctx.Send(probe.PID(), ctx.ReceiveTimeoutRemaining())
+		case *actor.ReceiveTimeout:
+			ctx.Send(probe.PID(), "timeout")
+		}
+	}).WithClock(clock)
+	pid := rootContext.Spawn(props)
+	defer rootContext.Stop(pid)
+
+	for clock.Pending() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	clock.Advance(20 * time.Second)
+	rootContext.Send(pid, &actor.MessageEnvelope{Header: map[string]string{"k": "v"}, Message: &heartbeat{}})
+	probe.ExpectMsg(40 * time.Second)
+
+	// other messages reset the timer
+	rootContext.Send(pid, "ping")
+	probe.ExpectMsg(time.Minute)
+	clock.Advance(20 * time.Second)
+	rootContext.Send(pid, &heartbeat{})
+	probe.ExpectMsg(40 * time.Second)
+
+	// heartbeats do not keep the actor from timing out
+	clock.Advance(40 * time.Second)
+	probe.ExpectMsg("timeout")
+}
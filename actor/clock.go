@@ -0,0 +1,30 @@
+package actor
+
+import "time"
+
+// Clock is the source of time of the timers of actors, a virtual clock can replace it to test time based logic
+// without waiting
+type Clock interface {
+	Now() time.Time
+	// AfterFunc calls fn once the duration has elapsed, like time.AfterFunc
+	AfterFunc(d time.Duration, fn func()) Timer
+}
+
+// Timer is a timer started by a Clock, like time.Timer
+type Timer interface {
+	Stop() bool
+	Reset(d time.Duration) bool
+}
+
+// SystemClock is the Clock of the operating system
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) AfterFunc(d time.Duration, fn func()) Timer {
+	return time.AfterFunc(d, fn)
+}
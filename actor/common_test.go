@@ -52,6 +52,11 @@ func (m *mockContext) ReceiveTimeout() time.Duration {
 	return args.Get(0).(time.Duration)
 }
 
+func (m *mockContext) ReceiveTimeoutRemaining() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
 func (m *mockContext) Children() []*PID {
 	args := m.Called()
 	return args.Get(0).([]*PID)
@@ -61,6 +66,10 @@ func (m *mockContext) Respond(response interface{}) {
 	m.Called(response)
 }
 
+func (m *mockContext) RespondErr(err error) {
+	m.Called(err)
+}
+
 func (m *mockContext) Stash() {
 	m.Called()
 }
@@ -85,6 +94,10 @@ func (m *mockContext) Forward(pid *PID) {
 	m.Called()
 }
 
+func (m *mockContext) Unhandled() {
+	m.Called()
+}
+
 func (m *mockContext) AwaitFuture(f *Future, cont func(res interface{}, err error)) {
 	m.Called(f, cont)
 }
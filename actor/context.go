@@ -45,6 +45,10 @@ type basePart interface {
 	// ReceiveTimeout returns the current timeout
 	ReceiveTimeout() time.Duration
 
+	// ReceiveTimeoutRemaining returns the time left before the ReceiveTimeout message is sent, zero when no timeout
+	// is set. While a message resetting the timer is processed, it is the whole timeout
+	ReceiveTimeoutRemaining() time.Duration
+
 	// Returns a slice of the actors children
 	Children() []*PID
 
@@ -52,6 +56,10 @@ type basePart interface {
 	// If the Sender is nil, the actor will panic
 	Respond(response interface{})
 
+	// RespondErr responds a Reply carrying err to the current `Sender`, or an ErrorReply carrying the message of err
+	// when the `Sender` is remote, see ResultAs
+	RespondErr(err error)
+
 	// Stash stashes the current message on a stack for reprocessing when the actor restarts
 	Stash()
 
@@ -65,7 +73,7 @@ type basePart interface {
 	// A duration of less than 1ms will disable the inactivity timer.
 	//
 	// If a message is received before the duration d, the timer will be reset. If the message conforms to
-	// the NotInfluenceReceiveTimeout interface, the timer will not be reset
+	// the NotInfluenceReceiveTimeout interface, also when sent in a MessageEnvelope, the timer will not be reset
 	SetReceiveTimeout(d time.Duration)
 
 	CancelReceiveTimeout()
@@ -73,6 +81,10 @@ type basePart interface {
 	// Forward forwards current message to the given PID
 	Forward(pid *PID)
 
+	// Unhandled reports the current message as not handled by the actor, an UnhandledMessage event is published
+	// and the message is passed to the UnhandledHandler of the props, if any
+	Unhandled()
+
 	AwaitFuture(f *Future, continuation func(res interface{}, err error))
 }
 
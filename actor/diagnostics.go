@@ -0,0 +1,132 @@
+package actor
+
+import (
+	"reflect"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// ActorNode is a snapshot of a local actor and its children
+type ActorNode struct {
+	PID    *PID
+	Parent *PID
+	// ActorType is the type name of the actor, e.g. actor.ActorFunc for actors spawned with PropsFromFunc
+	ActorType string
+	// MailboxLength is the number of user messages waiting to be processed, -1 for mailboxes not counting them
+	MailboxLength int
+	Watchers      []*PID
+	Children      []*ActorNode
+	// Responding is false when the actor did not answer in time, e.g. because it is busy.
+	// Only the PID and the mailbox length of such an actor are known, it is listed as a root actor
+	Responding bool
+}
+
+// DiagnosticsValue takes snapshots of the local actors
+type DiagnosticsValue struct {
+	// Timeout is the time to wait for the actors to answer
+	Timeout time.Duration
+}
+
+// Diagnostics returns the diagnostics of the local actors
+func Diagnostics() *DiagnosticsValue {
+	return &DiagnosticsValue{Timeout: time.Second}
+}
+
+type diagnose struct {
+	result chan *ActorNode
+}
+
+func (*diagnose) SystemMessage() {}
+
+type userMessageCounter interface {
+	UserMessageCount() int
+}
+
+// Tree returns the live actors as a hierarchy, the actors without a live parent are the roots.
+// Every actor is asked for its state by a system message, so the snapshot is safe to take while the actors run
+func (d *DiagnosticsValue) Tree() []*ActorNode {
+	type pending struct {
+		node   *ActorNode
+		result chan *ActorNode
+	}
+	var requests []pending
+	for item := range ProcessRegistry.LocalPIDs.IterBuffered() {
+		proc, ok := item.Val.(*ActorProcess)
+		if !ok || atomic.LoadInt32(&proc.dead) == 1 {
+			continue
+		}
+		pid := &PID{Address: ProcessRegistry.Address, Id: item.Key}
+		result := make(chan *ActorNode, 1)
+		proc.SendSystemMessage(pid, &diagnose{result: result})
+
+		length := -1
+		if counter, ok := proc.mailbox.(userMessageCounter); ok {
+			length = counter.UserMessageCount()
+		}
+		requests = append(requests, pending{node: &ActorNode{PID: pid, MailboxLength: length}, result: result})
+	}
+
+	timeout := time.NewTimer(d.Timeout)
+	defer timeout.Stop()
+	expired := false
+	nodes := make(map[string]*ActorNode, len(requests))
+	for _, r := range requests {
+		node := r.node
+		var answer *ActorNode
+		if expired {
+			select {
+			case answer = <-r.result:
+			default:
+			}
+		} else {
+			select {
+			case answer = <-r.result:
+			case <-timeout.C:
+				expired = true
+			}
+		}
+		if answer != nil {
+			answer.MailboxLength = node.MailboxLength
+			node = answer
+		}
+		nodes[node.PID.Id] = node
+	}
+
+	var roots []*ActorNode
+	for _, node := range nodes {
+		if node.Parent != nil {
+			if parent, ok := nodes[node.Parent.Id]; ok && node.Parent.Address == node.PID.Address {
+				parent.Children = append(parent.Children, node)
+				continue
+			}
+		}
+		roots = append(roots, node)
+	}
+	for _, node := range nodes {
+		sortNodes(node.Children)
+	}
+	sortNodes(roots)
+	return roots
+}
+
+func sortNodes(nodes []*ActorNode) {
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].PID.Id < nodes[j].PID.Id })
+}
+
+func (ctx *actorContext) diagnose() *ActorNode {
+	node := &ActorNode{
+		PID:        ctx.self,
+		Parent:     ctx.parent,
+		Responding: true,
+	}
+	if ctx.actor != nil {
+		node.ActorType = reflect.TypeOf(ctx.actor).String()
+	}
+	if ctx.extras != nil {
+		ctx.extras.watchers.ForEach(func(_ int, pid PID) {
+			node.Watchers = append(node.Watchers, &pid)
+		})
+	}
+	return node
+}
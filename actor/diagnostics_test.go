@@ -0,0 +1,74 @@
+package actor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func findNode(nodes []*ActorNode, pid *PID) *ActorNode {
+	for _, node := range nodes {
+		if node.PID.Id == pid.Id {
+			return node
+		}
+	}
+	return nil
+}
+
+func TestDiagnostics_Tree(t *testing.T) {
+	var child *PID
+	started := make(chan struct{})
+	block := make(chan struct{})
+	parent := rootContext.Spawn(PropsFromFunc(func(ctx Context) {
+		if _, ok := ctx.Message().(*Started); ok {
+			child = ctx.Spawn(PropsFromFunc(nullReceive))
+			close(started)
+		}
+	}))
+	defer rootContext.Stop(parent)
+	<-started
+
+	watcher := rootContext.Spawn(PropsFromFunc(func(ctx Context) {
+		if _, ok := ctx.Message().(*Started); ok {
+			ctx.Watch(parent)
+		}
+	}))
+	defer rootContext.Stop(watcher)
+
+	busy := rootContext.Spawn(PropsFromFunc(func(ctx Context) {
+		if _, ok := ctx.Message().(string); ok {
+			<-block
+		}
+	}))
+	defer rootContext.Stop(busy)
+	rootContext.Send(busy, "block")
+	rootContext.Send(busy, "queued")
+	defer close(block)
+	time.Sleep(10 * time.Millisecond)
+
+	diagnostics := Diagnostics()
+	diagnostics.Timeout = 50 * time.Millisecond
+	tree := diagnostics.Tree()
+
+	node := findNode(tree, parent)
+	if assert.NotNil(t, node) {
+		assert.True(t, node.Responding)
+		assert.Equal(t, "actor.ActorFunc", node.ActorType)
+		assert.Equal(t, 0, node.MailboxLength)
+		if assert.Len(t, node.Watchers, 1) {
+			assert.Equal(t, watcher.Id, node.Watchers[0].Id)
+		}
+		if assert.Len(t, node.Children, 1) {
+			assert.Equal(t, child.Id, node.Children[0].PID.Id)
+			assert.Equal(t, parent.Id, node.Children[0].Parent.Id)
+		}
+	}
+	assert.Nil(t, findNode(tree, child), "a child is not a root")
+
+	node = findNode(tree, busy)
+	if assert.NotNil(t, node) {
+		assert.False(t, node.Responding)
+		assert.Equal(t, 1, node.MailboxLength)
+	}
+}
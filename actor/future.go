@@ -24,6 +24,7 @@ func NewFuture(d time.Duration) *Future {
 	}
 
 	ref.pid = pid
+	ref.startDiagnostics(d)
 	if d >= 0 {
 		tp := time.AfterFunc(d, func() {
 			ref.cond.L.Lock()
@@ -51,6 +52,7 @@ type Future struct {
 	t           *time.Timer
 	pipes       []*PID
 	completions []func(res interface{}, err error)
+	diagnostics *FutureDiagnostics
 }
 
 // PID to the backing actor for the Future result
@@ -144,6 +146,7 @@ func (ref *futureProcess) Stop(pid *PID) {
 		tp.Stop()
 	}
 	ProcessRegistry.Remove(pid)
+	ref.stopDiagnostics()
 
 	ref.sendToPipes()
 	ref.runCompletions()
@@ -0,0 +1,108 @@
+package actor
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/log"
+)
+
+// FutureDiagnostics describes who is waiting on whom, it is only recorded while future diagnostics are enabled
+type FutureDiagnostics struct {
+	PID *PID
+	// Target is the receiver of the request, nil for futures not created by RequestFuture
+	Target *PID
+	// MessageType is the type name of the request
+	MessageType string
+	CreatedAt   time.Time
+	Timeout     time.Duration
+	// Stack is the stack trace of the creation of the future, only captured when enabled with captureStack
+	Stack string
+}
+
+var futureDiagnostics = &futureDiagnosticsValue{}
+
+type futureDiagnosticsValue struct {
+	enabled      int32
+	captureStack int32
+	outstanding  sync.Map
+}
+
+// EnableFutureDiagnostics records the target and the message of the futures created from now on, logs them on timeout
+// and lists them in OutstandingFutures until they complete. Capturing the stack is expensive, it is meant for debugging
+func EnableFutureDiagnostics(captureStack bool) {
+	var capture int32
+	if captureStack {
+		capture = 1
+	}
+	atomic.StoreInt32(&futureDiagnostics.captureStack, capture)
+	atomic.StoreInt32(&futureDiagnostics.enabled, 1)
+}
+
+// DisableFutureDiagnostics stops recording the futures created from now on
+func DisableFutureDiagnostics() {
+	atomic.StoreInt32(&futureDiagnostics.enabled, 0)
+}
+
+// OutstandingFutures returns the futures not completed yet, oldest first, to detect stuck requests
+func OutstandingFutures() []*FutureDiagnostics {
+	var res []*FutureDiagnostics
+	futureDiagnostics.outstanding.Range(func(_, value interface{}) bool {
+		res = append(res, value.(*Future).Diagnostics())
+		return true
+	})
+	sort.Slice(res, func(i, j int) bool { return res[i].CreatedAt.Before(res[j].CreatedAt) })
+	return res
+}
+
+// Diagnostics returns what is recorded about the future, nil if future diagnostics were disabled when it was created
+func (f *Future) Diagnostics() *FutureDiagnostics {
+	f.cond.L.Lock()
+	defer f.cond.L.Unlock()
+	if f.diagnostics == nil {
+		return nil
+	}
+	res := *f.diagnostics
+	return &res
+}
+
+func (f *Future) startDiagnostics(d time.Duration) {
+	if atomic.LoadInt32(&futureDiagnostics.enabled) == 0 {
+		return
+	}
+	f.diagnostics = &FutureDiagnostics{PID: f.pid, CreatedAt: time.Now(), Timeout: d}
+	if atomic.LoadInt32(&futureDiagnostics.captureStack) == 1 {
+		f.diagnostics.Stack = string(debug.Stack())
+	}
+	futureDiagnostics.outstanding.Store(f.pid.Id, f)
+}
+
+// trackRequest records the request the future waits for
+func (f *Future) trackRequest(target *PID, message interface{}) {
+	f.cond.L.Lock()
+	if f.diagnostics != nil {
+		f.diagnostics.Target = target
+		f.diagnostics.MessageType = fmt.Sprintf("%T", message)
+	}
+	f.cond.L.Unlock()
+}
+
+// stopDiagnostics may only be called with the lock of the future
+func (f *Future) stopDiagnostics() {
+	if f.diagnostics == nil {
+		return
+	}
+	futureDiagnostics.outstanding.Delete(f.pid.Id)
+	if f.err == ErrTimeout {
+		plog.Info("[ACTOR] Future timed out",
+			log.Stringer("future", f.pid),
+			log.Object("target", f.diagnostics.Target),
+			log.String("message", f.diagnostics.MessageType),
+			log.Duration("timeout", f.diagnostics.Timeout),
+			log.String("stack", f.diagnostics.Stack))
+	}
+}
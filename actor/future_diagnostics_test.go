@@ -0,0 +1,45 @@
+package actor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stuckRequest struct{}
+
+func TestFutureDiagnostics(t *testing.T) {
+	EnableFutureDiagnostics(true)
+	defer DisableFutureDiagnostics()
+
+	pid := rootContext.Spawn(PropsFromFunc(nullReceive))
+	defer rootContext.Stop(pid)
+
+	future := rootContext.RequestFuture(pid, &stuckRequest{}, 50*time.Millisecond)
+	diagnostics := future.Diagnostics()
+	if assert.NotNil(t, diagnostics) {
+		assert.Equal(t, pid, diagnostics.Target)
+		assert.Equal(t, "*actor.stuckRequest", diagnostics.MessageType)
+		assert.Contains(t, diagnostics.Stack, "TestFutureDiagnostics")
+	}
+
+	var outstanding *FutureDiagnostics
+	for _, d := range OutstandingFutures() {
+		if d.PID.Id == future.PID().Id {
+			outstanding = d
+		}
+	}
+	assert.NotNil(t, outstanding, "the future is outstanding until it completes")
+
+	assert.Equal(t, ErrTimeout, future.Wait())
+	for _, d := range OutstandingFutures() {
+		assert.NotEqual(t, future.PID().Id, d.PID.Id)
+	}
+}
+
+func TestFutureDiagnostics_disabled(t *testing.T) {
+	future := NewFuture(time.Second)
+	defer future.PID().ref().Stop(future.PID())
+	assert.Nil(t, future.Diagnostics())
+}
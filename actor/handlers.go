@@ -0,0 +1,85 @@
+package actor
+
+import "reflect"
+
+// Handlers is an actor dispatching the messages to handlers registered per message type with On,
+// instead of a type switch in Receive
+//
+//	func NewGreeter() actor.Actor {
+//		g := &greeter{}
+//		h := actor.NewHandlers()
+//		actor.On(h, g.hello)
+//		actor.On(h, g.goodbye)
+//		return h
+//	}
+type Handlers struct {
+	byType      map[reflect.Type]func(Context)
+	byInterface []interfaceHandler
+	otherwise   ActorFunc
+}
+
+// interfaceHandler handles the messages implementing an interface, they are matched in the order of registration
+type interfaceHandler struct {
+	matches func(message interface{}) bool
+	handle  func(Context)
+}
+
+// NewHandlers returns an actor without handlers, it ignores all messages until handlers are registered
+func NewHandlers() *Handlers {
+	return &Handlers{byType: make(map[reflect.Type]func(Context))}
+}
+
+// On registers fn to handle the messages of type T and returns h. T is the concrete type of the messages,
+// e.g. *Started or *MyMessage, or an interface handling the messages implementing it for which no concrete type
+// is registered. Registering a type again replaces its handler
+func On[T any](h *Handlers, fn func(ctx Context, message T)) *Handlers {
+	handle := func(ctx Context) {
+		fn(ctx, ctx.Message().(T))
+	}
+
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Interface {
+		h.byType[t] = handle
+		return h
+	}
+
+	h.byInterface = append(h.byInterface, interfaceHandler{
+		matches: func(message interface{}) bool {
+			_, ok := message.(T)
+			return ok
+		},
+		handle: handle,
+	})
+	return h
+}
+
+// Otherwise registers fn to handle the messages without handler and returns h.
+// Without it, the messages without handler other than the lifecycle messages are reported with Context.Unhandled
+func (h *Handlers) Otherwise(fn ActorFunc) *Handlers {
+	h.otherwise = fn
+	return h
+}
+
+// Receive dispatches the message to its handler
+func (h *Handlers) Receive(ctx Context) {
+	message := ctx.Message()
+	if handle, ok := h.byType[reflect.TypeOf(message)]; ok {
+		handle(ctx)
+		return
+	}
+	for _, handler := range h.byInterface {
+		if handler.matches(message) {
+			handler.handle(ctx)
+			return
+		}
+	}
+	if h.otherwise != nil {
+		h.otherwise(ctx)
+		return
+	}
+	switch message.(type) {
+	case SystemMessage, AutoReceiveMessage:
+	default:
+		ctx.Unhandled()
+	}
+}
@@ -0,0 +1,65 @@
+package actor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type greet struct {
+	name string
+}
+
+func TestHandlers_DispatchesByType(t *testing.T) {
+	started := make(chan bool, 1)
+	pid := rootContext.Spawn(PropsFromProducer(func() Actor {
+		h := NewHandlers()
+		On(h, func(ctx Context, msg *Started) { started <- true })
+		On(h, func(ctx Context, msg *greet) { ctx.Respond("hello " + msg.name) })
+		On(h, func(ctx Context, msg int) { ctx.Respond(msg * 2) })
+		return h.Otherwise(func(ctx Context) { ctx.Respond("unhandled") })
+	}))
+	defer rootContext.Stop(pid)
+
+	assert.True(t, <-started)
+	res, err := rootContext.RequestFuture(pid, &greet{name: "world"}, time.Second).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", res)
+
+	res, err = rootContext.RequestFuture(pid, 21, time.Second).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, 42, res)
+
+	res, err = rootContext.RequestFuture(pid, "ping", time.Second).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "unhandled", res)
+}
+
+func TestHandlers_DispatchesByInterface(t *testing.T) {
+	h := NewHandlers()
+	var handled []interface{}
+	On(h, func(ctx Context, msg SystemMessage) { handled = append(handled, "system") })
+	On(h, func(ctx Context, msg *Stop) { handled = append(handled, "stop") })
+
+	h.Receive(&actorContext{messageOrEnvelope: &Stop{}})
+	h.Receive(&actorContext{messageOrEnvelope: &Watch{}})
+	h.Receive(&actorContext{messageOrEnvelope: "ignored", props: &Props{}})
+	assert.Equal(t, []interface{}{"stop", "system"}, handled)
+}
+
+func TestHandlers_ReportsUnhandled(t *testing.T) {
+	unhandled := make(chan interface{}, 2)
+	pid := rootContext.Spawn(PropsFromProducer(func() Actor {
+		h := NewHandlers()
+		return On(h, func(ctx Context, msg int) {})
+	}).WithUnhandledHandler(func(ctx Context, message interface{}) {
+		unhandled <- message
+	}))
+	defer rootContext.Stop(pid)
+
+	rootContext.Send(pid, 1)
+	rootContext.Send(pid, "ping")
+	assert.Equal(t, "ping", <-unhandled)
+	assert.Empty(t, unhandled)
+}
@@ -0,0 +1,84 @@
+// Package deadline propagates the deadline of requests in a message header and drops the messages whose deadline
+// has passed, so actors do not work on requests whose callers have already timed out.
+//
+// Add SenderMiddleware and ReceiverMiddleware to the props of the actors, and request them with RequestFuture:
+//
+//	props := actor.PropsFromProducer(producer).
+//		WithSenderMiddleware(deadline.SenderMiddleware).
+//		WithReceiverMiddleware(deadline.ReceiverMiddleware)
+//	future := deadline.RequestFuture(rootContext, pid, &Request{}, time.Second)
+package deadline
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+)
+
+// HeaderKey is the message header holding the deadline, in nanoseconds since the Unix epoch
+const HeaderKey = "deadline"
+
+// Of returns the deadline in the header, ok is false if there is none
+func Of(header actor.ReadonlyMessageHeader) (deadline time.Time, ok bool) {
+	if header == nil {
+		return time.Time{}, false
+	}
+	value := header.Get(HeaderKey)
+	if value == "" {
+		return time.Time{}, false
+	}
+	nanos, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(0, nanos), true
+}
+
+// Envelope wraps the message with the deadline, send it as the message
+func Envelope(message interface{}, deadline time.Time) *actor.MessageEnvelope {
+	envelope := actor.WrapEnvelope(message)
+	envelope.SetHeader(HeaderKey, strconv.FormatInt(deadline.UnixNano(), 10))
+	return envelope
+}
+
+// RequestFuture requests the actor like actor.Context.RequestFuture, with the timeout of the future as the deadline
+func RequestFuture(ctx actor.SenderContext, pid *actor.PID, message interface{}, timeout time.Duration) *actor.Future {
+	future := actor.NewFuture(timeout)
+	envelope := Envelope(message, time.Now().Add(timeout))
+	envelope.Sender = future.PID()
+	ctx.Send(pid, envelope)
+	return future
+}
+
+// SenderMiddleware gives the messages sent while processing a message with a deadline that deadline,
+// so the responses and the messages sent or forwarded on behalf of a request expire with it.
+// Messages which already have a deadline keep theirs
+func SenderMiddleware(next actor.SenderFunc) actor.SenderFunc {
+	return func(ctx actor.SenderContext, target *actor.PID, envelope *actor.MessageEnvelope) {
+		if envelope.GetHeader(HeaderKey) == "" {
+			if header := ctx.MessageHeader(); header != nil {
+				if value := header.Get(HeaderKey); value != "" {
+					envelope.SetHeader(HeaderKey, value)
+				}
+			}
+		}
+		next(ctx, target, envelope)
+	}
+}
+
+// ReceiverMiddleware drops the messages whose deadline has passed, they are published as dead letters
+func ReceiverMiddleware(next actor.ReceiverFunc) actor.ReceiverFunc {
+	return func(ctx actor.ReceiverContext, envelope *actor.MessageEnvelope) {
+		if deadline, ok := Of(envelope.Header); ok && time.Now().After(deadline) {
+			eventstream.Publish(&actor.DeadLetterEvent{
+				PID:     ctx.Self(),
+				Message: envelope.Message,
+				Sender:  envelope.Sender,
+			})
+			return
+		}
+		next(ctx, envelope)
+	}
+}
@@ -0,0 +1,105 @@
+package deadline
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+	"github.com/stretchr/testify/assert"
+)
+
+var rootContext = actor.EmptyRootContext
+
+func TestOf(t *testing.T) {
+	deadline := time.Unix(0, time.Now().UnixNano())
+	got, ok := Of(Envelope("hello", deadline).Header)
+	assert.True(t, ok)
+	assert.True(t, deadline.Equal(got))
+
+	_, ok = Of(actor.WrapEnvelope("hello").Header)
+	assert.False(t, ok)
+	_, ok = Of(actor.EmptyMessageHeader)
+	assert.False(t, ok)
+}
+
+func TestReceiverMiddleware_drops_expired_messages(t *testing.T) {
+	deadLetters := make(chan *actor.DeadLetterEvent, 1)
+	sub := eventstream.Subscribe(func(evt interface{}) {
+		if e, ok := evt.(*actor.DeadLetterEvent); ok && e.Message == "expired" {
+			deadLetters <- e
+		}
+	})
+	defer eventstream.Unsubscribe(sub)
+
+	received := make(chan interface{}, 2)
+	pid := rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(string); ok {
+			received <- msg
+		}
+	}).WithReceiverMiddleware(ReceiverMiddleware))
+	defer rootContext.Stop(pid)
+
+	rootContext.Send(pid, Envelope("expired", time.Now().Add(-time.Second)))
+	rootContext.Send(pid, Envelope("pending", time.Now().Add(time.Minute)))
+	rootContext.Send(pid, "no deadline")
+
+	assert.Equal(t, "pending", <-received)
+	assert.Equal(t, "no deadline", <-received)
+	select {
+	case e := <-deadLetters:
+		assert.Equal(t, pid, e.PID)
+	case <-time.After(time.Second):
+		t.Fatal("expected a dead letter")
+	}
+}
+
+func TestSenderMiddleware_preserves_deadline(t *testing.T) {
+	headers := make(chan string, 2)
+	recorder := rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		if _, ok := ctx.Message().(string); ok {
+			headers <- ctx.MessageHeader().Get(HeaderKey)
+		}
+	}))
+	defer rootContext.Stop(recorder)
+
+	relay := rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		if _, ok := ctx.Message().(string); ok {
+			ctx.Send(recorder, "sent")
+			ctx.Forward(recorder)
+			ctx.Respond("done")
+		}
+	}).WithSenderMiddleware(SenderMiddleware))
+	defer rootContext.Stop(relay)
+
+	future := RequestFuture(rootContext, relay, "hello", time.Second)
+	res, err := future.Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "done", res)
+
+	deadline := <-headers
+	assert.NotEmpty(t, deadline)
+	assert.Equal(t, deadline, <-headers)
+}
+
+func TestSenderMiddleware_keeps_own_deadline(t *testing.T) {
+	headers := make(chan string, 1)
+	recorder := rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		if _, ok := ctx.Message().(string); ok {
+			headers <- ctx.MessageHeader().Get(HeaderKey)
+		}
+	}))
+	defer rootContext.Stop(recorder)
+
+	own := time.Now().Add(time.Hour)
+	relay := rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		if _, ok := ctx.Message().(string); ok {
+			ctx.Send(recorder, Envelope("sent", own))
+		}
+	}).WithSenderMiddleware(SenderMiddleware))
+	defer rootContext.Stop(relay)
+
+	rootContext.Send(relay, Envelope("hello", time.Now().Add(time.Minute)))
+	assert.Equal(t, strconv.FormatInt(own.UnixNano(), 10), <-headers)
+}
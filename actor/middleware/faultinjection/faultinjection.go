@@ -0,0 +1,151 @@
+// Package faultinjection drops, delays, duplicates and reorders the messages sent by actors,
+// to test the resilience of actors in integration tests
+package faultinjection
+
+import (
+	"math/rand"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+)
+
+// Rule is the faults injected into the messages it matches
+type Rule struct {
+	// Target matches the messages sent to the actor, all targets when nil
+	Target *actor.PID
+	// MessageType matches the messages with the type of the value, e.g. (*MyMessage)(nil), all messages when nil
+	MessageType interface{}
+	// DropRate is the probability of a message to be dropped
+	DropRate float64
+	// DuplicateRate is the probability of a message to be sent twice
+	DuplicateRate float64
+	// ReorderRate is the probability of a message to be held back and sent after the next message to the target,
+	// or after ReorderTimeout if there is no next message
+	ReorderRate float64
+	// Delay delays the messages, the delayed messages are sent from a timer
+	Delay time.Duration
+}
+
+func (r *Rule) matches(target *actor.PID, message interface{}) bool {
+	if r.Target != nil && (r.Target.Address != target.Address || r.Target.Id != target.Id) {
+		return false
+	}
+	return r.MessageType == nil || reflect.TypeOf(r.MessageType) == reflect.TypeOf(message)
+}
+
+// ReorderTimeout is the time a reordered message is held back at most
+const ReorderTimeout = 50 * time.Millisecond
+
+// Injector injects the faults of its rules into the messages sent through its middleware,
+// the rules can be changed while the actors run
+type Injector struct {
+	mu     sync.Mutex
+	rules  []*Rule
+	random *rand.Rand
+	held   map[string]*heldMessage
+}
+
+type heldMessage struct {
+	send  func()
+	timer *time.Timer
+}
+
+// New returns an injector without rules
+func New() *Injector {
+	return NewWithSeed(time.Now().UnixNano())
+}
+
+// NewWithSeed returns an injector taking its decisions from a random source with the seed, to reproduce a run
+func NewWithSeed(seed int64) *Injector {
+	return &Injector{
+		random: rand.New(rand.NewSource(seed)),
+		held:   make(map[string]*heldMessage),
+	}
+}
+
+// SetRules replaces the rules, the first rule matching a message applies
+func (i *Injector) SetRules(rules ...*Rule) {
+	i.mu.Lock()
+	i.rules = rules
+	i.mu.Unlock()
+}
+
+// Clear removes the rules, the held back messages are still sent
+func (i *Injector) Clear() {
+	i.SetRules()
+}
+
+// SenderMiddleware injects the faults into the messages sent by the actor
+func (i *Injector) SenderMiddleware(next actor.SenderFunc) actor.SenderFunc {
+	return func(ctx actor.SenderContext, target *actor.PID, envelope *actor.MessageEnvelope) {
+		send := func() { next(ctx, target, envelope) }
+		key := target.String()
+
+		i.mu.Lock()
+		previous := i.held[key]
+		delete(i.held, key)
+		rule := i.match(target, envelope.Message)
+		if rule == nil {
+			i.mu.Unlock()
+			send()
+			release(previous)
+			return
+		}
+		drop := i.random.Float64() < rule.DropRate
+		duplicate := i.random.Float64() < rule.DuplicateRate
+		reorder := previous == nil && i.random.Float64() < rule.ReorderRate
+		if !drop && reorder {
+			held := &heldMessage{send: send}
+			held.timer = time.AfterFunc(ReorderTimeout, func() {
+				i.mu.Lock()
+				current := i.held[key]
+				if current == held {
+					delete(i.held, key)
+				}
+				i.mu.Unlock()
+				if current == held {
+					held.send()
+				}
+			})
+			i.held[key] = held
+		}
+		i.mu.Unlock()
+
+		if !drop && !reorder {
+			deliver := send
+			if duplicate {
+				deliver = func() {
+					send()
+					send()
+				}
+			}
+			if rule.Delay > 0 {
+				time.AfterFunc(rule.Delay, deliver)
+			} else {
+				deliver()
+			}
+		}
+		release(previous)
+	}
+}
+
+// match returns the first rule matching the message, it may only be called with the lock
+func (i *Injector) match(target *actor.PID, message interface{}) *Rule {
+	for _, rule := range i.rules {
+		if rule.matches(target, message) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// release sends the message held back after the message sent after it, the message has been removed from the held
+// messages so its timer does not send it
+func release(held *heldMessage) {
+	if held != nil {
+		held.timer.Stop()
+		held.send()
+	}
+}
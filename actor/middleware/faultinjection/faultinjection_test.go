@@ -0,0 +1,78 @@
+package faultinjection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/actor/actortest"
+	"github.com/stretchr/testify/assert"
+)
+
+var rootContext = actor.EmptyRootContext
+
+type other struct{}
+
+// spawnSender spawns an actor sending the messages it receives to the probe through the injector
+func spawnSender(injector *Injector, probe *actortest.TestProbe) *actor.PID {
+	return rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		switch msg := ctx.Message().(type) {
+		case int, *other:
+			ctx.Send(probe.PID(), msg)
+		}
+	}).WithSenderMiddleware(injector.SenderMiddleware))
+}
+
+func TestInjector_drop_and_duplicate(t *testing.T) {
+	probe := actortest.NewTestProbe(t)
+	defer probe.Stop()
+	injector := NewWithSeed(1)
+	sender := spawnSender(injector, probe)
+	defer rootContext.Stop(sender)
+
+	injector.SetRules(
+		&Rule{MessageType: (*other)(nil), DuplicateRate: 1},
+		&Rule{Target: probe.PID(), DropRate: 1},
+	)
+	rootContext.Send(sender, 1)
+	rootContext.Send(sender, &other{})
+	probe.ExpectMsg(&other{})
+	probe.ExpectMsg(&other{})
+	probe.ExpectNoMsg(10 * time.Millisecond)
+
+	injector.Clear()
+	rootContext.Send(sender, 2)
+	probe.ExpectMsg(2)
+}
+
+func TestInjector_delay(t *testing.T) {
+	probe := actortest.NewTestProbe(t)
+	defer probe.Stop()
+	injector := NewWithSeed(1)
+	sender := spawnSender(injector, probe)
+	defer rootContext.Stop(sender)
+
+	injector.SetRules(&Rule{Delay: 50 * time.Millisecond})
+	start := time.Now()
+	rootContext.Send(sender, 1)
+	probe.ExpectMsg(1)
+	assert.True(t, time.Since(start) >= 50*time.Millisecond)
+}
+
+func TestInjector_reorder(t *testing.T) {
+	probe := actortest.NewTestProbe(t)
+	defer probe.Stop()
+	injector := NewWithSeed(1)
+	sender := spawnSender(injector, probe)
+	defer rootContext.Stop(sender)
+
+	injector.SetRules(&Rule{ReorderRate: 1})
+	rootContext.Send(sender, 1)
+	rootContext.Send(sender, 2)
+	probe.ExpectMsg(2)
+	probe.ExpectMsg(1)
+
+	// a held back message is sent on timeout when no other message follows
+	rootContext.Send(sender, 3)
+	probe.ExpectMsg(3)
+}
@@ -0,0 +1,153 @@
+// Package recorder records the messages delivered to actors and replays them into fresh actors,
+// to reproduce bugs depending on the order of messages offline
+package recorder
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/remote"
+)
+
+var rootContext = actor.EmptyRootContext
+
+// Record is a message delivered to a recorded actor, serialized with the remote serializers
+type Record struct {
+	// Seq orders the records of a recorder
+	Seq          uint64            `json:"seq"`
+	Target       *actor.PID        `json:"target"`
+	Sender       *actor.PID        `json:"sender,omitempty"`
+	Header       map[string]string `json:"header,omitempty"`
+	TypeName     string            `json:"typeName"`
+	SerializerID int32             `json:"serializerId"`
+	Data         []byte            `json:"data"`
+	Time         time.Time         `json:"time"`
+}
+
+// Recorder writes the messages delivered to the actors using its middleware as JSON lines,
+// add Recorder.ReceiverMiddleware to the props of the actors to record
+type Recorder struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+	seq     uint64
+	err     error
+}
+
+// New returns a recorder writing to w
+func New(w io.Writer) *Recorder {
+	return &Recorder{encoder: json.NewEncoder(w)}
+}
+
+// ReceiverMiddleware records the user messages received by the actor, before the actor processes them
+func (r *Recorder) ReceiverMiddleware(next actor.ReceiverFunc) actor.ReceiverFunc {
+	return func(ctx actor.ReceiverContext, envelope *actor.MessageEnvelope) {
+		switch envelope.Message.(type) {
+		case actor.SystemMessage, actor.AutoReceiveMessage:
+		default:
+			r.record(ctx.Self(), envelope)
+		}
+		next(ctx, envelope)
+	}
+}
+
+// Err returns the first error serializing or writing a message, the messages failing are not recorded
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+func (r *Recorder) record(self *actor.PID, envelope *actor.MessageEnvelope) {
+	serializerID := remote.SerializerIDFor(envelope.Message)
+	data, typeName, err := remote.Serialize(envelope.Message, serializerID)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err == nil {
+		r.seq++
+		err = r.encoder.Encode(&Record{
+			Seq:          r.seq,
+			Target:       self,
+			Sender:       envelope.Sender,
+			Header:       envelope.Header.ToMap(),
+			TypeName:     typeName,
+			SerializerID: serializerID,
+			Data:         data,
+			Time:         time.Now(),
+		})
+	}
+	if err != nil && r.err == nil {
+		r.err = err
+	}
+}
+
+// ReadRecords reads the records written by a recorder, in the order they were recorded
+func ReadRecords(reader io.Reader) ([]*Record, error) {
+	decoder := json.NewDecoder(reader)
+	var res []*Record
+	for {
+		record := &Record{}
+		err := decoder.Decode(record)
+		if err == io.EOF {
+			return res, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, record)
+	}
+}
+
+// RecordsOf returns the records of the messages delivered to the actor
+func RecordsOf(records []*Record, pid *actor.PID) []*Record {
+	var res []*Record
+	for _, record := range records {
+		if record.Target.Address == pid.Address && record.Target.Id == pid.Id {
+			res = append(res, record)
+		}
+	}
+	return res
+}
+
+// Replay spawns a fresh actor from props and sends it the recorded messages, see ReplayTo
+func Replay(props *actor.Props, records []*Record) (*actor.PID, error) {
+	messages, err := deserialize(records)
+	if err != nil {
+		return nil, err
+	}
+	pid := rootContext.Spawn(props)
+	send(pid, messages)
+	return pid, nil
+}
+
+// ReplayTo sends the recorded messages to the actor in their recorded order, with their headers.
+// The messages are sent without sender, so the replayed actor does not respond to the original senders
+func ReplayTo(pid *actor.PID, records []*Record) error {
+	messages, err := deserialize(records)
+	if err != nil {
+		return err
+	}
+	send(pid, messages)
+	return nil
+}
+
+func deserialize(records []*Record) ([]*actor.MessageEnvelope, error) {
+	res := make([]*actor.MessageEnvelope, len(records))
+	for i, record := range records {
+		message, err := remote.Deserialize(record.Data, record.TypeName, record.SerializerID)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = &actor.MessageEnvelope{Header: record.Header, Message: message}
+	}
+	return res, nil
+}
+
+func send(pid *actor.PID, messages []*actor.MessageEnvelope) {
+	for _, envelope := range messages {
+		rootContext.Send(pid, envelope)
+	}
+}
@@ -0,0 +1,51 @@
+package recorder
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/actor/actortest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	probe := actortest.NewTestProbe(t)
+	defer probe.Stop()
+	forward := func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(*actor.PID); ok {
+			ctx.Send(probe.PID(), msg.Id+ctx.MessageHeader().Get("h"))
+		}
+	}
+
+	buffer := &bytes.Buffer{}
+	recorder := New(buffer)
+	recorded := rootContext.Spawn(actor.PropsFromFunc(forward).WithReceiverMiddleware(recorder.ReceiverMiddleware))
+	other := rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) { ctx.Respond(true) }).WithReceiverMiddleware(recorder.ReceiverMiddleware))
+	defer rootContext.Stop(recorded)
+	defer rootContext.Stop(other)
+
+	rootContext.Send(recorded, &actor.PID{Id: "a"})
+	assert.NoError(t, rootContext.RequestFuture(other, &actor.PID{Id: "other"}, time.Second).Wait())
+	rootContext.Send(recorded, &actor.MessageEnvelope{Header: map[string]string{"h": "!"}, Message: &actor.PID{Id: "b"}})
+	rootContext.Send(recorded, "not serializable")
+	rootContext.Send(recorded, &actor.PID{Id: "c"})
+	probe.FishForMessage(func(msg interface{}) bool { return msg == "c" })
+	assert.Error(t, recorder.Err())
+
+	records, err := ReadRecords(buffer)
+	assert.NoError(t, err)
+	assert.Len(t, records, 4)
+	records = RecordsOf(records, recorded)
+	if assert.Len(t, records, 3) {
+		assert.True(t, records[0].Seq < records[1].Seq)
+	}
+
+	replayed, err := Replay(actor.PropsFromFunc(forward), records)
+	assert.NoError(t, err)
+	defer rootContext.Stop(replayed)
+	probe.ExpectMsg("a")
+	probe.ExpectMsg("b!")
+	probe.ExpectMsg("c")
+}
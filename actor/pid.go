@@ -1,6 +1,7 @@
 package actor
 
 import (
+	"errors"
 	//	"fmt"
 	//	"github.com/gogo/protobuf/jsonpb"
 	"strings"
@@ -56,6 +57,7 @@ func (pid *PID) key() string {
 	return pid.Address + "#" + pid.Id
 }
 
+// String formats the PID as "address/id", ParsePID parses it back
 func (pid *PID) String() string {
 	if pid == nil {
 		return "nil"
@@ -71,6 +73,19 @@ func NewPID(address, id string) *PID {
 	}
 }
 
+// ErrInvalidPID is returned by ParsePID for strings not formatted by PID.String
+var ErrInvalidPID = errors.New("pid: expected address/id")
+
+// ParsePID parses a PID formatted by PID.String, e.g. "127.0.0.1:8080/$1" or "nonhost/parent/child".
+// Addresses do not contain a slash, the id is the part after the first slash
+func ParsePID(s string) (*PID, error) {
+	i := strings.IndexByte(s, '/')
+	if i == -1 || i == len(s)-1 {
+		return nil, ErrInvalidPID
+	}
+	return NewPID(s[:i], s[i+1:]), nil
+}
+
 // NewLocalPID returns a new instance of the PID struct with the address preset
 func NewLocalPID(id string) *PID {
 	return &PID{
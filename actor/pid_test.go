@@ -40,3 +40,26 @@ func TestStopFuture(t *testing.T) {
 		assert.False(t, found)
 	}
 }
+
+func TestParsePID_RoundTripsString(t *testing.T) {
+	for _, pid := range []*PID{
+		NewPID("127.0.0.1:8080", "$1"),
+		NewPID("nonhost", "parent/child"),
+	} {
+		parsed, err := ParsePID(pid.String())
+		assert.NoError(t, err)
+		assert.True(t, pid.Equal(parsed))
+	}
+
+	for _, s := range []string{"", "nonhost", "nonhost/", "nil"} {
+		_, err := ParsePID(s)
+		assert.Equal(t, ErrInvalidPID, err, s)
+	}
+}
+
+func TestPID_Equal(t *testing.T) {
+	assert.True(t, NewPID("a", "b").Equal(NewPID("a", "b")))
+	assert.False(t, NewPID("a", "b").Equal(NewPID("a", "c")))
+	assert.False(t, NewPID("a", "b").Equal((*PID)(nil)))
+	assert.True(t, (*PID)(nil).Equal((*PID)(nil)))
+}
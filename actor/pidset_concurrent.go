@@ -0,0 +1,82 @@
+package actor
+
+import "sync"
+
+// ConcurrentPIDSet is a PIDSet safe for concurrent use, for sets shared between goroutines.
+// Actors keep using PIDSet for their own state
+type ConcurrentPIDSet struct {
+	mu  sync.RWMutex
+	set PIDSet
+}
+
+// NewConcurrentPIDSet returns a new ConcurrentPIDSet with the given pids.
+func NewConcurrentPIDSet(pids ...*PID) *ConcurrentPIDSet {
+	s := &ConcurrentPIDSet{}
+	for _, pid := range pids {
+		s.set.Add(pid)
+	}
+	return s
+}
+
+// Add adds the element v to the set and returns true unless it already existed
+func (p *ConcurrentPIDSet) Add(v *PID) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.set.Contains(v) {
+		return false
+	}
+	p.set.Add(v)
+	return true
+}
+
+// Remove removes v from the set and returns true if the element existed
+func (p *ConcurrentPIDSet) Remove(v *PID) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.set.Remove(v)
+}
+
+// Contains reports whether v is an element of the set
+func (p *ConcurrentPIDSet) Contains(v *PID) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.set.Contains(v)
+}
+
+// Len returns the number of elements in the set
+func (p *ConcurrentPIDSet) Len() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.set.Len()
+}
+
+// Clear removes all the elements in the set
+func (p *ConcurrentPIDSet) Clear() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.set.Clear()
+}
+
+// Empty reports whether the set is empty
+func (p *ConcurrentPIDSet) Empty() bool {
+	return p.Len() == 0
+}
+
+// Values returns all the elements of the set as a slice
+func (p *ConcurrentPIDSet) Values() []PID {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.set.Values()
+}
+
+// ForEach invokes f for every element of a snapshot of the set, f may modify the set
+func (p *ConcurrentPIDSet) ForEach(f func(i int, pid PID)) {
+	p.Snapshot().ForEach(f)
+}
+
+// Snapshot returns a copy of the set which is not safe for concurrent use
+func (p *ConcurrentPIDSet) Snapshot() *PIDSet {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.set.Clone()
+}
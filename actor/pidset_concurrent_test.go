@@ -0,0 +1,40 @@
+package actor
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentPIDSet_ConcurrentAddRemove(t *testing.T) {
+	s := NewConcurrentPIDSet()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				pid := NewLocalPID(strconv.Itoa(i*100 + j))
+				assert.True(t, s.Add(pid))
+				assert.False(t, s.Add(pid))
+				assert.True(t, s.Contains(pid))
+				if j%2 == 0 {
+					assert.True(t, s.Remove(pid))
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, 500, s.Len())
+	assert.Len(t, s.Values(), 500)
+
+	n := 0
+	s.ForEach(func(_ int, pid PID) {
+		s.Remove(&pid)
+		n++
+	})
+	assert.Equal(t, 500, n)
+	assert.True(t, s.Empty())
+}
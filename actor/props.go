@@ -44,7 +44,8 @@ var DefaultSpawner SpawnFunc = defaultSpawner
 // ErrNameExists is the error used when an existing name is used for spawning an actor.
 var ErrNameExists = errors.New("spawn: name exists")
 
-// Props represents configuration to define how an actor should be created
+// Props represents configuration to define how an actor should be created.
+// Props are immutable, the With methods return modified copies so props can be shared
 type Props struct {
 	spawner                 SpawnFunc
 	producer                Producer
@@ -60,6 +61,8 @@ type Props struct {
 	spawnMiddlewareChain    SpawnFunc
 	contextDecorator        []ContextDecorator
 	contextDecoratorChain   ContextDecoratorFunc
+	clock                   Clock
+	unhandledHandler        UnhandledHandler
 }
 
 func (props *Props) getSpawner() SpawnFunc {
@@ -90,6 +93,13 @@ func (props *Props) getContextDecoratorChain() ContextDecoratorFunc {
 	return props.contextDecoratorChain
 }
 
+func (props *Props) getClock() Clock {
+	if props.clock == nil {
+		return SystemClock
+	}
+	return props.clock
+}
+
 func (props *Props) produceMailbox() mailbox.Mailbox {
 	if props.mailboxProducer == nil {
 		return defaultMailboxProducer()
@@ -101,102 +111,109 @@ func (props *Props) spawn(name string, parentContext SpawnerContext) (*PID, erro
 	return props.getSpawner()(name, props, parentContext)
 }
 
-// WithProducer assigns a actor producer to the props
+// Configure returns a copy of the props with the options applied, the props are not modified
+func (props *Props) Configure(opts ...PropsOption) *Props {
+	res := props.clone()
+	for _, opt := range opts {
+		opt(res)
+	}
+	return res
+}
+
+// clone copies the props, the slices are copied so appending to the copy does not modify the original
+func (props *Props) clone() *Props {
+	res := *props
+	res.receiverMiddleware = append([]ReceiverMiddleware(nil), props.receiverMiddleware...)
+	res.senderMiddleware = append([]SenderMiddleware(nil), props.senderMiddleware...)
+	res.spawnMiddleware = append([]SpawnMiddleware(nil), props.spawnMiddleware...)
+	res.contextDecorator = append(make([]ContextDecorator, 0, len(props.contextDecorator)), props.contextDecorator...)
+	return &res
+}
+
+// WithProducer returns a copy of the props with the actor producer assigned
 func (props *Props) WithProducer(p Producer) *Props {
-	props.producer = p
-	return props
+	return props.Configure(WithProducer(p))
 }
 
-// WithDispatcher assigns a dispatcher to the props
+// WithDispatcher returns a copy of the props with the dispatcher assigned
 func (props *Props) WithDispatcher(dispatcher mailbox.Dispatcher) *Props {
-	props.dispatcher = dispatcher
-	return props
+	return props.Configure(WithDispatcher(dispatcher))
 }
 
-// WithMailbox assigns the desired mailbox producer to the props
+// WithMailbox returns a copy of the props with the mailbox producer assigned
 func (props *Props) WithMailbox(mailbox mailbox.Producer) *Props {
-	props.mailboxProducer = mailbox
-	return props
+	return props.Configure(WithMailbox(mailbox))
 }
 
-// WithContextDecorator assigns context decorator to the props
+// WithContextDecorator returns a copy of the props with the context decorators added.
+// A decorator returns a Context wrapping the actor context, the actor receives the wrapper so it can intercept
+// Send, Request, Respond or Spawn of this actor. The messages sent through the wrapper still pass the sender middleware
 func (props *Props) WithContextDecorator(contextDecorator ...ContextDecorator) *Props {
-	props.contextDecorator = append(props.contextDecorator, contextDecorator...)
-
-	props.contextDecoratorChain = makeContextDecoratorChain(props.contextDecorator, func(ctx Context) Context {
-		return ctx
-	})
+	return props.Configure(WithContextDecorator(contextDecorator...))
+}
 
-	return props
+// WithClock returns a copy of the props with the clock of the receive timeout assigned
+func (props *Props) WithClock(clock Clock) *Props {
+	return props.Configure(WithClock(clock))
 }
 
-// WithGuardian assigns a guardian strategy to the props
+// WithGuardian returns a copy of the props with the guardian strategy assigned
 func (props *Props) WithGuardian(guardian SupervisorStrategy) *Props {
-	props.guardianStrategy = guardian
-	return props
+	return props.Configure(WithGuardian(guardian))
 }
 
-// WithSupervisor assigns a supervision strategy to the props
+// WithSupervisor returns a copy of the props with the supervision strategy assigned
 func (props *Props) WithSupervisor(supervisor SupervisorStrategy) *Props {
-	props.supervisionStrategy = supervisor
-	return props
+	return props.Configure(WithSupervisor(supervisor))
 }
 
-// Assign one or more middleware to the props
+// WithReceiverMiddleware returns a copy of the props with the receiver middleware added
 func (props *Props) WithReceiverMiddleware(middleware ...ReceiverMiddleware) *Props {
-	props.receiverMiddleware = append(props.receiverMiddleware, middleware...)
-
-	// Construct the receiver middleware chain with the final receiver at the end
-	props.receiverMiddlewareChain = makeReceiverMiddlewareChain(props.receiverMiddleware, func(ctx ReceiverContext, envelope *MessageEnvelope) {
-		ctx.Receive(envelope)
-	})
-
-	return props
+	return props.Configure(WithReceiverMiddleware(middleware...))
 }
 
+// WithSenderMiddleware returns a copy of the props with the sender middleware added
 func (props *Props) WithSenderMiddleware(middleware ...SenderMiddleware) *Props {
-	props.senderMiddleware = append(props.senderMiddleware, middleware...)
-
-	// Construct the sender middleware chain with the final sender at the end
-	props.senderMiddlewareChain = makeSenderMiddlewareChain(props.senderMiddleware, func(_ SenderContext, target *PID, envelope *MessageEnvelope) {
-		target.sendUserMessage(envelope)
-	})
-
-	return props
+	return props.Configure(WithSenderMiddleware(middleware...))
 }
 
-// WithSpawnFunc assigns a custom spawn func to the props, this is mainly for internal usage
+// WithSpawnFunc returns a copy of the props with the custom spawn func assigned, this is mainly for internal usage
 func (props *Props) WithSpawnFunc(spawn SpawnFunc) *Props {
-	props.spawner = spawn
-	return props
+	return props.Configure(WithSpawnFunc(spawn))
 }
 
-// WithFunc assigns a receive func to the props
+// WithFunc returns a copy of the props with the receive func assigned
 func (props *Props) WithFunc(f ActorFunc) *Props {
-	props.producer = func() Actor { return f }
-	return props
+	return props.Configure(WithFunc(f))
 }
 
+// WithSpawnMiddleware returns a copy of the props with the spawn middleware added
 func (props *Props) WithSpawnMiddleware(middleware ...SpawnMiddleware) *Props {
-	props.spawnMiddleware = append(props.spawnMiddleware, middleware...)
+	return props.Configure(WithSpawnMiddleware(middleware...))
+}
 
-	// Construct the spawner middleware chain with the final spawner at the end
-	props.spawnMiddlewareChain = makeSpawnMiddlewareChain(props.spawnMiddleware, func(id string, props *Props, parentContext SpawnerContext) (pid *PID, e error) {
-		if props.spawner == nil {
-			return defaultSpawner(id, props, parentContext)
-		}
-		return props.spawner(id, props, parentContext)
-	})
+// WithUnhandledHandler returns a copy of the props with the handler of the unhandled messages assigned
+func (props *Props) WithUnhandledHandler(handler UnhandledHandler) *Props {
+	return props.Configure(WithUnhandledHandler(handler))
+}
 
+// NewProps creates a props with the given actor producer and options
+//
+//	props := actor.NewProps(producer, actor.WithMailbox(mailbox.Bounded(100)), actor.WithSupervisor(strategy))
+func NewProps(producer Producer, opts ...PropsOption) *Props {
+	props := &Props{
+		producer:         producer,
+		contextDecorator: make([]ContextDecorator, 0),
+	}
+	for _, opt := range opts {
+		opt(props)
+	}
 	return props
 }
 
 // PropsFromProducer creates a props with the given actor producer assigned
 func PropsFromProducer(producer Producer) *Props {
-	return &Props{
-		producer:         producer,
-		contextDecorator: make([]ContextDecorator, 0),
-	}
+	return NewProps(producer)
 }
 
 // PropsFromFunc creates a props with the given receive func assigned as the actor producer
@@ -0,0 +1,119 @@
+package actor
+
+import "github.com/AsynkronIT/protoactor-go/mailbox"
+
+// PropsOption configures props, see NewProps and Props.Configure
+type PropsOption func(props *Props)
+
+// WithProducer assigns a actor producer to the props
+func WithProducer(p Producer) PropsOption {
+	return func(props *Props) {
+		props.producer = p
+	}
+}
+
+// WithDispatcher assigns a dispatcher to the props
+func WithDispatcher(dispatcher mailbox.Dispatcher) PropsOption {
+	return func(props *Props) {
+		props.dispatcher = dispatcher
+	}
+}
+
+// WithMailbox assigns the desired mailbox producer to the props
+func WithMailbox(mailbox mailbox.Producer) PropsOption {
+	return func(props *Props) {
+		props.mailboxProducer = mailbox
+	}
+}
+
+// WithContextDecorator adds context decorators to the props
+func WithContextDecorator(contextDecorator ...ContextDecorator) PropsOption {
+	return func(props *Props) {
+		props.contextDecorator = append(props.contextDecorator, contextDecorator...)
+
+		props.contextDecoratorChain = makeContextDecoratorChain(props.contextDecorator, func(ctx Context) Context {
+			return ctx
+		})
+	}
+}
+
+// WithClock assigns the clock of the receive timeout to the props
+func WithClock(clock Clock) PropsOption {
+	return func(props *Props) {
+		props.clock = clock
+	}
+}
+
+// WithGuardian assigns a guardian strategy to the props
+func WithGuardian(guardian SupervisorStrategy) PropsOption {
+	return func(props *Props) {
+		props.guardianStrategy = guardian
+	}
+}
+
+// WithSupervisor assigns a supervision strategy to the props
+func WithSupervisor(supervisor SupervisorStrategy) PropsOption {
+	return func(props *Props) {
+		props.supervisionStrategy = supervisor
+	}
+}
+
+// WithReceiverMiddleware adds receiver middleware to the props
+func WithReceiverMiddleware(middleware ...ReceiverMiddleware) PropsOption {
+	return func(props *Props) {
+		props.receiverMiddleware = append(props.receiverMiddleware, middleware...)
+
+		// Construct the receiver middleware chain with the final receiver at the end
+		props.receiverMiddlewareChain = makeReceiverMiddlewareChain(props.receiverMiddleware, func(ctx ReceiverContext, envelope *MessageEnvelope) {
+			ctx.Receive(envelope)
+		})
+	}
+}
+
+// WithSenderMiddleware adds sender middleware to the props
+func WithSenderMiddleware(middleware ...SenderMiddleware) PropsOption {
+	return func(props *Props) {
+		props.senderMiddleware = append(props.senderMiddleware, middleware...)
+
+		// Construct the sender middleware chain with the final sender at the end
+		props.senderMiddlewareChain = makeSenderMiddlewareChain(props.senderMiddleware, func(_ SenderContext, target *PID, envelope *MessageEnvelope) {
+			target.sendUserMessage(envelope)
+		})
+	}
+}
+
+// WithSpawnFunc assigns a custom spawn func to the props, this is mainly for internal usage
+func WithSpawnFunc(spawn SpawnFunc) PropsOption {
+	return func(props *Props) {
+		props.spawner = spawn
+	}
+}
+
+// WithFunc assigns a receive func to the props
+func WithFunc(f ActorFunc) PropsOption {
+	return func(props *Props) {
+		props.producer = func() Actor { return f }
+	}
+}
+
+// WithSpawnMiddleware adds spawn middleware to the props
+func WithSpawnMiddleware(middleware ...SpawnMiddleware) PropsOption {
+	return func(props *Props) {
+		props.spawnMiddleware = append(props.spawnMiddleware, middleware...)
+
+		// Construct the spawner middleware chain with the final spawner at the end
+		props.spawnMiddlewareChain = makeSpawnMiddlewareChain(props.spawnMiddleware, func(id string, props *Props, parentContext SpawnerContext) (pid *PID, e error) {
+			if props.spawner == nil {
+				return defaultSpawner(id, props, parentContext)
+			}
+			return props.spawner(id, props, parentContext)
+		})
+	}
+}
+
+// WithUnhandledHandler assigns the handler of the messages the actors report with Context.Unhandled
+func WithUnhandledHandler(handler UnhandledHandler) PropsOption {
+	return func(props *Props) {
+		props.unhandledHandler = handler
+	}
+}
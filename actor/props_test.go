@@ -0,0 +1,32 @@
+package actor
+
+import (
+	"testing"
+
+	"github.com/AsynkronIT/protoactor-go/mailbox"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProps_AppliesOptions(t *testing.T) {
+	dispatcher := mailbox.NewSynchronizedDispatcher(1)
+	strategy := NewRestartingStrategy()
+	props := NewProps(nullProducer, WithDispatcher(dispatcher), WithSupervisor(strategy))
+
+	assert.Equal(t, dispatcher, props.getDispatcher())
+	assert.Equal(t, strategy, props.getSupervisor())
+}
+
+func TestProps_WithReturnsCopies(t *testing.T) {
+	base := PropsFromProducer(nullProducer).WithReceiverMiddleware(func(next ReceiverFunc) ReceiverFunc { return next })
+	strategy := NewRestartingStrategy()
+
+	first := base.WithReceiverMiddleware(func(next ReceiverFunc) ReceiverFunc { return next })
+	second := base.WithReceiverMiddleware(func(next ReceiverFunc) ReceiverFunc { return next }).WithSupervisor(strategy)
+
+	assert.Len(t, base.receiverMiddleware, 1)
+	assert.Len(t, first.receiverMiddleware, 2)
+	assert.Len(t, second.receiverMiddleware, 2)
+	assert.Equal(t, defaultSupervisionStrategy, base.getSupervisor())
+	assert.Equal(t, defaultSupervisionStrategy, first.getSupervisor())
+	assert.Equal(t, strategy, second.getSupervisor())
+}
@@ -1,6 +1,5 @@
-// Code generated by protoc-gen-gogo.
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: protos.proto
-// DO NOT EDIT!
 
 /*
 Package actor is a generated protocol buffer package.
@@ -15,6 +14,7 @@ It has these top-level messages:
 	Unwatch
 	Terminated
 	Stop
+	ErrorReply
 */
 package actor
 
@@ -126,6 +126,22 @@ func (m *Stop) Reset()                    { *m = Stop{} }
 func (*Stop) ProtoMessage()               {}
 func (*Stop) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{5} }
 
+// ErrorReply is the serializable error responded by Context.RespondErr to a remote sender
+type ErrorReply struct {
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *ErrorReply) Reset()                    { *m = ErrorReply{} }
+func (*ErrorReply) ProtoMessage()               {}
+func (*ErrorReply) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{6} }
+
+func (m *ErrorReply) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*PID)(nil), "actor.PID")
 	proto.RegisterType((*PoisonPill)(nil), "actor.PoisonPill")
@@ -133,13 +149,11 @@ func init() {
 	proto.RegisterType((*Unwatch)(nil), "actor.Unwatch")
 	proto.RegisterType((*Terminated)(nil), "actor.Terminated")
 	proto.RegisterType((*Stop)(nil), "actor.Stop")
+	proto.RegisterType((*ErrorReply)(nil), "actor.ErrorReply")
 }
 func (this *PID) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	}
 
 	that1, ok := that.(*PID)
@@ -152,10 +166,7 @@ func (this *PID) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
@@ -169,10 +180,7 @@ func (this *PID) Equal(that interface{}) bool {
 }
 func (this *PoisonPill) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	}
 
 	that1, ok := that.(*PoisonPill)
@@ -185,10 +193,7 @@ func (this *PoisonPill) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
@@ -196,10 +201,7 @@ func (this *PoisonPill) Equal(that interface{}) bool {
 }
 func (this *Watch) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	}
 
 	that1, ok := that.(*Watch)
@@ -212,10 +214,7 @@ func (this *Watch) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
@@ -226,10 +225,7 @@ func (this *Watch) Equal(that interface{}) bool {
 }
 func (this *Unwatch) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	}
 
 	that1, ok := that.(*Unwatch)
@@ -242,10 +238,7 @@ func (this *Unwatch) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
@@ -256,10 +249,7 @@ func (this *Unwatch) Equal(that interface{}) bool {
 }
 func (this *Terminated) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	}
 
 	that1, ok := that.(*Terminated)
@@ -272,10 +262,7 @@ func (this *Terminated) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
@@ -289,10 +276,7 @@ func (this *Terminated) Equal(that interface{}) bool {
 }
 func (this *Stop) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	}
 
 	that1, ok := that.(*Stop)
@@ -305,13 +289,34 @@ func (this *Stop) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
+		return this == nil
+	} else if this == nil {
 		return false
+	}
+	return true
+}
+func (this *ErrorReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ErrorReply)
+	if !ok {
+		that2, ok := that.(ErrorReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
 	} else if this == nil {
 		return false
 	}
+	if this.Message != that1.Message {
+		return false
+	}
 	return true
 }
 func (m *PID) Marshal() (dAtA []byte, err error) {
@@ -474,24 +479,30 @@ func (m *Stop) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeFixed64Protos(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
+func (m *ErrorReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func encodeFixed32Protos(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
+
+func (m *ErrorReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	return i, nil
 }
+
 func encodeVarintProtos(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -560,6 +571,16 @@ func (m *Stop) Size() (n int) {
 	return n
 }
 
+func (m *ErrorReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovProtos(uint64(l))
+	}
+	return n
+}
+
 func sovProtos(x uint64) (n int) {
 	for {
 		n++
@@ -622,6 +643,16 @@ func (this *Stop) String() string {
 	}, "")
 	return s
 }
+func (this *ErrorReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ErrorReply{`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringProtos(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1107,6 +1138,85 @@ func (m *Stop) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ErrorReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProtos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProtos(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1215,7 +1325,7 @@ var (
 func init() { proto.RegisterFile("protos.proto", fileDescriptorProtos) }
 
 var fileDescriptorProtos = []byte{
-	// 284 bytes of a gzipped FileDescriptorProto
+	// 311 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x29, 0x28, 0xca, 0x2f,
 	0xc9, 0x2f, 0xd6, 0x03, 0x53, 0x42, 0xac, 0x89, 0xc9, 0x25, 0xf9, 0x45, 0x52, 0xba, 0xe9, 0x99,
 	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xe9, 0xf9, 0xe9, 0xf9, 0xfa, 0x60, 0xd9,
@@ -1229,9 +1339,11 @@ var fileDescriptorProtos = []byte{
 	0xf3, 0xca, 0x49, 0xd0, 0x10, 0xc9, 0xc5, 0x15, 0x92, 0x5a, 0x94, 0x9b, 0x99, 0x97, 0x58, 0x92,
 	0x9a, 0x22, 0x24, 0xc3, 0xc5, 0x5c, 0x9e, 0x91, 0x8f, 0x45, 0x3d, 0x48, 0x58, 0x48, 0x97, 0x4b,
 	0x08, 0xea, 0xfc, 0xf8, 0x12, 0xb8, 0x1e, 0xb0, 0x1f, 0x38, 0x82, 0x04, 0xa1, 0x32, 0x08, 0xc3,
-	0x94, 0xd8, 0xb8, 0x58, 0x82, 0x4b, 0xf2, 0x0b, 0x9c, 0x74, 0x2e, 0x3c, 0x94, 0x63, 0xb8, 0xf1,
-	0x50, 0x8e, 0xe1, 0xc3, 0x43, 0x39, 0x86, 0x86, 0x47, 0x72, 0x8c, 0x2b, 0x1e, 0xc9, 0x31, 0x9e,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x2f, 0x1e, 0xc9, 0x31,
-	0x7c, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0x43, 0x12, 0x1b, 0x38, 0x00, 0x8d, 0x01, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xcc, 0x73, 0xa7, 0xab, 0x86, 0x01, 0x00, 0x00,
+	0x94, 0xd8, 0xb8, 0x58, 0x82, 0x4b, 0xf2, 0x0b, 0x94, 0xd4, 0xb8, 0xb8, 0x5c, 0x8b, 0x8a, 0xf2,
+	0x8b, 0x82, 0x52, 0x0b, 0x72, 0x2a, 0x41, 0x41, 0x92, 0x9b, 0x5a, 0x5c, 0x9c, 0x98, 0x9e, 0x0a,
+	0x0b, 0x12, 0x28, 0xd7, 0x49, 0xe7, 0xc2, 0x43, 0x39, 0x86, 0x1b, 0x0f, 0xe5, 0x18, 0x3e, 0x3c,
+	0x94, 0x63, 0x68, 0x78, 0x24, 0xc7, 0xb8, 0xe2, 0x91, 0x1c, 0xe3, 0x89, 0x47, 0x72, 0x8c, 0x17,
+	0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0xf8, 0xe2, 0x91, 0x1c, 0xc3, 0x87, 0x47, 0x72, 0x8c,
+	0x13, 0x1e, 0xcb, 0x31, 0x24, 0xb1, 0x81, 0x03, 0xda, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x0b,
+	0x4e, 0x0a, 0x4d, 0xae, 0x01, 0x00, 0x00,
 }
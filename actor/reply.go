@@ -0,0 +1,66 @@
+package actor
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrUnexpectedResponse is returned by ResultAs when the response is not of the expected type
+var ErrUnexpectedResponse = errors.New("actor: unexpected response")
+
+// Reply is a response carrying either a value or an error, sent by Context.RespondErr to a local sender.
+// ResultAs and RequestAs unwrap it, so the requesting side gets the error returned.
+// Reply is not serializable, it must only be responded to local senders
+type Reply struct {
+	Value interface{}
+	Err   error
+}
+
+// Error returns the message of the error, so ResultAs returns the ErrorReply as the error of a remote request
+func (e *ErrorReply) Error() string {
+	return e.Message
+}
+
+func (ctx *actorContext) RespondErr(err error) {
+	sender := ctx.Sender()
+	if sender != nil && sender.Address != localAddress && sender.Address != ProcessRegistry.Address {
+		ctx.Respond(&ErrorReply{Message: err.Error()})
+		return
+	}
+	ctx.Respond(&Reply{Err: err})
+}
+
+// ResultAs waits for the result of the future and returns it as T. A Reply response is unwrapped into its value
+// or error and an ErrorReply response is returned as the error, other responses are returned as they are
+//
+//	user, err := actor.ResultAs[*User](ctx.RequestFuture(pid, &GetUser{ID: id}, time.Second))
+func ResultAs[T any](f *Future) (T, error) {
+	var zero T
+	res, err := f.Result()
+	if err != nil {
+		return zero, err
+	}
+	switch reply := res.(type) {
+	case *Reply:
+		if reply.Err != nil {
+			return zero, reply.Err
+		}
+		res = reply.Value
+	case *ErrorReply:
+		return zero, reply
+	}
+	if res == nil {
+		return zero, nil
+	}
+	value, ok := res.(T)
+	if !ok {
+		return zero, fmt.Errorf("%w: %T is not %T", ErrUnexpectedResponse, res, zero)
+	}
+	return value, nil
+}
+
+// RequestAs requests pid and returns the response as T, see ResultAs
+func RequestAs[T any](ctx SenderContext, pid *PID, message interface{}, timeout time.Duration) (T, error) {
+	return ResultAs[T](ctx.RequestFuture(pid, message, timeout))
+}
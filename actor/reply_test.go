@@ -0,0 +1,92 @@
+package actor
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+	"github.com/stretchr/testify/assert"
+)
+
+type getUser struct {
+	id int
+}
+
+type user struct {
+	name string
+}
+
+var errNoUser = errors.New("no such user")
+
+func TestRequestAs(t *testing.T) {
+	pid := rootContext.Spawn(PropsFromFunc(func(ctx Context) {
+		switch msg := ctx.Message().(type) {
+		case *getUser:
+			switch msg.id {
+			case 1:
+				ctx.Respond(&user{name: "alice"})
+			case 2:
+				ctx.Respond(&Reply{Value: &user{name: "bob"}})
+			case 3:
+				ctx.Respond(&Reply{})
+			case 4:
+				ctx.Respond("not a user")
+			default:
+				ctx.RespondErr(errNoUser)
+			}
+		}
+	}))
+	defer rootContext.Stop(pid)
+
+	u, err := RequestAs[*user](rootContext, pid, &getUser{id: 1}, time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", u.name)
+
+	u, err = RequestAs[*user](rootContext, pid, &getUser{id: 2}, time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, "bob", u.name)
+
+	u, err = RequestAs[*user](rootContext, pid, &getUser{id: 3}, time.Second)
+	assert.NoError(t, err)
+	assert.Nil(t, u)
+
+	_, err = RequestAs[*user](rootContext, pid, &getUser{id: 4}, time.Second)
+	assert.True(t, errors.Is(err, ErrUnexpectedResponse))
+
+	_, err = RequestAs[*user](rootContext, pid, &getUser{id: 5}, time.Second)
+	assert.Equal(t, errNoUser, err)
+
+	_, err = RequestAs[*user](rootContext, pid, "ignored", 10*time.Millisecond)
+	assert.Equal(t, ErrTimeout, err)
+}
+
+func TestRespondErr_RemoteSender(t *testing.T) {
+	responses := make(chan interface{}, 1)
+	sub := eventstream.Subscribe(func(msg interface{}) {
+		if deadLetter, ok := msg.(*DeadLetterEvent); ok && deadLetter.PID.Address == "remotehost:8000" {
+			responses <- deadLetter.Message
+		}
+	})
+	defer eventstream.Unsubscribe(sub)
+
+	pid := rootContext.Spawn(PropsFromFunc(func(ctx Context) {
+		if _, ok := ctx.Message().(*getUser); ok {
+			ctx.RespondErr(errNoUser)
+		}
+	}))
+	defer rootContext.Stop(pid)
+
+	// the error is responded as a serializable ErrorReply, which ResultAs returns as the error
+	rootContext.RequestWithCustomSender(pid, &getUser{id: 5}, NewPID("remotehost:8000", "requester"))
+	select {
+	case res := <-responses:
+		assert.Equal(t, &ErrorReply{Message: "no such user"}, res)
+		future := NewFuture(time.Second)
+		rootContext.Send(future.PID(), res)
+		_, err := ResultAs[*user](future)
+		assert.EqualError(t, err, "no such user")
+	case <-time.After(time.Second):
+		t.Fatal("no response")
+	}
+}
@@ -111,6 +111,7 @@ func (rc *RootContext) RequestWithCustomSender(pid *PID, message interface{}, se
 // RequestFuture sends a message to a given PID and returns a Future
 func (rc *RootContext) RequestFuture(pid *PID, message interface{}, timeout time.Duration) *Future {
 	future := NewFuture(timeout)
+	future.trackRequest(pid, message)
 	env := &MessageEnvelope{
 		Header:  nil,
 		Message: message,
@@ -0,0 +1,62 @@
+package actor
+
+import "sync/atomic"
+
+// BeforeSwap is sent to an actor before it is replaced by Swap or SwapAll, the messages in its mailbox are kept
+// for the actor replacing it
+type BeforeSwap struct{}
+
+// AfterSwap is the first message of an actor replacing another by Swap or SwapAll.
+// Previous is the replaced actor, so the new actor can take over its state
+type AfterSwap struct {
+	Previous Actor
+}
+
+func (*BeforeSwap) AutoReceiveMessage() {}
+func (*AfterSwap) AutoReceiveMessage()  {}
+
+var beforeSwapMessage interface{} = &BeforeSwap{}
+
+// swap is the system message replacing the actor of a context, only the contexts of props are swapped
+// when props is set
+type swap struct {
+	producer Producer
+	props    *Props
+}
+
+func (*swap) SystemMessage() {}
+
+// Swap replaces the actor of the local pid by an actor of producer. The actor receives BeforeSwap, then the new
+// actor receives AfterSwap and the messages of the mailbox. Restarts of the actor use producer from then on
+func Swap(pid *PID, producer Producer) {
+	pid.sendSystemMessage(&swap{producer: producer})
+}
+
+// SwapAll replaces the actors spawned from props, like Swap. The actors spawned from props afterwards still use
+// the producer of props, spawn them from props.WithProducer(producer)
+func SwapAll(props *Props, producer Producer) {
+	msg := &swap{producer: producer, props: props}
+	for item := range ProcessRegistry.LocalPIDs.IterBuffered() {
+		proc, ok := item.Val.(*ActorProcess)
+		if !ok || atomic.LoadInt32(&proc.dead) == 1 {
+			continue
+		}
+		proc.SendSystemMessage(&PID{Address: ProcessRegistry.Address, Id: item.Key}, msg)
+	}
+}
+
+func (ctx *actorContext) handleSwap(msg *swap) {
+	if msg.props != nil && msg.props != ctx.props {
+		return
+	}
+	ctx.producer = msg.producer
+	if atomic.LoadInt32(&ctx.state) != stateAlive {
+		// a restarting actor is incarnated from the new producer
+		return
+	}
+
+	ctx.InvokeUserMessage(beforeSwapMessage)
+	previous := ctx.actor
+	ctx.actor = msg.producer()
+	ctx.InvokeUserMessage(&AfterSwap{Previous: previous})
+}
@@ -0,0 +1,90 @@
+package actor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type swapCounter struct {
+	step     int
+	count    int
+	block    chan struct{}
+	received chan interface{}
+}
+
+func (c *swapCounter) Receive(ctx Context) {
+	switch msg := ctx.Message().(type) {
+	case *BeforeSwap:
+		c.received <- msg
+	case *AfterSwap:
+		c.count = msg.Previous.(*swapCounter).count
+		c.received <- msg
+	case string:
+		if c.block != nil {
+			<-c.block
+		}
+		c.count += c.step
+		c.received <- c.count
+	case *Restarting:
+		c.received <- msg
+	case error:
+		panic(msg)
+	}
+}
+
+func TestSwap_keeps_mailbox_and_state(t *testing.T) {
+	received := make(chan interface{}, 10)
+	block := make(chan struct{})
+	pid := rootContext.Spawn(PropsFromProducer(func() Actor {
+		return &swapCounter{step: 1, block: block, received: received}
+	}))
+	defer rootContext.Stop(pid)
+
+	rootContext.Send(pid, "first")
+	rootContext.Send(pid, "second")
+	time.Sleep(10 * time.Millisecond)
+	Swap(pid, func() Actor {
+		return &swapCounter{step: 10, received: received}
+	})
+	close(block)
+
+	assert.Equal(t, 1, <-received)
+	assert.IsType(t, &BeforeSwap{}, <-received)
+	assert.IsType(t, &AfterSwap{}, <-received)
+	assert.Equal(t, 11, <-received)
+
+	// the new producer is used on restart
+	rootContext.Send(pid, assert.AnError)
+	assert.IsType(t, &Restarting{}, <-received)
+	rootContext.Send(pid, "third")
+	assert.Equal(t, 10, <-received)
+}
+
+func TestSwapAll_swaps_the_actors_of_props(t *testing.T) {
+	received := make(chan interface{}, 10)
+	props := PropsFromProducer(func() Actor {
+		return &swapCounter{step: 1, received: received}
+	})
+	first := rootContext.Spawn(props)
+	defer rootContext.Stop(first)
+	second := rootContext.Spawn(props)
+	defer rootContext.Stop(second)
+	other := rootContext.Spawn(props.WithMailbox(nil))
+	defer rootContext.Stop(other)
+
+	SwapAll(props, func() Actor {
+		return &swapCounter{step: 10, received: received}
+	})
+	for i := 0; i < 4; i++ {
+		<-received
+	}
+
+	rootContext.Send(first, "hello")
+	assert.Equal(t, 10, <-received)
+	rootContext.Send(second, "hello")
+	assert.Equal(t, 10, <-received)
+	rootContext.Send(other, "hello")
+	assert.Equal(t, 1, <-received)
+}
@@ -0,0 +1,32 @@
+package actor
+
+import "github.com/AsynkronIT/protoactor-go/eventstream"
+
+// UnhandledMessage is published via event.Publish when an actor reports a message it does not handle with
+// Context.Unhandled. Unlike DeadLetterEvent the actor exists, it ignored the message
+type UnhandledMessage struct {
+	PID     *PID        // The actor which did not handle the message
+	Message interface{} // The message not handled
+	Sender  *PID        // the process that sent the Message
+}
+
+// UnhandledHandler handles the messages the actors do not handle, see Props.WithUnhandledHandler
+type UnhandledHandler func(ctx Context, message interface{})
+
+func (ctx *actorContext) Unhandled() {
+	message := ctx.Message()
+	eventstream.Publish(&UnhandledMessage{
+		PID:     ctx.self,
+		Message: message,
+		Sender:  ctx.Sender(),
+	})
+
+	if ctx.props.unhandledHandler == nil {
+		return
+	}
+	if ctx.props.contextDecoratorChain != nil {
+		ctx.props.unhandledHandler(ctx.ensureExtras().context, message)
+		return
+	}
+	ctx.props.unhandledHandler(ctx, message)
+}
@@ -0,0 +1,37 @@
+package actor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActorContext_Unhandled(t *testing.T) {
+	events := make(chan *UnhandledMessage, 1)
+	sub := eventstream.Subscribe(func(evt interface{}) {
+		if e, ok := evt.(*UnhandledMessage); ok {
+			events <- e
+		}
+	})
+	defer eventstream.Unsubscribe(sub)
+
+	pid := rootContext.Spawn(PropsFromFunc(func(ctx Context) {
+		if _, ok := ctx.Message().(string); ok {
+			ctx.Unhandled()
+		}
+	}).WithUnhandledHandler(func(ctx Context, message interface{}) {
+		ctx.Respond("unhandled " + message.(string))
+	}))
+	defer rootContext.Stop(pid)
+
+	res, err := rootContext.RequestFuture(pid, "ping", time.Second).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, "unhandled ping", res)
+
+	e := <-events
+	assert.Equal(t, pid, e.PID)
+	assert.Equal(t, "ping", e.Message)
+	assert.NotNil(t, e.Sender)
+}
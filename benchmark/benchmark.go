@@ -0,0 +1,295 @@
+// Package benchmark contains standardized throughput and latency benchmarks of actors, mailboxes and routers,
+// to compare mailbox and dispatcher changes or the configuration of an application reproducibly.
+//
+// The benchmarks can be run from code and reported with Report, or with go test -bench
+package benchmark
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"sort"
+	"strconv"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/mailbox"
+	"github.com/AsynkronIT/protoactor-go/router"
+)
+
+var rootContext = actor.EmptyRootContext
+
+// Config configures a benchmark run
+type Config struct {
+	// Messages is the number of messages every client sends
+	Messages int
+	// Clients is the number of clients sending messages concurrently
+	Clients int
+	// Window is the number of requests a client has in flight
+	Window int
+	// Routees is the number of routees of the router in FanOut
+	Routees int
+	// Mailbox produces the mailboxes of the actors, the default mailbox when nil
+	Mailbox mailbox.Producer
+	// Dispatcher runs the mailboxes of the actors, the default dispatcher when nil
+	Dispatcher mailbox.Dispatcher
+}
+
+// DefaultConfig returns a configuration running for about a second on a laptop
+func DefaultConfig() *Config {
+	return &Config{
+		Messages: 100000,
+		Clients:  runtime.NumCPU(),
+		Window:   100,
+		Routees:  runtime.NumCPU(),
+	}
+}
+
+func (c *Config) props(props *actor.Props) *actor.Props {
+	if c.Mailbox != nil {
+		props = props.WithMailbox(c.Mailbox)
+	}
+	if c.Dispatcher != nil {
+		props = props.WithDispatcher(c.Dispatcher)
+	}
+	return props
+}
+
+// Result is the outcome of a benchmark run
+type Result struct {
+	Name     string
+	Messages int
+	Elapsed  time.Duration
+	// Latency percentiles of the round trips, zero for benchmarks without responses
+	P50 time.Duration
+	P99 time.Duration
+	Max time.Duration
+}
+
+// Throughput returns the messages per second
+func (r *Result) Throughput() float64 {
+	return float64(r.Messages) / r.Elapsed.Seconds()
+}
+
+func (r *Result) String() string {
+	return fmt.Sprintf("%v: %v messages in %v, %.0f msg/s, latency p50 %v p99 %v max %v",
+		r.Name, r.Messages, r.Elapsed, r.Throughput(), r.P50, r.P99, r.Max)
+}
+
+// Report writes the results as a table
+func Report(w io.Writer, results ...*Result) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "Benchmark\tMessages\tElapsed\tMsg/s\tP50\tP99\tMax\t")
+	for _, r := range results {
+		fmt.Fprintf(tw, "%v\t%v\t%v\t%.0f\t%v\t%v\t%v\t\n",
+			r.Name, r.Messages, r.Elapsed.Round(time.Millisecond), r.Throughput(), r.P50, r.P99, r.Max)
+	}
+	return tw.Flush()
+}
+
+// ping is the message of the in process benchmarks
+type ping struct {
+	seq uint64
+}
+
+// codec puts the sequence number of a request into the message, so the latency is measured whatever the order
+// of the responses is
+type codec struct {
+	encode func(seq uint64) interface{}
+	decode func(msg interface{}) (uint64, bool)
+}
+
+var localCodec = codec{
+	encode: func(seq uint64) interface{} { return &ping{seq: seq} },
+	decode: func(msg interface{}) (uint64, bool) {
+		p, ok := msg.(*ping)
+		if !ok {
+			return 0, false
+		}
+		return p.seq, true
+	},
+}
+
+// remoteCodec uses a PID as the message, as it is serializable without registering messages
+var remoteCodec = codec{
+	encode: func(seq uint64) interface{} { return &actor.PID{Id: strconv.FormatUint(seq, 10)} },
+	decode: func(msg interface{}) (uint64, bool) {
+		p, ok := msg.(*actor.PID)
+		if !ok {
+			return 0, false
+		}
+		seq, err := strconv.ParseUint(p.Id, 10, 64)
+		return seq, err == nil
+	},
+}
+
+// EchoProps returns the props of the actor responding the requests of the benchmarks, spawn it on the remote node
+// of RemoteEcho
+func EchoProps() *actor.Props {
+	return actor.PropsFromFunc(echo)
+}
+
+func echo(ctx actor.Context) {
+	switch ctx.Message().(type) {
+	case *actor.Started, *actor.Stopping, *actor.Stopped, *actor.Restarting:
+	default:
+		ctx.Respond(ctx.Message())
+	}
+}
+
+type start struct{}
+
+// client sends its messages keeping a window of requests in flight and records the latency of every round trip
+type client struct {
+	target    *actor.PID
+	codec     codec
+	messages  int
+	window    int
+	seq       uint64
+	received  int
+	sentAt    map[uint64]time.Time
+	latencies []time.Duration
+	done      chan []time.Duration
+}
+
+func (state *client) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *start:
+		state.sentAt = make(map[uint64]time.Time, state.window)
+		state.latencies = make([]time.Duration, 0, state.messages)
+		for i := 0; i < state.window; i++ {
+			state.send(ctx)
+		}
+	default:
+		seq, ok := state.codec.decode(msg)
+		if !ok {
+			return
+		}
+		state.latencies = append(state.latencies, time.Since(state.sentAt[seq]))
+		delete(state.sentAt, seq)
+		state.received++
+		if state.received == state.messages {
+			state.done <- state.latencies
+			return
+		}
+		state.send(ctx)
+	}
+}
+
+func (state *client) send(ctx actor.Context) {
+	if int(state.seq) == state.messages {
+		return
+	}
+	state.seq++
+	state.sentAt[state.seq] = time.Now()
+	ctx.Request(state.target, state.codec.encode(state.seq))
+}
+
+// runClients runs a client per target and waits for all of them to receive their responses
+func runClients(name string, cfg *Config, c codec, targets []*actor.PID) *Result {
+	done := make(chan []time.Duration, len(targets))
+	clients := make([]*actor.PID, len(targets))
+	for i, target := range targets {
+		target := target
+		clients[i] = rootContext.Spawn(cfg.props(actor.PropsFromProducer(func() actor.Actor {
+			return &client{target: target, codec: c, messages: cfg.Messages, window: cfg.Window, done: done}
+		})))
+	}
+
+	begin := time.Now()
+	for _, pid := range clients {
+		rootContext.Send(pid, &start{})
+	}
+	var latencies []time.Duration
+	for range clients {
+		latencies = append(latencies, <-done...)
+	}
+	elapsed := time.Since(begin)
+
+	stop(clients...)
+	return newResult(name, len(latencies), elapsed, latencies)
+}
+
+func newResult(name string, messages int, elapsed time.Duration, latencies []time.Duration) *Result {
+	res := &Result{Name: name, Messages: messages, Elapsed: elapsed}
+	if len(latencies) == 0 {
+		return res
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	res.P50 = latencies[len(latencies)/2]
+	res.P99 = latencies[len(latencies)*99/100]
+	res.Max = latencies[len(latencies)-1]
+	return res
+}
+
+// PingPong runs Clients pairs of a client and an echo actor
+func PingPong(cfg *Config) *Result {
+	echoes := make([]*actor.PID, cfg.Clients)
+	for i := range echoes {
+		echoes[i] = rootContext.Spawn(cfg.props(EchoProps()))
+	}
+	defer stop(echoes...)
+	return runClients("PingPong", cfg, localCodec, echoes)
+}
+
+// FanOut runs Clients clients sending to a round robin pool router of Routees echo actors
+func FanOut(cfg *Config) *Result {
+	pool := rootContext.Spawn(cfg.props(router.NewRoundRobinPool(cfg.Routees).WithFunc(echo)))
+	defer stop(pool)
+	targets := make([]*actor.PID, cfg.Clients)
+	for i := range targets {
+		targets[i] = pool
+	}
+	return runClients("FanOut", cfg, localCodec, targets)
+}
+
+// MailboxSaturation floods a single actor from Clients goroutines without waiting for responses,
+// measuring how fast its mailbox is drained
+func MailboxSaturation(cfg *Config) *Result {
+	total := cfg.Messages * cfg.Clients
+	done := make(chan struct{})
+	received := 0
+	pid := rootContext.Spawn(cfg.props(actor.PropsFromFunc(func(ctx actor.Context) {
+		if _, ok := ctx.Message().(*ping); ok {
+			received++
+			if received == total {
+				close(done)
+			}
+		}
+	})))
+	defer stop(pid)
+
+	var msg interface{} = &ping{}
+	begin := time.Now()
+	var wg sync.WaitGroup
+	wg.Add(cfg.Clients)
+	for i := 0; i < cfg.Clients; i++ {
+		go func() {
+			defer wg.Done()
+			for j := 0; j < cfg.Messages; j++ {
+				rootContext.Send(pid, msg)
+			}
+		}()
+	}
+	wg.Wait()
+	<-done
+	return newResult("MailboxSaturation", total, time.Since(begin), nil)
+}
+
+// RemoteEcho runs Clients clients sending to the echo actor at target, which has to be spawned from EchoProps
+// on another node. Remoting has to be started on this node
+func RemoteEcho(cfg *Config, target *actor.PID) *Result {
+	targets := make([]*actor.PID, cfg.Clients)
+	for i := range targets {
+		targets[i] = target
+	}
+	return runClients("RemoteEcho", cfg, remoteCodec, targets)
+}
+
+func stop(pids ...*actor.PID) {
+	for _, pid := range pids {
+		rootContext.StopFuture(pid).Wait()
+	}
+}
@@ -0,0 +1,55 @@
+package benchmark
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/AsynkronIT/protoactor-go/mailbox"
+	"github.com/stretchr/testify/assert"
+)
+
+func testConfig() *Config {
+	return &Config{Messages: 1000, Clients: 2, Window: 10, Routees: 3}
+}
+
+func TestBenchmarks(t *testing.T) {
+	cfg := testConfig()
+	echo := rootContext.Spawn(EchoProps())
+	defer rootContext.Stop(echo)
+
+	results := []*Result{PingPong(cfg), FanOut(cfg), MailboxSaturation(cfg), RemoteEcho(cfg, echo)}
+	for _, r := range results {
+		assert.Equal(t, 2000, r.Messages, r.Name)
+		assert.True(t, r.Elapsed > 0, r.Name)
+	}
+	assert.True(t, results[0].Max >= results[0].P50)
+
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, Report(buffer, results...))
+	assert.Contains(t, buffer.String(), "MailboxSaturation")
+}
+
+func TestBenchmarks_mailbox(t *testing.T) {
+	cfg := testConfig()
+	cfg.Mailbox = mailbox.Bounded(100)
+	cfg.Dispatcher = mailbox.NewDefaultDispatcher(10)
+	assert.Equal(t, 2000, PingPong(cfg).Messages)
+}
+
+func benchmarkConfig(b *testing.B) *Config {
+	cfg := DefaultConfig()
+	cfg.Messages = b.N/cfg.Clients + 1
+	return cfg
+}
+
+func BenchmarkPingPong(b *testing.B) {
+	PingPong(benchmarkConfig(b))
+}
+
+func BenchmarkFanOut(b *testing.B) {
+	FanOut(benchmarkConfig(b))
+}
+
+func BenchmarkMailboxSaturation(b *testing.B) {
+	MailboxSaturation(benchmarkConfig(b))
+}
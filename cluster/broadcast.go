@@ -0,0 +1,41 @@
+package cluster
+
+import (
+	"github.com/AsynkronIT/protoactor-go/log"
+	"github.com/AsynkronIT/protoactor-go/remote"
+)
+
+// BroadcastToKind sends the message to every live activation of the kind in the cluster, e.g. to invalidate caches or reload configuration.
+//
+// The message is sent to the partition actors of the kind on all members, which forward it to the activations they own.
+// The delivery is best-effort: activations being spawned or moved between members while the topology changes may miss the message,
+// and activations located by an IdentityLookup are not known to the partition actors.
+// Generated grains receive the message through BroadcastReceiver
+func BroadcastToKind(kind string, message interface{}) error {
+	serializerID := remote.SerializerIDFor(message)
+	data, typeName, err := remote.Serialize(message, serializerID)
+	if err != nil {
+		return err
+	}
+	req := &BroadcastRequest{MessageData: data, TypeName: typeName, SerializerId: serializerID}
+	for _, address := range memberList.getMembers(kind) {
+		rootContext.Send(partition.partitionForKind(address, kind), req)
+	}
+	return nil
+}
+
+// BroadcastReceiver is implemented by the generated grains receiving the messages sent with BroadcastToKind
+type BroadcastReceiver interface {
+	ReceiveBroadcast(message interface{}, ctx GrainContext)
+}
+
+func (state *partitionActor) broadcast(msg *BroadcastRequest) {
+	message, err := remote.Deserialize(msg.MessageData, msg.TypeName, msg.SerializerId)
+	if err != nil {
+		plog.Error("Partition failed to deserialize broadcast message", log.String("kind", state.kind), log.String("type", msg.TypeName), log.Error(err))
+		return
+	}
+	for _, pid := range state.partition {
+		rootContext.Send(pid, message)
+	}
+}
@@ -0,0 +1,40 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartition_Broadcast(t *testing.T) {
+	received := make(chan interface{}, 10)
+	activation1 := spawnSubscriber(received, false)
+	activation2 := spawnSubscriber(received, false)
+	defer rootContext.Stop(activation1)
+	defer rootContext.Stop(activation2)
+
+	props := actor.PropsFromProducer(func() actor.Actor {
+		a := newPartitionActor("kind")().(*partitionActor)
+		a.partition["a"] = activation1
+		a.partition["b"] = activation2
+		return a
+	})
+	pid := rootContext.Spawn(props)
+	defer rootContext.Stop(pid)
+
+	data, typeName, err := remote.Serialize(&ClusterIdentity{Id: "reload"}, remote.ProtoSerializerID)
+	assert.NoError(t, err)
+	rootContext.Send(pid, &BroadcastRequest{MessageData: data, TypeName: typeName, SerializerId: remote.ProtoSerializerID})
+
+	for i := 0; i < 2; i++ {
+		select {
+		case msg := <-received:
+			assert.Equal(t, &ClusterIdentity{Id: "reload"}, msg)
+		case <-time.After(time.Second):
+			t.Fatal("broadcast not received")
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package cluster
+
+import (
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+	"github.com/AsynkronIT/protoactor-go/internal/chaos"
+)
+
+func init() {
+	chaos.OnPartitionChanged(republishTopology)
+}
+
+// republishTopology publishes the last topology of the cluster provider again, so the members are filtered by the
+// partitions simulated with the clustertest package without waiting for the provider
+func republishTopology() {
+	if memberList == nil {
+		return
+	}
+	memberList.mutex.RLock()
+	topology := memberList.lastTopology
+	memberList.mutex.RUnlock()
+	if topology != nil {
+		eventstream.Publish(topology)
+	}
+}
+
+// reachableTopology returns the topology without the members a partition is simulated from
+func reachableTopology(topology ClusterTopologyEvent) ClusterTopologyEvent {
+	res := make(ClusterTopologyEvent, 0, len(topology))
+	for _, status := range topology {
+		if !chaos.IsPartitioned(status.Address()) {
+			res = append(res, status)
+		}
+	}
+	return res
+}
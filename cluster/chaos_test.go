@@ -0,0 +1,31 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+	"github.com/AsynkronIT/protoactor-go/internal/chaos"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimulatePartition(t *testing.T) {
+	cfg = NewClusterConfig("mycluster", "127.0.0.1:0", nil)
+	defer func() { cfg = nil }()
+	setupMemberList()
+	defer stopMemberList()
+	defer chaos.Reset()
+
+	eventstream.Publish(ClusterTopologyEvent(members(1, 2, 3)))
+	assert.True(t, memberList.isMember("127.0.0.1:3"))
+
+	chaos.Partition("127.0.0.1:3")
+	assert.False(t, memberList.isMember("127.0.0.1:3"))
+	assert.True(t, memberList.isMember("127.0.0.1:2"))
+
+	// the provider still sees the member, the partition keeps it out of the topology
+	eventstream.Publish(ClusterTopologyEvent(members(1, 2, 3)))
+	assert.False(t, memberList.isMember("127.0.0.1:3"))
+
+	chaos.Heal("127.0.0.1:3")
+	assert.True(t, memberList.isMember("127.0.0.1:3"))
+}
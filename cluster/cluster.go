@@ -11,6 +11,9 @@ import (
 
 var cfg *ClusterConfig
 
+// startedAt is the time this member started, advertised to the other members
+var startedAt time.Time
+
 var rootContext = actor.EmptyRootContext
 
 func Start(clusterName, address string, provider ClusterProvider) {
@@ -19,32 +22,68 @@ func Start(clusterName, address string, provider ClusterProvider) {
 
 func StartWithConfig(config *ClusterConfig) {
 	cfg = config
+	startedAt = time.Now()
 
 	// TODO: make it possible to become a cluster even if remoting is already started
-	remote.Start(cfg.Address, cfg.RemotingOption...)
+	remote.Start(cfg.Address, cfg.remotingOptions()...)
 
 	address := actor.ProcessRegistry.Address
 	h, p := gonet.GetAddress(address)
 	plog.Info("Starting Proto.Actor cluster", log.String("address", address))
-	kinds := remote.GetKnownKinds()
+	remote.Register(TopicActorKind, actor.PropsFromProducer(newTopicActor))
+	kinds := cfg.hostedKinds(remote.GetKnownKinds())
+	setupPassivation(cfg.KindIdleTimeouts)
 
 	// for each known kind, spin up a partition-kind actor to handle all requests for that kind
 	setupPartition(kinds)
 	setupPidCache()
 	setupMemberList()
+	setupMetrics()
+	setupDowning(cfg.DowningStrategy)
+	if cfg.IdentityLookup != nil {
+		cfg.IdentityLookup.Setup()
+	}
+
+	cfg.ClusterProvider.RegisterMember(cfg.Name, h, p, append(cfg.advertisedKinds(kinds), advertisedStartedAt(startedAt)), cfg.InitialMemberStatusValue, cfg.MemberStatusValueSerializer)
+	cfg.ClusterProvider.MonitorMemberStatusChanges()
+	setupSingletons(cfg.SingletonKinds)
+	setupReminders(cfg.ReminderStore)
+}
+
+// StartClient connects to the cluster without hosting any kinds, for processes which only call the grains such as API gateways
+// and command line tools. The client registers with the cluster provider to follow the members, marked as a client so it
+// never owns identities nor activates grains, and the members neither count it in their health nor in their downing decisions.
+// The options of the hosted kinds, singletons, reminders and downing are ignored
+func StartClient(config *ClusterConfig) {
+	cfg = config
+	startedAt = time.Now()
+
+	remote.Start(cfg.Address, cfg.remotingOptions()...)
+
+	address := actor.ProcessRegistry.Address
+	h, p := gonet.GetAddress(address)
+	plog.Info("Starting Proto.Actor cluster client", log.String("address", address))
+
+	setupPartition(nil)
+	setupPidCache()
+	setupMemberList()
+	setupMetrics()
+	setupDowning(nil)
+	if cfg.IdentityLookup != nil {
+		cfg.IdentityLookup.Setup()
+	}
 
-	cfg.ClusterProvider.RegisterMember(cfg.Name, h, p, kinds, cfg.InitialMemberStatusValue, cfg.MemberStatusValueSerializer)
+	cfg.ClusterProvider.RegisterMember(cfg.Name, h, p, []string{clientKind, advertisedStartedAt(startedAt)}, cfg.InitialMemberStatusValue, cfg.MemberStatusValueSerializer)
 	cfg.ClusterProvider.MonitorMemberStatusChanges()
+	setupSingletons(nil)
 }
 
 func Shutdown(graceful bool) {
 	if graceful {
-		cfg.ClusterProvider.Shutdown()
+		deregister()
 		// This is to wait ownership transferring complete.
 		time.Sleep(time.Millisecond * 2000)
-		stopMemberList()
-		stopPidCache()
-		stopPartition()
+		stop()
 	}
 
 	remote.Shutdown(graceful)
@@ -53,13 +92,87 @@ func Shutdown(graceful bool) {
 	plog.Info("Stopped Proto.Actor cluster", log.String("address", address))
 }
 
+// deregister stops the cluster services depending on the membership of this member and deregisters it
+func deregister() {
+	stopReminders()
+	stopSingletons()
+	stopDowning()
+	cfg.ClusterProvider.Shutdown()
+}
+
+func stop() {
+	if cfg.IdentityLookup != nil {
+		cfg.IdentityLookup.Shutdown()
+	}
+	stopMetrics()
+	stopMemberList()
+	stopPidCache()
+	stopPartition()
+}
+
+// Handoff is sent to the grains of a member leaving the cluster gracefully, before they are stopped.
+// Grains handle it to transfer their state, e.g. by persisting it, so it is recovered when they are activated on another member
+type Handoff struct{}
+
+// Handoffable is implemented by the grains handling Handoff, generated grains receive Handoff through it
+type Handoffable interface {
+	Handoff(ctx GrainContext)
+}
+
+// LeaveGracefully leaves the cluster without losing the grains of this member: it hands over the identities owned by
+// this member to the members owning them once it has left, deregisters the member, then stops accepting new activations
+// and hands off the grains activated on this member, which are activated on other members when they are next requested.
+// The grains keep processing the requests routed to them until they are handed off
+func LeaveGracefully() {
+	address := actor.ProcessRegistry.Address
+	plog.Info("Leaving Proto.Actor cluster", log.String("address", address))
+
+	leavePartitions()
+	deregister()
+
+	activations, err := remote.DrainActivator(cfg.TimeoutTime)
+	if err != nil {
+		plog.Error("Failed to stop activations", log.Error(err))
+	}
+	handoff(activations)
+
+	stop()
+	remote.Shutdown(true)
+	plog.Info("Stopped Proto.Actor cluster", log.String("address", address))
+}
+
+// handoff sends Handoff to the grains and stops them once they have processed their pending messages
+func handoff(pids []*actor.PID) {
+	futures := make([]*actor.Future, 0, len(pids))
+	for _, pid := range pids {
+		rootContext.Send(pid, &Handoff{})
+		futures = append(futures, rootContext.PoisonFuture(pid))
+	}
+	for _, f := range futures {
+		f.Wait()
+	}
+}
+
 // Get a PID to a virtual actor
 func Get(name string, kind string) (*actor.PID, remote.ResponseStatusCode) {
+	if inProcess {
+		return getInProcess(name, kind)
+	}
+	metrics.addRequest(kind)
+
 	// Check Cache
 	if pid, ok := pidCache.getCache(name); ok {
 		return pid, remote.ResponseStatusCodeOK
 	}
 
+	if cfg.IdentityLookup != nil {
+		pid, statusCode := cfg.IdentityLookup.Get(name, kind)
+		if statusCode == remote.ResponseStatusCodeOK {
+			pidCache.addCache(name, pid)
+		}
+		return pid, statusCode
+	}
+
 	// Get Pid
 	address := memberList.getPartitionMember(name, kind)
 	if address == "" {
@@ -15,6 +15,22 @@ type ClusterConfig struct {
 	InitialMemberStatusValue    MemberStatusValue
 	MemberStatusValueSerializer MemberStatusValueSerializer
 	MemberStrategyBuilder       func(kind string) MemberStrategy
+	KindIdleTimeouts            map[string]time.Duration
+	TopicDeliveryGuarantees     map[string]DeliveryGuarantee
+	SingletonKinds              []string
+	Roles                       []string
+	KindRoles                   map[string][]string
+	DowningStrategy             DowningStrategy
+	KindGrainCallOptions        map[string]*GrainCallOptions
+	IdentityLookup              IdentityLookup
+	ReminderStore               ReminderStore
+	TopicStore                  TopicStore
+	PartitionStrategy           PartitionStrategy
+	PartitionCount              int
+	RemoteBatchLinger           time.Duration
+	RemoteBatchSize             int
+	KindVersions                map[string]int
+	VersionDrainPolicy          VersionDrainPolicy
 }
 
 func NewClusterConfig(name string, address string, clusterProvider ClusterProvider) *ClusterConfig {
@@ -26,6 +42,11 @@ func NewClusterConfig(name string, address string, clusterProvider ClusterProvid
 		InitialMemberStatusValue:    nil,
 		MemberStatusValueSerializer: &NilMemberStatusValueSerializer{},
 		MemberStrategyBuilder:       newDefaultMemberStrategy,
+		KindIdleTimeouts:            make(map[string]time.Duration),
+		TopicDeliveryGuarantees:     make(map[string]DeliveryGuarantee),
+		KindRoles:                   make(map[string][]string),
+		KindGrainCallOptions:        make(map[string]*GrainCallOptions),
+		KindVersions:                make(map[string]int),
 	}
 }
 
@@ -34,6 +55,28 @@ func (c *ClusterConfig) WithRemotingOption(remotingOption []remote.RemotingOptio
 	return c
 }
 
+// WithRemoteBatching makes this member wait up to linger for a full batch of maxBatchSize messages before sending them to
+// another member, so the grain requests sent within the linger time are coalesced into a single remote batch.
+// It applies to all remote traffic of this member, not only to the grain requests, as remote.WithEndpointWriterLinger and
+// remote.WithEndpointWriterBatchSize do. This trades up to the linger time of latency for less overhead per message in chatty workloads
+func (c *ClusterConfig) WithRemoteBatching(linger time.Duration, maxBatchSize int) *ClusterConfig {
+	c.RemoteBatchLinger = linger
+	c.RemoteBatchSize = maxBatchSize
+	return c
+}
+
+// remotingOptions returns the remoting options, followed by the options of the request batching
+func (c *ClusterConfig) remotingOptions() []remote.RemotingOption {
+	res := append([]remote.RemotingOption{}, c.RemotingOption...)
+	if c.RemoteBatchLinger > 0 {
+		res = append(res, remote.WithEndpointWriterLinger(c.RemoteBatchLinger))
+	}
+	if c.RemoteBatchSize > 0 {
+		res = append(res, remote.WithEndpointWriterBatchSize(c.RemoteBatchSize))
+	}
+	return res
+}
+
 func (c *ClusterConfig) WithTimeout(t time.Duration) *ClusterConfig {
 	c.TimeoutTime = t
 	return c
@@ -53,3 +96,123 @@ func (c *ClusterConfig) WithMemberStrategyBuilder(builder func(kind string) Memb
 	c.MemberStrategyBuilder = builder
 	return c
 }
+
+// WithPartitionStrategy replaces the partitioning of the identities of the member strategies, e.g. to give more identities
+// to the larger members of a heterogeneous cluster
+func (c *ClusterConfig) WithPartitionStrategy(strategy PartitionStrategy) *ClusterConfig {
+	c.PartitionStrategy = strategy
+	return c
+}
+
+// WithPartitionCount hashes the identities to the given number of partitions, which are distributed among the members
+// instead of the identities themselves. Zero, the default, distributes every identity on its own
+func (c *ClusterConfig) WithPartitionCount(count int) *ClusterConfig {
+	c.PartitionCount = count
+	return c
+}
+
+// WithKindIdleTimeout passivates the grains of the given kind once they have not received a message for the given timeout
+func (c *ClusterConfig) WithKindIdleTimeout(kind string, timeout time.Duration) *ClusterConfig {
+	c.KindIdleTimeouts[kind] = timeout
+	return c
+}
+
+// WithTopicDeliveryGuarantee sets how the messages published to the topic are delivered, topics default to AtMostOnce.
+// All members must use the same delivery guarantees, as the topic can be activated on any member
+func (c *ClusterConfig) WithTopicDeliveryGuarantee(topic string, guarantee DeliveryGuarantee) *ClusterConfig {
+	c.TopicDeliveryGuarantees[topic] = guarantee
+	return c
+}
+
+// WithSingletonKind declares the kind as a singleton, which has exactly one activation across the cluster.
+// Use SingletonProxy to send messages to it
+func (c *ClusterConfig) WithSingletonKind(kind string) *ClusterConfig {
+	c.SingletonKinds = append(c.SingletonKinds, kind)
+	return c
+}
+
+// WithRoles sets the roles of this member, such as "frontend" or "gpu", which decide the kinds it hosts
+func (c *ClusterConfig) WithRoles(roles ...string) *ClusterConfig {
+	c.Roles = roles
+	return c
+}
+
+// WithKindRoles restricts the kind to the members having at least one of the roles.
+// A member does not advertise the kinds it cannot host, so their grains are never placed on it
+func (c *ClusterConfig) WithKindRoles(kind string, roles ...string) *ClusterConfig {
+	c.KindRoles[kind] = roles
+	return c
+}
+
+// hostedKinds returns the kinds this member can host according to its roles
+func (c *ClusterConfig) hostedKinds(kinds []string) []string {
+	res := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		if c.canHost(kind) {
+			res = append(res, kind)
+		}
+	}
+	return res
+}
+
+func (c *ClusterConfig) canHost(kind string) bool {
+	required, ok := c.KindRoles[kind]
+	if !ok {
+		return true
+	}
+	for _, role := range required {
+		for _, r := range c.Roles {
+			if r == role {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WithDowningStrategy sets the strategy deciding which side of a network split survives, the members of the other side shut down.
+// The strategy runs when members become unreachable, not when they deregister from the cluster provider, e.g. by leaving
+// gracefully. Without a strategy, all sides keep running
+func (c *ClusterConfig) WithDowningStrategy(strategy DowningStrategy) *ClusterConfig {
+	c.DowningStrategy = strategy
+	return c
+}
+
+// WithKindGrainCallOptions sets the options used by the calls to the grains of the kind which are not given options
+func (c *ClusterConfig) WithKindGrainCallOptions(kind string, opts *GrainCallOptions) *ClusterConfig {
+	c.KindGrainCallOptions[kind] = opts
+	return c
+}
+
+// WithIdentityLookup locates the activations of the grains with the lookup instead of the partition actors
+func (c *ClusterConfig) WithIdentityLookup(lookup IdentityLookup) *ClusterConfig {
+	c.IdentityLookup = lookup
+	return c
+}
+
+// WithReminderStore enables the reminders of the grains, persisted in the store shared by all members,
+// such as the stores of the cluster/identity/redis and cluster/identity/mongo packages
+func (c *ClusterConfig) WithReminderStore(store ReminderStore) *ClusterConfig {
+	c.ReminderStore = store
+	return c
+}
+
+// WithTopicStore persists the subscribers of the topics in the store shared by all members, so they are kept when a topic is
+// activated again. The stores of the cluster/identity/redis and cluster/identity/mongo packages implement it
+func (c *ClusterConfig) WithTopicStore(store TopicStore) *ClusterConfig {
+	c.TopicStore = store
+	return c
+}
+
+// WithKindVersion sets the version of the kind run by this member. During a rolling upgrade, the grains of the kind are
+// only activated on the members running the latest version of the kind in the cluster
+func (c *ClusterConfig) WithKindVersion(kind string, version int) *ClusterConfig {
+	c.KindVersions[kind] = version
+	return c
+}
+
+// WithVersionDrainPolicy sets what happens to the activations of this member once a newer version of their kind joins
+func (c *ClusterConfig) WithVersionDrainPolicy(policy VersionDrainPolicy) *ClusterConfig {
+	c.VersionDrainPolicy = policy
+	return c
+}
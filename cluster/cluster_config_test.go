@@ -0,0 +1,97 @@
+package cluster
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+func TestClusterConfig_HostedKinds(t *testing.T) {
+	kinds := []string{"light", "heavy", "frontend"}
+
+	c := NewClusterConfig("mycluster", "127.0.0.1:0", nil).
+		WithKindRoles("heavy", "gpu", "big").
+		WithKindRoles("frontend", "frontend")
+	assert.Equal(t, []string{"light"}, c.hostedKinds(kinds))
+
+	c.WithRoles("worker", "big")
+	assert.Equal(t, []string{"light", "heavy"}, c.hostedKinds(kinds))
+
+	c.WithRoles("frontend")
+	assert.Equal(t, []string{"light", "frontend"}, c.hostedKinds(kinds))
+}
+
+func TestClusterConfig_RemoteBatching(t *testing.T) {
+	c := NewClusterConfig("mycluster", "127.0.0.1:0", nil)
+	assert.Empty(t, c.remotingOptions())
+
+	c.WithRemoteBatching(time.Millisecond, 100).
+		WithRemotingOption([]remote.RemotingOption{remote.WithEndpointWriterQueueSize(10)})
+	assert.Len(t, c.remotingOptions(), 3)
+	assert.Len(t, c.RemotingOption, 1)
+}
+
+// recordingTransport records the batches sent to the other nodes, which never respond
+type recordingTransport struct {
+	batches chan *remote.MessageBatch
+	stopped chan struct{}
+}
+
+func (t *recordingTransport) Serve(lis net.Listener, handler remote.TransportHandler) error {
+	<-t.stopped
+	return lis.Close()
+}
+
+func (t *recordingTransport) Stop(graceful bool) {
+	close(t.stopped)
+}
+
+func (t *recordingTransport) Dial(ctx context.Context, address string) (remote.TransportConnection, *remote.ConnectResponse, error) {
+	return t, &remote.ConnectResponse{}, nil
+}
+
+func (t *recordingTransport) Send(batch *remote.MessageBatch) error {
+	t.batches <- batch
+	return nil
+}
+
+func (t *recordingTransport) Wait() error {
+	<-t.stopped
+	return nil
+}
+
+func (t *recordingTransport) Close() error {
+	return nil
+}
+
+func TestClusterConfig_RemoteBatchingCoalescesRequests(t *testing.T) {
+	address, handlers := actor.ProcessRegistry.Address, actor.ProcessRegistry.RemoteHandlers
+	transport := &recordingTransport{batches: make(chan *remote.MessageBatch, 100), stopped: make(chan struct{})}
+	c := NewClusterConfig("mycluster", "127.0.0.1:0", nil).WithRemoteBatching(time.Second, 10).
+		WithRemotingOption([]remote.RemotingOption{remote.WithTransport(transport)})
+	remote.Start(c.Address, c.remotingOptions()...)
+	defer func() {
+		remote.Shutdown(true)
+		actor.ProcessRegistry.Address, actor.ProcessRegistry.RemoteHandlers = address, handlers
+	}()
+
+	grain := actor.NewPID("127.0.0.1:1", "Remote$grain")
+	for i := 0; i < 10; i++ {
+		rootContext.Request(grain, &ClusterIdentity{Id: fmt.Sprint(i)})
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	// the requests trickling in within the linger time are sent in a single batch
+	select {
+	case batch := <-transport.batches:
+		assert.Len(t, batch.Envelopes, 10)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no batch sent")
+	}
+}
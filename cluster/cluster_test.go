@@ -0,0 +1,140 @@
+package cluster
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandoff(t *testing.T) {
+	spawnGrain := func(received chan interface{}) *actor.PID {
+		return rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+			switch ctx.Message().(type) {
+			case string, *Handoff:
+				received <- ctx.Message()
+			}
+		}))
+	}
+	received1 := make(chan interface{}, 10)
+	received2 := make(chan interface{}, 10)
+	pid1 := spawnGrain(received1)
+	pid2 := spawnGrain(received2)
+	rootContext.Send(pid1, "pending")
+
+	handoff([]*actor.PID{pid1, pid2})
+
+	// pending messages are processed before the handoff, and the grains are stopped afterwards
+	assert.Equal(t, "pending", <-received1)
+	assert.IsType(t, &Handoff{}, <-received1)
+	assert.IsType(t, &Handoff{}, <-received2)
+	for _, pid := range []*actor.PID{pid1, pid2} {
+		_, found := actor.ProcessRegistry.LocalPIDs.Get(pid.Id)
+		assert.False(t, found)
+	}
+}
+
+func TestLeavePartitions(t *testing.T) {
+	cfg = NewClusterConfig("mycluster", "127.0.0.1:0", nil)
+	defer func() { cfg = nil }()
+	address := actor.ProcessRegistry.Address
+	actor.ProcessRegistry.Address = "127.0.0.1:1"
+	defer func() { actor.ProcessRegistry.Address = address }()
+
+	setupMemberList()
+	defer stopMemberList()
+	setupPartition([]string{"user"})
+	defer stopPartition()
+	eventstream.Publish(ClusterTopologyEvent{
+		&MemberStatus{Host: "127.0.0.1", Port: 1, Kinds: []string{"user"}, Alive: true},
+		&MemberStatus{Host: "127.0.0.1", Port: 2, Kinds: []string{"user"}, Alive: true},
+	})
+
+	// the messages to the other member end up in the dead letters as remoting is not started
+	successor := actor.NewPID("127.0.0.1:2", "partition-user")
+	received := make(chan interface{}, 10)
+	sub := eventstream.Subscribe(func(m interface{}) {
+		if dl, ok := m.(*actor.DeadLetterEvent); ok && dl.PID.Equal(successor) {
+			received <- dl.Message
+		}
+	})
+	defer eventstream.Unsubscribe(sub)
+
+	// an identity owned by this member
+	var name string
+	for i := 0; name == ""; i++ {
+		if n := fmt.Sprintf("user%v", i); memberList.getPartitionMember(n, "user") == "127.0.0.1:1" {
+			name = n
+		}
+	}
+	grain := rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {}))
+	defer rootContext.Stop(grain)
+	rootContext.Send(partition.kindPIDMap["user"], &TakeOwnership{Name: name, Pid: grain})
+
+	leavePartitions()
+
+	select {
+	case msg := <-received:
+		assert.Equal(t, &TakeOwnership{Name: name, Pid: grain}, msg)
+	case <-time.After(time.Second):
+		t.Fatal("ownership not transferred")
+	}
+
+	// the requests received once the identities are handed over are forwarded to the new owner
+	rootContext.Request(partition.kindPIDMap["user"], &remote.ActorPidRequest{Name: name, Kind: "user"})
+	select {
+	case msg := <-received:
+		assert.Equal(t, &remote.ActorPidRequest{Name: name, Kind: "user"}, msg)
+	case <-time.After(time.Second):
+		t.Fatal("request not forwarded")
+	}
+}
+
+// testProvider publishes the topology made of the registered member and the given members
+type testProvider struct {
+	members []*MemberStatus
+	self    *MemberStatus
+}
+
+func (p *testProvider) RegisterMember(clusterName string, address string, port int, knownKinds []string,
+	statusValue MemberStatusValue, serializer MemberStatusValueSerializer) error {
+	p.self = &MemberStatus{Host: address, Port: port, Kinds: knownKinds, Alive: true}
+	return nil
+}
+
+func (p *testProvider) MonitorMemberStatusChanges() {
+	eventstream.Publish(append(ClusterTopologyEvent{p.self}, p.members...))
+}
+
+func (p *testProvider) UpdateMemberStatusValue(statusValue MemberStatusValue) error { return nil }
+func (p *testProvider) DeregisterMember() error                                     { return nil }
+func (p *testProvider) Shutdown() error                                             { return nil }
+
+func TestStartClient(t *testing.T) {
+	address, handlers := actor.ProcessRegistry.Address, actor.ProcessRegistry.RemoteHandlers
+	provider := &testProvider{members: []*MemberStatus{{Host: "127.0.0.1", Port: 1, Kinds: []string{"hello"}, Alive: true}}}
+	StartClient(NewClusterConfig("mycluster", "127.0.0.1:0", provider).WithDowningStrategy(KeepOldest()))
+	self := actor.ProcessRegistry.Address
+	defer func() {
+		Shutdown(true)
+		actor.ProcessRegistry.Address, actor.ProcessRegistry.RemoteHandlers = address, handlers
+		cfg = nil
+	}()
+
+	// the client is marked, it hosts no kinds and the grains are placed on the member
+	assert.Equal(t, []string{clientKind, advertisedStartedAt(startedAt)}, provider.self.Kinds)
+	assert.Equal(t, []string{"127.0.0.1:1"}, memberList.getMembers("hello"))
+	assert.Empty(t, memberList.getMembers(clientKind))
+	assert.Equal(t, "127.0.0.1:1", memberList.getPartitionMember("name", "hello"))
+	assert.True(t, memberList.members[self].Client)
+	assert.True(t, memberList.members[self].StartedAt.Equal(startedAt))
+
+	// the client follows the cluster, but is not counted as a member
+	health := Health()
+	assert.True(t, health.Joined)
+	assert.Equal(t, 1, health.Members)
+}
@@ -0,0 +1,22 @@
+// Package clustertest simulates partitions between the members of a cluster in process
+package clustertest
+
+import (
+	"github.com/AsynkronIT/protoactor-go/remote/remotetest"
+)
+
+// SimulatePartition partitions this member from the members at the addresses, as if the network between them failed.
+// Messages to and from the members are dropped, watches of their actors terminate and the members are removed
+// from the topology of this member, until HealPartition is called
+func SimulatePartition(addresses ...string) {
+	for _, address := range addresses {
+		remotetest.SimulatePartition(address)
+	}
+}
+
+// HealPartition ends the partition from the members at the addresses, they rejoin the topology of this member
+func HealPartition(addresses ...string) {
+	for _, address := range addresses {
+		remotetest.HealPartition(address)
+	}
+}
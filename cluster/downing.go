@@ -0,0 +1,206 @@
+package cluster
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+	"github.com/AsynkronIT/protoactor-go/log"
+)
+
+// DowningStrategy decides which side of a network split survives, so both sides do not keep activating the same identities
+type DowningStrategy interface {
+	// Survives is called when members became unreachable, with the reachable members before and after the change.
+	// The members are ordered from the oldest to the youngest, see sortByAge. It returns false when this member has to go down
+	Survives(self string, previous []*MemberStatus, current []*MemberStatus) bool
+}
+
+// Lease is held by a single side of a network split, e.g. a lock in etcd or consul
+type Lease interface {
+	// Acquire returns true if the lease is held by the holder, acquiring it if it is free
+	Acquire(holder string) (bool, error)
+}
+
+// KeepMajority keeps the side with the majority of the previous members.
+// When both sides have the same size, the side with the lowest address survives
+func KeepMajority() DowningStrategy {
+	return &keepMajority{}
+}
+
+type keepMajority struct{}
+
+func (*keepMajority) Survives(self string, previous []*MemberStatus, current []*MemberStatus) bool {
+	switch {
+	case len(current)*2 > len(previous):
+		return true
+	case len(current)*2 < len(previous):
+		return false
+	default:
+		return contains(current, lowestAddress(previous))
+	}
+}
+
+// KeepOldest keeps the side with the oldest member, the member which started first
+func KeepOldest() DowningStrategy {
+	return &keepOldest{}
+}
+
+type keepOldest struct{}
+
+func (*keepOldest) Survives(self string, previous []*MemberStatus, current []*MemberStatus) bool {
+	return len(previous) == 0 || contains(current, previous[0].Address())
+}
+
+// StaticQuorum keeps the sides having at least size members, size should be more than half of the cluster size
+func StaticQuorum(size int) DowningStrategy {
+	return &staticQuorum{size: size}
+}
+
+type staticQuorum struct {
+	size int
+}
+
+func (s *staticQuorum) Survives(self string, previous []*MemberStatus, current []*MemberStatus) bool {
+	return len(current) >= s.size
+}
+
+// LeaseBased keeps the side which acquires the lease. The side is named after its lowest address,
+// so all members of a side acquire the lease for the same holder
+func LeaseBased(lease Lease) DowningStrategy {
+	return &leaseBased{lease: lease}
+}
+
+type leaseBased struct {
+	lease Lease
+}
+
+func (s *leaseBased) Survives(self string, previous []*MemberStatus, current []*MemberStatus) bool {
+	acquired, err := s.lease.Acquire(lowestAddress(current))
+	if err != nil {
+		plog.Error("Failed to acquire downing lease", log.Error(err))
+		return false
+	}
+	return acquired
+}
+
+func lowestAddress(members []*MemberStatus) string {
+	res := ""
+	for _, m := range members {
+		if res == "" || m.Address() < res {
+			res = m.Address()
+		}
+	}
+	return res
+}
+
+// sortByAge orders the members from the oldest to the youngest by the start time they advertise, which all members agree on.
+// The members not advertising it are the oldest, ordered by address like the members started at the same time
+func sortByAge(members []*MemberStatus) {
+	sort.Slice(members, func(i, j int) bool {
+		if !members[i].StartedAt.Equal(members[j].StartedAt) {
+			return members[i].StartedAt.Before(members[j].StartedAt)
+		}
+		return members[i].Address() < members[j].Address()
+	})
+}
+
+func contains(members []*MemberStatus, address string) bool {
+	for _, m := range members {
+		if m.Address() == address {
+			return true
+		}
+	}
+	return false
+}
+
+var downing *downingValue
+
+// downingValue applies the downing strategy whenever members become unreachable
+type downingValue struct {
+	mutex    sync.Mutex
+	strategy DowningStrategy
+	self     string
+	members  []*MemberStatus // reachable members, from the oldest to the youngest
+	down     func()
+	downed   bool
+
+	topologySub *eventstream.Subscription
+}
+
+func setupDowning(strategy DowningStrategy) {
+	downing = &downingValue{
+		strategy: strategy,
+		self:     actor.ProcessRegistry.Address,
+		down: func() {
+			go Shutdown(true)
+		},
+	}
+	if strategy == nil {
+		return
+	}
+	downing.topologySub = eventstream.
+		Subscribe(downing.onTopology).
+		WithPredicate(func(m interface{}) bool {
+			_, ok := m.(ClusterTopologyEvent)
+			return ok
+		})
+}
+
+func stopDowning() {
+	if downing.topologySub != nil {
+		eventstream.Unsubscribe(downing.topologySub)
+	}
+	downing = nil
+}
+
+func (d *downingValue) onTopology(m interface{}) {
+	// the members missing from the topology deregistered, e.g. they left gracefully, they do not take part in the
+	// decision. The members in the topology which are not alive, or partitioned, became unreachable.
+	// The clients never take part in the decision
+	registered := make(map[string]bool)
+	for _, status := range m.(ClusterTopologyEvent) {
+		if !advertisesClient(status.Kinds) {
+			registered[status.Address()] = true
+		}
+	}
+	topology := reachableTopology(m.(ClusterTopologyEvent))
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.downed {
+		return
+	}
+
+	reachable := make(map[string]bool)
+	current := make([]*MemberStatus, 0, len(topology))
+	for _, status := range topology {
+		if status.Alive && !advertisesClient(status.Kinds) {
+			reachable[status.Address()] = true
+			current = append(current, withStartedAt(status))
+		}
+	}
+	sortByAge(current)
+
+	// the members which joined since are not in the previous members
+	previous := make([]*MemberStatus, 0, len(d.members))
+	lost := false
+	for _, known := range d.members {
+		if reachable[known.Address()] {
+			previous = append(previous, known)
+		} else if registered[known.Address()] {
+			previous = append(previous, known)
+			lost = true
+		}
+	}
+
+	d.members = current
+	if !lost || d.strategy.Survives(d.self, previous, current) {
+		return
+	}
+
+	plog.Error("Downing this member, it is on the losing side of a network split",
+		log.String("address", d.self), log.Int("previous", len(previous)), log.Int("current", len(current)))
+	d.downed = true
+	d.down()
+}
@@ -0,0 +1,162 @@
+package cluster
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func members(ports ...int) []*MemberStatus {
+	res := make([]*MemberStatus, len(ports))
+	for i, port := range ports {
+		res[i] = &MemberStatus{Host: "127.0.0.1", Port: port, Alive: true}
+	}
+	return res
+}
+
+type testLease struct {
+	holder string
+	err    error
+}
+
+func (l *testLease) Acquire(holder string) (bool, error) {
+	if l.err != nil {
+		return false, l.err
+	}
+	if l.holder == "" {
+		l.holder = holder
+	}
+	return l.holder == holder, nil
+}
+
+func TestDowningStrategies(t *testing.T) {
+	previous := members(3, 1, 2, 4)
+
+	assert.True(t, KeepMajority().Survives("", members(1, 2, 3, 4, 5), members(1, 2, 3)))
+	assert.False(t, KeepMajority().Survives("", members(1, 2, 3, 4, 5), members(4, 5)))
+	assert.True(t, KeepMajority().Survives("", previous, members(1, 4)))
+	assert.False(t, KeepMajority().Survives("", previous, members(2, 3)))
+
+	assert.True(t, KeepOldest().Survives("", previous, members(3)))
+	assert.False(t, KeepOldest().Survives("", previous, members(1, 2, 4)))
+
+	assert.True(t, StaticQuorum(2).Survives("", previous, members(1, 2)))
+	assert.False(t, StaticQuorum(3).Survives("", previous, members(1, 2)))
+
+	lease := &testLease{}
+	assert.True(t, LeaseBased(lease).Survives("", previous, members(2, 1)))
+	assert.True(t, LeaseBased(lease).Survives("", previous, members(1, 2)))
+	assert.False(t, LeaseBased(lease).Survives("", previous, members(3, 4)))
+	assert.False(t, LeaseBased(&testLease{err: errors.New("unavailable")}).Survives("", previous, members(1, 2)))
+}
+
+func TestDowning_OnTopology(t *testing.T) {
+	downed := 0
+	d := &downingValue{
+		strategy: KeepOldest(),
+		self:     "127.0.0.1:2",
+		down:     func() { downed++ },
+	}
+
+	d.onTopology(ClusterTopologyEvent(started(2)))
+	d.onTopology(ClusterTopologyEvent(started(2, 1, 3)))
+	// the members started after this member are younger, whatever their addresses
+	assert.Equal(t, "127.0.0.1:2", d.members[0].Address())
+	assert.Equal(t, 0, downed)
+
+	// the oldest member is still reachable
+	d.onTopology(ClusterTopologyEvent(started(2, 1)))
+	assert.Equal(t, 0, downed)
+
+	// fewer members than the quorum remain reachable
+	d.strategy = StaticQuorum(2)
+	d.onTopology(ClusterTopologyEvent(append(members(2), unreachable(1)...)))
+	assert.Equal(t, 1, downed)
+
+	// a downed member is not downed again
+	d.onTopology(ClusterTopologyEvent(append(members(3), unreachable(1, 2)...)))
+	assert.Equal(t, 1, downed)
+}
+
+// started returns the members advertising they started in the order of the ports
+func started(ports ...int) []*MemberStatus {
+	res := members(ports...)
+	for i, status := range res {
+		status.Kinds = []string{advertisedStartedAt(time.Unix(int64(i), 0))}
+	}
+	return res
+}
+
+func TestDowning_KeepOldestAgreesOnAge(t *testing.T) {
+	// the member 3 started first, the member 1 joined once the members 2 and 3 were running
+	first := &downingValue{strategy: KeepOldest(), self: "127.0.0.1:3", down: func() {}}
+	last := &downingValue{strategy: KeepOldest(), self: "127.0.0.1:1", down: func() {}}
+	first.onTopology(ClusterTopologyEvent(started(3)))
+	first.onTopology(ClusterTopologyEvent(started(3, 2)))
+	first.onTopology(ClusterTopologyEvent(started(3, 2, 1)))
+	last.onTopology(ClusterTopologyEvent(started(3, 2, 1)))
+
+	// both sides of the split agree the side of the member 3 survives
+	split := started(3, 2, 1)
+	split[0].Alive = false
+	last.onTopology(ClusterTopologyEvent(split))
+	assert.True(t, last.downed)
+
+	split = started(3, 2, 1)
+	split[1].Alive = false
+	split[2].Alive = false
+	first.onTopology(ClusterTopologyEvent(split))
+	assert.False(t, first.downed)
+}
+
+func unreachable(ports ...int) []*MemberStatus {
+	res := members(ports...)
+	for _, status := range res {
+		status.Alive = false
+	}
+	return res
+}
+
+func TestDowning_IgnoresDeregisteredMembers(t *testing.T) {
+	downed := 0
+	d := &downingValue{
+		strategy: KeepMajority(),
+		self:     "127.0.0.1:2",
+		down:     func() { downed++ },
+	}
+	d.onTopology(ClusterTopologyEvent(members(1, 2)))
+
+	// the member with the lowest address leaves the cluster
+	d.onTopology(ClusterTopologyEvent(members(2)))
+	assert.Equal(t, 0, downed)
+	assert.Len(t, d.members, 1)
+
+	// a member becoming unreachable in a cluster of two is a split
+	d.onTopology(ClusterTopologyEvent(members(1, 2)))
+	d.onTopology(ClusterTopologyEvent(append(members(2), unreachable(1)...)))
+	assert.Equal(t, 1, downed)
+}
+
+func TestDowning_IgnoresClients(t *testing.T) {
+	downed := 0
+	d := &downingValue{
+		strategy: KeepMajority(),
+		self:     "127.0.0.1:2",
+		down:     func() { downed++ },
+	}
+	client := func(alive bool) *MemberStatus {
+		return &MemberStatus{Host: "127.0.0.1", Port: 3, Kinds: []string{clientKind}, Alive: alive}
+	}
+	d.onTopology(ClusterTopologyEvent(append(members(1, 2), client(true))))
+	assert.Len(t, d.members, 2)
+
+	// a client becoming unreachable is not a split
+	d.onTopology(ClusterTopologyEvent(append(members(1, 2), client(false))))
+	assert.Equal(t, 0, downed)
+
+	// and a reachable client does not count in the majority
+	d.onTopology(ClusterTopologyEvent(append(append(members(2), unreachable(1)...), client(true))))
+	assert.Equal(t, 1, downed)
+}
@@ -0,0 +1,300 @@
+package etcd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/cluster"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+)
+
+// EtcdProvider discovers the cluster members using etcd.
+//
+// Every member stores its address and kinds under a key attached to a lease, which is kept alive as long as the member runs.
+// When a member stops or fails, the lease expires and etcd deletes the key, which the other members see as the member leaving
+type EtcdProvider struct {
+	deregistered          bool
+	shutdown              bool
+	client                *clientv3.Client
+	prefix                string
+	key                   string
+	clusterName           string
+	address               string
+	port                  int
+	knownKinds            []string
+	statusValue           cluster.MemberStatusValue
+	statusValueSerializer cluster.MemberStatusValueSerializer
+	ttl                   int64 // lease TTL in seconds
+	retryInterval         time.Duration
+	leaseID               clientv3.LeaseID
+	ctx                   context.Context
+	cancel                context.CancelFunc
+	stopKeepAlive         context.CancelFunc
+	keepAliveDone         chan struct{}
+	mu                    sync.Mutex
+	members               map[string]*memberData
+	revision              int64
+	clusterError          error
+}
+
+type memberData struct {
+	Host        string   `json:"host"`
+	Port        int      `json:"port"`
+	Kinds       []string `json:"kinds"`
+	StatusValue string   `json:"statusValue"`
+}
+
+// New creates a provider connecting to etcd on localhost
+func New() (*EtcdProvider, error) {
+	return NewWithConfig(clientv3.Config{
+		Endpoints:   []string{"127.0.0.1:2379"},
+		DialTimeout: 5 * time.Second,
+	})
+}
+
+func NewWithConfig(etcdConfig clientv3.Config) (*EtcdProvider, error) {
+	client, err := clientv3.New(etcdConfig)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &EtcdProvider{
+		client:        client,
+		prefix:        "/protoactor/",
+		ttl:           5,
+		retryInterval: 1 * time.Second,
+		ctx:           ctx,
+		cancel:        cancel,
+		members:       make(map[string]*memberData),
+	}
+	return p, nil
+}
+
+func (p *EtcdProvider) RegisterMember(clusterName string, address string, port int, knownKinds []string,
+	statusValue cluster.MemberStatusValue, serializer cluster.MemberStatusValueSerializer) error {
+	p.clusterName = clusterName
+	p.key = fmt.Sprintf("%v%v:%v", p.clusterPrefix(), address, port)
+	p.address = address
+	p.port = port
+	p.knownKinds = knownKinds
+	p.statusValue = statusValue
+	p.statusValueSerializer = serializer
+
+	err := p.registerMember(p.ctx)
+	if err != nil {
+		return err
+	}
+	p.keepAlive()
+
+	// get the members directly after registering, so the local node sees its own information upon startup
+	return p.listMembers()
+}
+
+func (p *EtcdProvider) MonitorMemberStatusChanges() {
+	go func() {
+		for !p.shutdown {
+			p.watchMembers()
+			if p.shutdown {
+				return
+			}
+			// the watch ended, get the members again to not miss any changes
+			if err := p.listMembers(); err != nil {
+				log.Printf("[CLUSTER] [ETCD] Error getting members %v", err)
+				time.Sleep(p.retryInterval)
+			}
+		}
+	}()
+}
+
+func (p *EtcdProvider) UpdateMemberStatusValue(statusValue cluster.MemberStatusValue) error {
+	p.statusValue = statusValue
+	if p.statusValue == nil {
+		return nil
+	}
+	return p.putMember(p.leaseID)
+}
+
+func (p *EtcdProvider) DeregisterMember() error {
+	// stop keeping the lease alive first, otherwise the member is registered again once the lease is revoked
+	if p.stopKeepAlive != nil {
+		p.stopKeepAlive()
+		<-p.keepAliveDone
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	// revoking the lease deletes the key
+	_, err := p.client.Revoke(ctx, p.leaseID)
+	if err != nil {
+		return err
+	}
+	p.deregistered = true
+	return nil
+}
+
+func (p *EtcdProvider) Shutdown() error {
+	p.shutdown = true
+	var err error
+	if !p.deregistered {
+		err = p.DeregisterMember()
+	}
+	p.cancel()
+	p.client.Close()
+	return err
+}
+
+// GetHealthStatus returns an error if the cluster health status has problems
+func (p *EtcdProvider) GetHealthStatus() error {
+	return p.clusterError
+}
+
+func (p *EtcdProvider) clusterPrefix() string {
+	return p.prefix + p.clusterName + "/"
+}
+
+func (p *EtcdProvider) registerMember(ctx context.Context) error {
+	lease, err := p.client.Grant(ctx, p.ttl)
+	p.clusterError = err
+	if err != nil {
+		return err
+	}
+	p.leaseID = lease.ID
+	return p.putMemberWithContext(ctx, lease.ID)
+}
+
+func (p *EtcdProvider) putMember(leaseID clientv3.LeaseID) error {
+	return p.putMemberWithContext(p.ctx, leaseID)
+}
+
+func (p *EtcdProvider) putMemberWithContext(ctx context.Context, leaseID clientv3.LeaseID) error {
+	data, err := json.Marshal(&memberData{
+		Host:        p.address,
+		Port:        p.port,
+		Kinds:       p.knownKinds,
+		StatusValue: p.statusValueSerializer.Serialize(p.statusValue),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = p.client.Put(ctx, p.key, string(data), clientv3.WithLease(leaseID))
+	p.clusterError = err
+	return err
+}
+
+// keepAlive keeps the lease alive and registers the member again if the lease is lost, until the member is deregistered
+func (p *EtcdProvider) keepAlive() {
+	ctx, cancel := context.WithCancel(p.ctx)
+	p.stopKeepAlive = cancel
+	p.keepAliveDone = make(chan struct{})
+	go func() {
+		defer close(p.keepAliveDone)
+		for ctx.Err() == nil {
+			responses, err := p.client.KeepAlive(ctx, p.leaseID)
+			if err == nil {
+				for range responses {
+				}
+			}
+			if ctx.Err() != nil {
+				return
+			}
+
+			log.Println("[CLUSTER] [ETCD] Lease lost, registering member again")
+			for ctx.Err() == nil {
+				err := p.registerMember(ctx)
+				if err == nil {
+					break
+				}
+				log.Println("[CLUSTER] [ETCD] Error registering member ", err)
+				select {
+				case <-ctx.Done():
+				case <-time.After(p.retryInterval):
+				}
+			}
+		}
+	}()
+}
+
+func (p *EtcdProvider) listMembers() error {
+	resp, err := p.client.Get(p.ctx, p.clusterPrefix(), clientv3.WithPrefix())
+	p.clusterError = err
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	p.members = make(map[string]*memberData, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		p.updateMember(kv)
+	}
+	p.revision = resp.Header.Revision
+	p.mu.Unlock()
+
+	p.notifyStatuses()
+	return nil
+}
+
+// watchMembers applies the changes to the members until the watch ends
+func (p *EtcdProvider) watchMembers() {
+	p.mu.Lock()
+	revision := p.revision
+	p.mu.Unlock()
+
+	for resp := range p.client.Watch(p.ctx, p.clusterPrefix(), clientv3.WithPrefix(), clientv3.WithRev(revision+1)) {
+		if err := resp.Err(); err != nil {
+			// e.g. the revision has been compacted
+			p.clusterError = err
+			log.Printf("[CLUSTER] [ETCD] Error watching members %v", err)
+			return
+		}
+
+		p.mu.Lock()
+		for _, event := range resp.Events {
+			switch event.Type {
+			case mvccpb.PUT:
+				p.updateMember(event.Kv)
+			case mvccpb.DELETE:
+				delete(p.members, string(event.Kv.Key))
+			}
+		}
+		p.revision = resp.Header.Revision
+		p.mu.Unlock()
+
+		p.notifyStatuses()
+	}
+}
+
+func (p *EtcdProvider) updateMember(kv *mvccpb.KeyValue) {
+	member := &memberData{}
+	if err := json.Unmarshal(kv.Value, member); err != nil {
+		log.Printf("[CLUSTER] [ETCD] Invalid member %v %v", string(kv.Key), err)
+		return
+	}
+	p.members[string(kv.Key)] = member
+}
+
+func (p *EtcdProvider) notifyStatuses() {
+	p.mu.Lock()
+	res := make(cluster.ClusterTopologyEvent, 0, len(p.members))
+	for _, member := range p.members {
+		res = append(res, &cluster.MemberStatus{
+			MemberID:    fmt.Sprintf("%v/%v:%v", p.clusterName, member.Host, member.Port),
+			Host:        member.Host,
+			Port:        member.Port,
+			Kinds:       member.Kinds,
+			Alive:       true,
+			StatusValue: p.statusValueSerializer.Deserialize(member.StatusValue),
+		})
+	}
+	p.mu.Unlock()
+
+	sort.Slice(res, func(i, j int) bool { return res[i].MemberID < res[j].MemberID })
+
+	// publish the current cluster topology onto the event stream
+	eventstream.Publish(res)
+}
@@ -0,0 +1,127 @@
+package etcd
+
+import (
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/cluster"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/embed"
+	"github.com/stretchr/testify/assert"
+)
+
+func freeURL(t *testing.T) url.URL {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	return url.URL{Scheme: "http", Host: lis.Addr().String()}
+}
+
+func startEtcd(t *testing.T) (*embed.Etcd, string) {
+	dir, err := ioutil.TempDir("", "etcd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := embed.NewConfig()
+	cfg.Dir = dir
+	clientURL, peerURL := freeURL(t), freeURL(t)
+	cfg.LCUrls, cfg.ACUrls = []url.URL{clientURL}, []url.URL{clientURL}
+	cfg.LPUrls, cfg.APUrls = []url.URL{peerURL}, []url.URL{peerURL}
+	cfg.InitialCluster = cfg.InitialClusterFromName(cfg.Name)
+
+	e, err := embed.StartEtcd(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-e.Server.ReadyNotify():
+	case <-time.After(10 * time.Second):
+		t.Fatal("etcd did not start")
+	}
+	return e, clientURL.Host
+}
+
+func newProvider(t *testing.T, endpoint string) *EtcdProvider {
+	p, err := NewWithConfig(clientv3.Config{Endpoints: []string{endpoint}, DialTimeout: 5 * time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func nextTopology(t *testing.T, events chan cluster.ClusterTopologyEvent) cluster.ClusterTopologyEvent {
+	select {
+	case topology := <-events:
+		return topology
+	case <-time.After(10 * time.Second):
+		t.Fatal("no topology published")
+		return nil
+	}
+}
+
+func TestEtcdProvider(t *testing.T) {
+	e, endpoint := startEtcd(t)
+	defer os.RemoveAll(e.Config().Dir)
+	defer e.Close()
+
+	events := make(chan cluster.ClusterTopologyEvent, 10)
+	sub := eventstream.Subscribe(func(evt interface{}) {
+		if topology, ok := evt.(cluster.ClusterTopologyEvent); ok {
+			events <- topology
+		}
+	})
+	defer eventstream.Unsubscribe(sub)
+
+	p1 := newProvider(t, endpoint)
+	defer p1.Shutdown()
+	err := p1.RegisterMember("mycluster", "127.0.0.1", 8000, []string{"a", "b"}, nil, &cluster.NilMemberStatusValueSerializer{})
+	assert.NoError(t, err)
+	topology := nextTopology(t, events)
+	if assert.Len(t, topology, 1) {
+		assert.Equal(t, "mycluster/127.0.0.1:8000", topology[0].MemberID)
+		assert.Equal(t, []string{"a", "b"}, topology[0].Kinds)
+		assert.True(t, topology[0].Alive)
+	}
+	p1.MonitorMemberStatusChanges()
+
+	// another member joins
+	p2 := newProvider(t, endpoint)
+	err = p2.RegisterMember("mycluster", "127.0.0.1", 8001, []string{"a"}, nil, &cluster.NilMemberStatusValueSerializer{})
+	assert.NoError(t, err)
+	// the topologies published by p2 itself and by the watch of p1
+	assert.Len(t, nextTopology(t, events), 2)
+	assert.Len(t, nextTopology(t, events), 2)
+
+	// and leaves
+	assert.NoError(t, p2.Shutdown())
+	topology = nextTopology(t, events)
+	if assert.Len(t, topology, 1) {
+		assert.Equal(t, "mycluster/127.0.0.1:8000", topology[0].MemberID)
+	}
+}
+
+func TestEtcdProvider_DeregisterMember(t *testing.T) {
+	e, endpoint := startEtcd(t)
+	defer os.RemoveAll(e.Config().Dir)
+	defer e.Close()
+
+	p := newProvider(t, endpoint)
+	p.ttl = 1
+	defer p.Shutdown()
+	err := p.RegisterMember("mycluster", "127.0.0.1", 8000, []string{"a"}, nil, &cluster.NilMemberStatusValueSerializer{})
+	assert.NoError(t, err)
+	assert.NoError(t, p.DeregisterMember())
+
+	// the key must stay deleted after the lease would have been kept alive again
+	time.Sleep(3 * time.Second)
+	resp, err := p.client.Get(p.ctx, p.key)
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Kvs)
+}
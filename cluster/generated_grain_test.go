@@ -0,0 +1,95 @@
+package cluster_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/cluster"
+	"github.com/AsynkronIT/protoactor-go/examples/cluster/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+var rootContext = actor.EmptyRootContext
+
+// helloGrain is the implementation of the grain generated from examples/cluster/shared/protos.proto
+type helloGrain struct {
+	cluster.Grain
+	passivated *int32
+	handoffs   chan string
+	broadcasts chan interface{}
+}
+
+func (*helloGrain) Terminate() {}
+
+func (*helloGrain) SayHello(r *shared.HelloRequest, ctx cluster.GrainContext) (*shared.HelloResponse, error) {
+	return &shared.HelloResponse{Message: "hello " + r.Name}, nil
+}
+
+func (*helloGrain) Add(r *shared.AddRequest, ctx cluster.GrainContext) (*shared.AddResponse, error) {
+	return &shared.AddResponse{Result: r.A + r.B}, nil
+}
+
+func (*helloGrain) VoidFunc(r *shared.AddRequest, ctx cluster.GrainContext) (*shared.Unit, error) {
+	return &shared.Unit{}, nil
+}
+
+func (g *helloGrain) PrePassivate(ctx actor.Context) {
+	atomic.AddInt32(g.passivated, 1)
+}
+
+func (g *helloGrain) Handoff(ctx cluster.GrainContext) {
+	g.handoffs <- g.ID()
+}
+
+func (g *helloGrain) ReceiveBroadcast(message interface{}, ctx cluster.GrainContext) {
+	g.broadcasts <- message
+}
+
+func spawnHelloGrain(t *testing.T, name string, g *helloGrain, middleware ...actor.ReceiverMiddleware) *actor.PID {
+	shared.HelloFactory(func() shared.Hello { return g })
+	props := actor.PropsFromProducer(func() actor.Actor { return &shared.HelloActor{} }).WithReceiverMiddleware(middleware...)
+	pid, err := rootContext.SpawnNamed(props, "Remote$"+name)
+	assert.NoError(t, err)
+	return pid
+}
+
+func TestGeneratedGrain_IdlePassivation(t *testing.T) {
+	var passivated int32
+	pid := spawnHelloGrain(t, "passivated", &helloGrain{passivated: &passivated}, cluster.IdlePassivation(50*time.Millisecond))
+
+	time.Sleep(300 * time.Millisecond)
+	_, found := actor.ProcessRegistry.LocalPIDs.Get(pid.Id)
+	assert.False(t, found)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&passivated))
+}
+
+func TestGeneratedGrain_Handoff(t *testing.T) {
+	handoffs := make(chan string, 1)
+	pid := spawnHelloGrain(t, "handoff", &helloGrain{handoffs: handoffs})
+	defer rootContext.Stop(pid)
+
+	rootContext.Send(pid, &cluster.Handoff{})
+	select {
+	case id := <-handoffs:
+		assert.Equal(t, "handoff", id)
+	case <-time.After(time.Second):
+		t.Fatal("handoff not received")
+	}
+}
+
+func TestGeneratedGrain_ReceiveBroadcast(t *testing.T) {
+	broadcasts := make(chan interface{}, 1)
+	pid := spawnHelloGrain(t, "broadcast", &helloGrain{broadcasts: broadcasts})
+	defer rootContext.Stop(pid)
+
+	// the partition actors send the deserialized message of the BroadcastRequest to the activations
+	rootContext.Send(pid, &cluster.ClusterIdentity{Id: "reload"})
+	select {
+	case msg := <-broadcasts:
+		assert.Equal(t, &cluster.ClusterIdentity{Id: "reload"}, msg)
+	case <-time.After(time.Second):
+		t.Fatal("broadcast not received")
+	}
+}
@@ -0,0 +1 @@
+protoc -I=. -I=$GOPATH/src --gogoslick_out=. protos.proto
\ No newline at end of file
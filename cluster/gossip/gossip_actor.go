@@ -0,0 +1,309 @@
+package gossip
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/cluster"
+	"github.com/AsynkronIT/protoactor-go/scheduler"
+)
+
+func (m *Member) address() string {
+	return fmt.Sprintf("%v:%v", m.Host, m.Port)
+}
+
+// precedence orders the states of a member with the same incarnation, the higher one overrides the lower one
+var precedence = map[MemberState]int{ALIVE: 0, SUSPECT: 1, DEAD: 2, LEFT: 3}
+
+// overrides returns true if the update is newer than the known state of the member
+func overrides(update, known *Member) bool {
+	if update.Incarnation != known.Incarnation {
+		return update.Incarnation > known.Incarnation
+	}
+	return precedence[update.State] > precedence[known.State]
+}
+
+type memberState struct {
+	member *Member
+	// since is the time the member entered its current state
+	since time.Time
+}
+
+type probe struct {
+	seq    uint64
+	target string
+	acked  bool
+}
+
+type protocolTick struct{}
+
+type probeTimeout struct {
+	seq uint64
+}
+
+type startMonitoring struct{}
+
+type leave struct{}
+
+type updateStatusValue struct {
+	statusValue string
+}
+
+// gossipActor runs the SWIM protocol: every protocol period it pings one member, asking other members to ping it
+// when it does not answer in time. A member which does not answer either way is suspected and declared dead
+// when the suspicion is not refuted. The membership is piggybacked on every message
+type gossipActor struct {
+	config      *Config
+	clusterName string
+	self        *Member
+	seeds       []string
+	members     map[string]*memberState
+	probe       *probe
+	probeOrder  []string
+	seq         uint64
+	monitoring  bool
+	cancelTick  scheduler.CancelFunc
+	scheduler   *scheduler.TimerScheduler
+	pidFor      func(address string) *actor.PID
+	publish     func(cluster.ClusterTopologyEvent)
+	deserialize func(string) cluster.MemberStatusValue
+}
+
+func (state *gossipActor) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *actor.Started:
+		state.members = map[string]*memberState{
+			state.self.address(): {member: state.self, since: time.Now()},
+		}
+		state.scheduler = scheduler.NewTimerScheduler(scheduler.WithContext(ctx))
+		state.cancelTick = state.scheduler.SendRepeatedly(0, state.config.ProtocolPeriod, ctx.Self(), &protocolTick{})
+	case *actor.Stopping:
+		state.cancelTick()
+	case *startMonitoring:
+		state.monitoring = true
+		state.notifyTopology()
+	case *updateStatusValue:
+		state.self.StatusValue = msg.statusValue
+		state.self.Incarnation++
+	case *leave:
+		state.self.State = LEFT
+		state.self.Incarnation++
+		for address := range state.members {
+			if address != state.self.address() {
+				ctx.Request(state.pidFor(address), state.newPing(0))
+			}
+		}
+		ctx.Respond(&leave{})
+	case *protocolTick:
+		state.tick(ctx)
+	case *probeTimeout:
+		if state.probe == nil || state.probe.seq != msg.seq || state.probe.acked {
+			return
+		}
+		// ask other members to ping the target on our behalf
+		request := &PingRequest{Cluster: state.clusterName, Seq: msg.seq, Target: state.probe.target, Members: state.memberList()}
+		for _, address := range state.randomMembers(state.config.IndirectChecks, state.probe.target) {
+			ctx.Request(state.pidFor(address), request)
+		}
+	case *Ping:
+		if msg.Cluster != state.clusterName {
+			return
+		}
+		state.merge(msg.Members)
+		ctx.Respond(&Ack{Cluster: state.clusterName, Seq: msg.Seq, From: state.self.address(), Members: state.memberList()})
+	case *Ack:
+		if msg.Cluster != state.clusterName {
+			return
+		}
+		state.merge(msg.Members)
+		if state.probe != nil && state.probe.seq == msg.Seq && state.probe.target == msg.From {
+			state.probe.acked = true
+		}
+	case *PingRequest:
+		if msg.Cluster != state.clusterName {
+			return
+		}
+		state.merge(msg.Members)
+		ping := state.newPing(msg.Seq)
+		future := ctx.RequestFuture(state.pidFor(msg.Target), ping, state.config.PingTimeout)
+		requester := ctx.Sender()
+		go func() {
+			// forward the ack of the target to the requester
+			if res, err := future.Result(); err == nil && requester != nil {
+				rootContext.Send(requester, res)
+			}
+		}()
+	}
+}
+
+func (state *gossipActor) newPing(seq uint64) *Ping {
+	return &Ping{Cluster: state.clusterName, Seq: seq, From: state.self.address(), Members: state.memberList()}
+}
+
+func (state *gossipActor) tick(ctx actor.Context) {
+	now := time.Now()
+	changed := false
+
+	// the previous probe did not succeed
+	if state.probe != nil && !state.probe.acked {
+		if known, ok := state.members[state.probe.target]; ok && known.member.State == ALIVE {
+			state.setState(known, SUSPECT, now)
+			changed = true
+		}
+	}
+	state.probe = nil
+
+	for address, known := range state.members {
+		switch known.member.State {
+		case SUSPECT:
+			if now.Sub(known.since) > state.config.SuspicionTimeout {
+				state.setState(known, DEAD, now)
+				changed = true
+			}
+		case DEAD, LEFT:
+			// keep the member for a while to not let older gossip bring it back
+			if now.Sub(known.since) > state.config.DeadMemberRetention {
+				delete(state.members, address)
+			}
+		}
+	}
+	if changed {
+		state.notifyTopology()
+	}
+
+	if len(state.randomMembers(1, "")) == 0 {
+		// not joined yet or all other members are gone, try the seeds
+		for _, seed := range state.seeds {
+			if seed != state.self.address() {
+				ctx.Request(state.pidFor(seed), state.newPing(0))
+			}
+		}
+		return
+	}
+
+	target := state.nextProbeTarget()
+	if target == "" {
+		return
+	}
+	state.seq++
+	state.probe = &probe{seq: state.seq, target: target}
+	ctx.Request(state.pidFor(target), state.newPing(state.seq))
+	state.scheduler.SendOnce(state.config.PingTimeout, ctx.Self(), &probeTimeout{seq: state.seq})
+}
+
+// nextProbeTarget returns the members in a random order, a new order is made once all members have been probed
+func (state *gossipActor) nextProbeTarget() string {
+	for {
+		if len(state.probeOrder) == 0 {
+			state.probeOrder = state.randomMembers(len(state.members), "")
+			if len(state.probeOrder) == 0 {
+				return ""
+			}
+		}
+		target := state.probeOrder[0]
+		state.probeOrder = state.probeOrder[1:]
+		if known, ok := state.members[target]; ok && isReachable(known.member) {
+			return target
+		}
+	}
+}
+
+func isReachable(member *Member) bool {
+	return member.State == ALIVE || member.State == SUSPECT
+}
+
+// randomMembers returns up to count reachable members other than this member and except
+func (state *gossipActor) randomMembers(count int, except string) []string {
+	res := make([]string, 0, len(state.members))
+	for address, known := range state.members {
+		if address != state.self.address() && address != except && isReachable(known.member) {
+			res = append(res, address)
+		}
+	}
+	rand.Shuffle(len(res), func(i, j int) { res[i], res[j] = res[j], res[i] })
+	if len(res) > count {
+		res = res[:count]
+	}
+	return res
+}
+
+func (state *gossipActor) setState(known *memberState, memberState MemberState, now time.Time) {
+	updated := *known.member
+	updated.State = memberState
+	known.member = &updated
+	known.since = now
+}
+
+func (state *gossipActor) memberList() []*Member {
+	res := make([]*Member, 0, len(state.members))
+	for _, known := range state.members {
+		// copied, as this member changes in place and local receivers keep the gossiped members
+		member := *known.member
+		res = append(res, &member)
+	}
+	return res
+}
+
+// merge applies the gossiped membership and notifies the topology if it changed
+func (state *gossipActor) merge(members []*Member) {
+	now := time.Now()
+	changed := false
+	for _, update := range members {
+		address := update.address()
+		if address == state.self.address() {
+			// refute the suspicion of this member by raising the incarnation
+			if state.self.State == ALIVE && update.State != ALIVE && update.Incarnation >= state.self.Incarnation {
+				state.self.Incarnation = update.Incarnation + 1
+			}
+			continue
+		}
+
+		known, ok := state.members[address]
+		if !ok {
+			if !isReachable(update) {
+				continue
+			}
+			state.members[address] = &memberState{member: update, since: now}
+			changed = true
+			continue
+		}
+		if overrides(update, known.member) {
+			stateChanged := update.State != known.member.State
+			topologyChanged := stateChanged || update.StatusValue != known.member.StatusValue
+			known.member = update
+			if stateChanged {
+				known.since = now
+			}
+			changed = changed || topologyChanged
+		}
+	}
+	if changed {
+		state.notifyTopology()
+	}
+}
+
+func (state *gossipActor) notifyTopology() {
+	if !state.monitoring {
+		return
+	}
+	res := make(cluster.ClusterTopologyEvent, 0, len(state.members))
+	for _, known := range state.members {
+		member := known.member
+		if !isReachable(member) {
+			continue
+		}
+		res = append(res, &cluster.MemberStatus{
+			MemberID:    fmt.Sprintf("%v/%v", state.clusterName, member.address()),
+			Host:        member.Host,
+			Port:        int(member.Port),
+			Kinds:       member.Kinds,
+			Alive:       true,
+			StatusValue: state.deserialize(member.StatusValue),
+		})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].MemberID < res[j].MemberID })
+	state.publish(res)
+}
@@ -0,0 +1,135 @@
+package gossip
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+)
+
+// testNode runs the gossip actor of a member in process, members reach each other through local PIDs
+type testNode struct {
+	pid      *actor.PID
+	mu       sync.Mutex
+	topology cluster.ClusterTopologyEvent
+}
+
+func (n *testNode) members() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	res := make([]string, 0, len(n.topology))
+	for _, m := range n.topology {
+		res = append(res, m.MemberID)
+	}
+	return res
+}
+
+func testConfig() *Config {
+	return &Config{
+		ProtocolPeriod:      20 * time.Millisecond,
+		PingTimeout:         5 * time.Millisecond,
+		IndirectChecks:      2,
+		SuspicionTimeout:    100 * time.Millisecond,
+		DeadMemberRetention: time.Second,
+	}
+}
+
+func localPID(address string) *actor.PID {
+	return actor.NewLocalPID("gossip-test-" + address)
+}
+
+func startTestNode(t *testing.T, port int, seeds ...string) *testNode {
+	node := &testNode{}
+	self := &Member{Host: "127.0.0.1", Port: int32(port), Kinds: []string{"kind"}, State: ALIVE, Incarnation: 1}
+	props := actor.PropsFromProducer(func() actor.Actor {
+		return &gossipActor{
+			config:      testConfig(),
+			clusterName: "mycluster",
+			self:        self,
+			seeds:       seeds,
+			pidFor:      localPID,
+			publish: func(topology cluster.ClusterTopologyEvent) {
+				node.mu.Lock()
+				node.topology = topology
+				node.mu.Unlock()
+			},
+			deserialize: (&cluster.NilMemberStatusValueSerializer{}).Deserialize,
+		}
+	})
+	pid, err := rootContext.SpawnNamed(props, localPID(self.address()).Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.pid = pid
+	rootContext.Send(pid, &startMonitoring{})
+	return node
+}
+
+func memberIDs(ports ...int) []string {
+	res := make([]string, len(ports))
+	for i, port := range ports {
+		res[i] = fmt.Sprintf("mycluster/127.0.0.1:%v", port)
+	}
+	return res
+}
+
+func eventually(t *testing.T, expected []string, node *testNode) {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if assert.ObjectsAreEqual(expected, node.members()) {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, expected, node.members())
+}
+
+func TestGossip_JoinAndFail(t *testing.T) {
+	seed := "127.0.0.1:9001"
+	node1 := startTestNode(t, 9001)
+	node2 := startTestNode(t, 9002, seed)
+	node3 := startTestNode(t, 9003, seed)
+	defer rootContext.Stop(node1.pid)
+	defer rootContext.Stop(node2.pid)
+
+	all := memberIDs(9001, 9002, 9003)
+	eventually(t, all, node1)
+	eventually(t, all, node2)
+	eventually(t, all, node3)
+
+	// node3 silently dies, it is suspected and then declared dead
+	rootContext.StopFuture(node3.pid).Wait()
+	remaining := memberIDs(9001, 9002)
+	eventually(t, remaining, node1)
+	eventually(t, remaining, node2)
+}
+
+func TestGossip_Leave(t *testing.T) {
+	seed := "127.0.0.1:9011"
+	node1 := startTestNode(t, 9011)
+	node2 := startTestNode(t, 9012, seed)
+	defer rootContext.Stop(node1.pid)
+
+	all := memberIDs(9011, 9012)
+	eventually(t, all, node1)
+	eventually(t, all, node2)
+
+	_, err := rootContext.RequestFuture(node2.pid, &leave{}, time.Second).Result()
+	assert.NoError(t, err)
+	rootContext.StopFuture(node2.pid).Wait()
+	eventually(t, memberIDs(9011), node1)
+}
+
+func TestMerge_Precedence(t *testing.T) {
+	known := &Member{State: ALIVE, Incarnation: 2}
+
+	assert.True(t, overrides(&Member{State: SUSPECT, Incarnation: 2}, known))
+	assert.True(t, overrides(&Member{State: ALIVE, Incarnation: 3}, known))
+	assert.False(t, overrides(&Member{State: DEAD, Incarnation: 1}, known))
+	assert.False(t, overrides(&Member{State: ALIVE, Incarnation: 2}, known))
+	assert.True(t, overrides(&Member{State: LEFT, Incarnation: 2}, &Member{State: DEAD, Incarnation: 2}))
+}
@@ -0,0 +1,135 @@
+package gossip
+
+import (
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/cluster"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+)
+
+var rootContext = actor.EmptyRootContext
+
+// actorName is the name of the actor running the gossip protocol on every member
+const actorName = "gossip"
+
+// Config configures the gossip protocol
+type Config struct {
+	// ProtocolPeriod is the interval at which a member pings another member
+	ProtocolPeriod time.Duration
+	// PingTimeout is the time to wait for a ping to be answered before asking other members to ping the member
+	PingTimeout time.Duration
+	// IndirectChecks is the number of members asked to ping a member which does not answer
+	IndirectChecks int
+	// SuspicionTimeout is the time a suspected member has to refute the suspicion before it is declared dead
+	SuspicionTimeout time.Duration
+	// DeadMemberRetention is the time a dead or left member is remembered, to not let older gossip bring it back
+	DeadMemberRetention time.Duration
+}
+
+// DefaultConfig returns the configuration used by New
+func DefaultConfig() *Config {
+	return &Config{
+		ProtocolPeriod:      1 * time.Second,
+		PingTimeout:         300 * time.Millisecond,
+		IndirectChecks:      3,
+		SuspicionTimeout:    5 * time.Second,
+		DeadMemberRetention: 60 * time.Second,
+	}
+}
+
+// GossipProvider maintains the cluster membership with a SWIM style gossip protocol over remoting,
+// so no external service is needed. Members join through the seed members, which can be any members of the cluster.
+// This suits small clusters, the whole membership is gossiped with every message
+type GossipProvider struct {
+	deregistered bool
+	config       *Config
+	seeds        []string
+	clusterName  string
+	serializer   cluster.MemberStatusValueSerializer
+	pid          *actor.PID
+}
+
+// New creates a provider joining the cluster through the seed members, given as "host:port" remoting addresses.
+// The first member of a cluster may have no seeds or list itself
+func New(seeds ...string) *GossipProvider {
+	return NewWithConfig(DefaultConfig(), seeds...)
+}
+
+func NewWithConfig(config *Config, seeds ...string) *GossipProvider {
+	return &GossipProvider{
+		config: config,
+		seeds:  seeds,
+	}
+}
+
+func (p *GossipProvider) RegisterMember(clusterName string, address string, port int, knownKinds []string,
+	statusValue cluster.MemberStatusValue, serializer cluster.MemberStatusValueSerializer) error {
+	p.clusterName = clusterName
+	p.serializer = serializer
+
+	self := &Member{
+		Host:        address,
+		Port:        int32(port),
+		Kinds:       knownKinds,
+		StatusValue: serializer.Serialize(statusValue),
+		State:       ALIVE,
+		// a restarted member has to override what is known about its previous run
+		Incarnation: uint64(time.Now().UnixNano()),
+	}
+	props := actor.PropsFromProducer(func() actor.Actor {
+		return &gossipActor{
+			config:      p.config,
+			clusterName: clusterName,
+			self:        self,
+			seeds:       p.seeds,
+			pidFor: func(address string) *actor.PID {
+				return actor.NewPID(address, actorName)
+			},
+			publish: func(topology cluster.ClusterTopologyEvent) {
+				eventstream.Publish(topology)
+			},
+			deserialize: serializer.Deserialize,
+		}
+	})
+	pid, err := rootContext.SpawnNamed(props, actorName)
+	if err != nil {
+		return err
+	}
+	p.pid = pid
+
+	// publish this member directly after registering, so the local node sees its own information upon startup
+	eventstream.Publish(cluster.ClusterTopologyEvent{&cluster.MemberStatus{
+		MemberID:    clusterName + "/" + self.address(),
+		Host:        address,
+		Port:        port,
+		Kinds:       knownKinds,
+		Alive:       true,
+		StatusValue: statusValue,
+	}})
+	return nil
+}
+
+func (p *GossipProvider) MonitorMemberStatusChanges() {
+	rootContext.Send(p.pid, &startMonitoring{})
+}
+
+func (p *GossipProvider) UpdateMemberStatusValue(statusValue cluster.MemberStatusValue) error {
+	rootContext.Send(p.pid, &updateStatusValue{statusValue: p.serializer.Serialize(statusValue)})
+	return nil
+}
+
+// DeregisterMember tells the other members that this member leaves and stops gossiping
+func (p *GossipProvider) DeregisterMember() error {
+	_, err := rootContext.RequestFuture(p.pid, &leave{}, p.config.ProtocolPeriod).Result()
+	rootContext.StopFuture(p.pid).Wait()
+	p.deregistered = true
+	return err
+}
+
+func (p *GossipProvider) Shutdown() error {
+	if !p.deregistered {
+		return p.DeregisterMember()
+	}
+	return nil
+}
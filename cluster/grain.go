@@ -1,6 +1,12 @@
 package cluster
 
-import "time"
+import (
+	"errors"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/gogo/protobuf/proto"
+)
 
 type Grain struct {
 	id string
@@ -14,10 +20,34 @@ func (g *Grain) Init(id string) {
 	g.id = id
 }
 
+// ErrResponseDeferred is returned by the grain methods which respond later with RespondGrain,
+// typically from the continuation of a reentrant call
+var ErrResponseDeferred = errors.New("cluster: grain response deferred")
+
+// RespondGrain responds to the grain request being processed with the result of the method
+func RespondGrain(ctx GrainContext, res proto.Message, err error) {
+	if err != nil {
+		ctx.Respond(&GrainErrorResponse{Err: err.Error()})
+		return
+	}
+	bytes, err := proto.Marshal(res)
+	if err != nil {
+		ctx.Respond(&GrainErrorResponse{Err: err.Error()})
+		return
+	}
+	ctx.Respond(&GrainResponse{MessageData: bytes})
+}
+
 type GrainCallOptions struct {
-	RetryCount  int
-	Timeout     time.Duration
+	// RetryCount is the number of attempts of a call, 1 disables retrying
+	RetryCount int
+	Timeout    time.Duration
+	// RetryAction is called after a failed attempt, e.g. to back off before retrying
 	RetryAction func(n int)
+	// RetryableError tells if a call failing with the error may be retried, only timeouts are retried when it is nil
+	RetryableError func(err error) bool
+	// Headers are sent along with the requests
+	Headers map[string]string
 }
 
 var defaultGrainCallOptions *GrainCallOptions
@@ -54,3 +84,44 @@ func (config *GrainCallOptions) WithRetryAction(act func(i int)) *GrainCallOptio
 	config.RetryAction = act
 	return config
 }
+
+// WithNoRetry makes a single attempt, for calls which are not idempotent
+func (config *GrainCallOptions) WithNoRetry() *GrainCallOptions {
+	config.RetryCount = 1
+	return config
+}
+
+// WithExponentialBackoff waits base, then twice as long after every failed attempt, up to max
+func (config *GrainCallOptions) WithExponentialBackoff(base time.Duration, max time.Duration) *GrainCallOptions {
+	config.RetryAction = func(i int) {
+		backoff := base << uint(i)
+		if backoff > max || backoff <= 0 {
+			backoff = max
+		}
+		time.Sleep(backoff)
+	}
+	return config
+}
+
+func (config *GrainCallOptions) WithRetryableError(retryable func(err error) bool) *GrainCallOptions {
+	config.RetryableError = retryable
+	return config
+}
+
+// IsRetryable returns true if a call failing with the error may be retried
+func (config *GrainCallOptions) IsRetryable(err error) bool {
+	if config.RetryableError == nil {
+		return err == actor.ErrTimeout
+	}
+	return config.RetryableError(err)
+}
+
+// GrainCallOptionsForKind returns the options configured for the kind, or the default options
+func GrainCallOptionsForKind(kind string) *GrainCallOptions {
+	if cfg != nil {
+		if opts, ok := cfg.KindGrainCallOptions[kind]; ok {
+			return opts
+		}
+	}
+	return DefaultGrainCallOptions()
+}
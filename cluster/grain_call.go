@@ -0,0 +1,157 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	"github.com/gogo/protobuf/proto"
+)
+
+// GrainCallOption changes the options of a single grain call
+type GrainCallOption func(opts *GrainCallOptions)
+
+// WithCallOptions replaces the options of the kind by opts
+func WithCallOptions(opts *GrainCallOptions) GrainCallOption {
+	return func(o *GrainCallOptions) {
+		*o = *opts
+		o.Headers = copyHeaders(opts.Headers)
+	}
+}
+
+// WithCallTimeout sets the timeout of every attempt of the call
+func WithCallTimeout(timeout time.Duration) GrainCallOption {
+	return func(o *GrainCallOptions) {
+		o.Timeout = timeout
+	}
+}
+
+// WithCallRetry sets the number of attempts of the call and the action called after a failed attempt, which may be nil
+func WithCallRetry(count int, action func(i int)) GrainCallOption {
+	return func(o *GrainCallOptions) {
+		o.RetryCount = count
+		o.RetryAction = action
+	}
+}
+
+// WithCallHeader sends the header along with the request, grains read it with GrainHeaders
+func WithCallHeader(key string, value string) GrainCallOption {
+	return func(o *GrainCallOptions) {
+		if o.Headers == nil {
+			o.Headers = make(map[string]string)
+		}
+		o.Headers[key] = value
+	}
+}
+
+// ApplyGrainCallOptions returns a copy of the options of the kind changed by opts
+func ApplyGrainCallOptions(kind string, opts ...GrainCallOption) *GrainCallOptions {
+	res := *GrainCallOptionsForKind(kind)
+	res.Headers = copyHeaders(res.Headers)
+	for _, opt := range opts {
+		opt(&res)
+	}
+	return &res
+}
+
+func copyHeaders(headers map[string]string) map[string]string {
+	if headers == nil {
+		return nil
+	}
+	res := make(map[string]string, len(headers))
+	for k, v := range headers {
+		res[k] = v
+	}
+	return res
+}
+
+// GrainHeaders returns the headers of the grain request being processed
+func GrainHeaders(ctx GrainContext) map[string]string {
+	if req, ok := ctx.Message().(*GrainRequest); ok {
+		return req.Headers
+	}
+	return nil
+}
+
+// CallGrain requests the method of the grain and unmarshals its response into res, retrying as configured by opts.
+// The call stops when ctx is done, returning the error of ctx; the timeout of an attempt is shortened to the deadline of ctx
+func CallGrain(ctx context.Context, id string, kind string, methodIndex int32, req proto.Message, res proto.Message, opts *GrainCallOptions) error {
+	return callGrain(ctx, func() (*actor.PID, error) {
+		pid, statusCode := Get(id, kind)
+		if statusCode != remote.ResponseStatusCodeOK && statusCode != remote.ResponseStatusCodePROCESSNAMEALREADYEXIST {
+			return nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode)
+		}
+		return pid, nil
+	}, methodIndex, req, res, opts)
+}
+
+func callGrain(ctx context.Context, getPid func() (*actor.PID, error), methodIndex int32, req proto.Message, res proto.Message, opts *GrainCallOptions) error {
+	bytes, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	request := &GrainRequest{MethodIndex: methodIndex, MessageData: bytes, Headers: opts.Headers}
+
+	attempts := opts.RetryCount
+	if attempts < 1 {
+		attempts = 1
+	}
+	for i := 0; i < attempts; i++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		err = requestGrain(ctx, getPid, request, res, opts.Timeout)
+		if err == nil || !opts.IsRetryable(err) {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if opts.RetryAction != nil && i < attempts-1 {
+			opts.RetryAction(i)
+		}
+	}
+	return err
+}
+
+func requestGrain(ctx context.Context, getPid func() (*actor.PID, error), request *GrainRequest, res proto.Message, timeout time.Duration) error {
+	pid, err := getPid()
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < timeout {
+		timeout = time.Until(deadline)
+		if timeout <= 0 {
+			return context.DeadlineExceeded
+		}
+	}
+
+	f := rootContext.RequestFuture(pid, request, timeout)
+	var response interface{}
+	done := make(chan struct{})
+	go func() {
+		response, err = f.Result()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		rootContext.Stop(f.PID())
+		return ctx.Err()
+	}
+	if err != nil {
+		return err
+	}
+
+	switch msg := response.(type) {
+	case *GrainResponse:
+		return proto.Unmarshal(msg.MessageData, res)
+	case *GrainErrorResponse:
+		return errors.New(msg.Err)
+	default:
+		return errors.New("unknown response")
+	}
+}
@@ -0,0 +1,80 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+)
+
+func spawnTestGrain(fn func(ctx actor.Context, req *GrainRequest)) *actor.PID {
+	return rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		if req, ok := ctx.Message().(*GrainRequest); ok {
+			fn(ctx, req)
+		}
+	}))
+}
+
+func TestCallGrain(t *testing.T) {
+	cfg = NewClusterConfig("mycluster", "127.0.0.1:0", nil)
+	defer func() { cfg = nil }()
+
+	grain := spawnTestGrain(func(ctx actor.Context, req *GrainRequest) {
+		assert.Equal(t, "value", GrainHeaders(ctx)["key"])
+		RespondGrain(ctx, &ClusterIdentity{Id: "res"}, nil)
+	})
+	defer rootContext.Stop(grain)
+	getPid := func() (*actor.PID, error) { return grain, nil }
+
+	res := &ClusterIdentity{}
+	opts := ApplyGrainCallOptions("kind", WithCallHeader("key", "value"))
+	err := callGrain(context.Background(), getPid, 0, &ClusterIdentity{Id: "req"}, res, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "res", res.Id)
+	// the options of the kind are not changed
+	assert.Nil(t, GrainCallOptionsForKind("kind").Headers)
+}
+
+func TestCallGrain_Retry(t *testing.T) {
+	cfg = NewClusterConfig("mycluster", "127.0.0.1:0", nil)
+	defer func() { cfg = nil }()
+
+	attempts := 0
+	grain := spawnTestGrain(func(ctx actor.Context, req *GrainRequest) {
+		attempts++
+		if attempts < 3 {
+			// times out
+			return
+		}
+		RespondGrain(ctx, nil, errors.New("failed"))
+	})
+	defer rootContext.Stop(grain)
+	getPid := func() (*actor.PID, error) { return grain, nil }
+
+	retried := 0
+	opts := ApplyGrainCallOptions("kind", WithCallTimeout(10*time.Millisecond), WithCallRetry(5, func(i int) { retried++ }))
+	err := callGrain(context.Background(), getPid, 0, &ClusterIdentity{}, &ClusterIdentity{}, opts)
+	// the error of the grain is not retried
+	assert.EqualError(t, err, "failed")
+	assert.Equal(t, 3, attempts)
+	assert.Equal(t, 2, retried)
+}
+
+func TestCallGrain_ContextDone(t *testing.T) {
+	cfg = NewClusterConfig("mycluster", "127.0.0.1:0", nil)
+	defer func() { cfg = nil }()
+
+	grain := spawnTestGrain(func(ctx actor.Context, req *GrainRequest) {})
+	defer rootContext.Stop(grain)
+	getPid := func() (*actor.PID, error) { return grain, nil }
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := callGrain(ctx, getPid, 0, &ClusterIdentity{}, &ClusterIdentity{}, ApplyGrainCallOptions("kind", WithCallTimeout(time.Second)))
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.True(t, time.Since(start) < 500*time.Millisecond)
+}
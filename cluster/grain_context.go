@@ -25,6 +25,13 @@ type GrainContext interface {
 	// Message returns the current message to be processed
 	Message() interface{}
 
+	// Respond sends a response to the sender of the current message
+	Respond(response interface{})
+
+	// AwaitFuture calls the continuation within the grain once the future completes, with the current message restored.
+	// The grain processes other messages meanwhile, which makes the calls to other grains reentrant
+	AwaitFuture(f *actor.Future, continuation func(res interface{}, err error))
+
 	// Tell sends a message to the given PID
 	Send(pid *actor.PID, message interface{})
 
@@ -0,0 +1,98 @@
+package cluster
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrainCallOptions_IsRetryable(t *testing.T) {
+	cfg = NewClusterConfig("mycluster", "127.0.0.1:0", nil)
+	defer func() { cfg = nil }()
+
+	errConflict := errors.New("conflict")
+	opts := NewGrainCallOptions()
+	assert.True(t, opts.IsRetryable(actor.ErrTimeout))
+	assert.False(t, opts.IsRetryable(errConflict))
+
+	opts.WithRetryableError(func(err error) bool { return err == errConflict })
+	assert.False(t, opts.IsRetryable(actor.ErrTimeout))
+	assert.True(t, opts.IsRetryable(errConflict))
+
+	assert.Equal(t, 1, NewGrainCallOptions().WithNoRetry().RetryCount)
+}
+
+func TestGrainCallOptions_ExponentialBackoff(t *testing.T) {
+	cfg = NewClusterConfig("mycluster", "127.0.0.1:0", nil)
+	defer func() { cfg = nil }()
+
+	opts := NewGrainCallOptions().WithExponentialBackoff(10*time.Millisecond, 30*time.Millisecond)
+	for i, expected := range []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 30 * time.Millisecond, 30 * time.Millisecond} {
+		start := time.Now()
+		opts.RetryAction(i)
+		elapsed := time.Since(start)
+		assert.True(t, elapsed >= expected, "attempt %v waited %v", i, elapsed)
+		assert.True(t, elapsed < expected+50*time.Millisecond, "attempt %v waited %v", i, elapsed)
+	}
+}
+
+func TestGrainCallOptionsForKind(t *testing.T) {
+	cfg = NewClusterConfig("mycluster", "127.0.0.1:0", nil)
+	defer func() { cfg = nil }()
+
+	payment := NewGrainCallOptions().WithNoRetry()
+	cfg.WithKindGrainCallOptions("payment", payment)
+	assert.Same(t, payment, GrainCallOptionsForKind("payment"))
+	assert.Same(t, DefaultGrainCallOptions(), GrainCallOptionsForKind("other"))
+}
+
+func TestRespondGrain_Reentrant(t *testing.T) {
+	// a grain calls b, which calls a back before responding
+	var b *actor.PID
+	a := rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		switch msg := ctx.Message().(type) {
+		case *GrainRequest:
+			switch msg.MethodIndex {
+			case 1:
+				RespondGrain(ctx, &GrainErrorResponse{Err: "from a"}, nil)
+				return
+			case 2:
+				RespondGrain(ctx, nil, errors.New("failed"))
+				return
+			}
+			ctx.AwaitFuture(ctx.RequestFuture(b, &GrainRequest{}, time.Second), func(res interface{}, err error) {
+				if err != nil {
+					RespondGrain(ctx, nil, err)
+					return
+				}
+				RespondGrain(ctx, &GrainErrorResponse{Err: "from b"}, nil)
+			})
+		}
+	}))
+	b = rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		if _, ok := ctx.Message().(*GrainRequest); ok {
+			res, err := ctx.RequestFuture(a, &GrainRequest{MethodIndex: 1}, time.Second).Result()
+			if err != nil {
+				RespondGrain(ctx, nil, err)
+				return
+			}
+			ctx.Respond(res)
+		}
+	}))
+
+	res, err := rootContext.RequestFuture(a, &GrainRequest{}, 2*time.Second).Result()
+	assert.NoError(t, err)
+	if assert.IsType(t, &GrainResponse{}, res) {
+		msg := &GrainErrorResponse{}
+		assert.NoError(t, msg.Unmarshal(res.(*GrainResponse).MessageData))
+		assert.Equal(t, "from b", msg.Err)
+	}
+
+	// errors are responded as GrainErrorResponse
+	res, err = rootContext.RequestFuture(a, &GrainRequest{MethodIndex: 2}, time.Second).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, &GrainErrorResponse{Err: "failed"}, res)
+}
@@ -0,0 +1,100 @@
+package cluster
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+)
+
+// HealthStatus is the health of this member in the cluster
+type HealthStatus struct {
+	// Joined is true when this member sees itself in the cluster topology
+	Joined bool `json:"joined"`
+	// Members is the number of alive members, this member included, the clients are not counted
+	Members int `json:"members"`
+	// PartitionsSettled is true when the partitions of this member have handled the last topology change, and the identities
+	// they no longer own were taken over by the members owning them or their transfer timed out
+	PartitionsSettled bool `json:"partitionsSettled"`
+}
+
+// Ready returns true when the member can serve requests
+func (h *HealthStatus) Ready() bool {
+	return h.Joined && h.PartitionsSettled
+}
+
+// Health returns the health of this member, it is not joined when the cluster is not started
+func Health() *HealthStatus {
+	ml := memberList
+	if ml == nil {
+		return &HealthStatus{}
+	}
+
+	ml.mutex.RLock()
+	defer ml.mutex.RUnlock()
+
+	res := &HealthStatus{}
+	for address, m := range ml.members {
+		if !m.Alive {
+			continue
+		}
+		if address == actor.ProcessRegistry.Address {
+			res.Joined = true
+		}
+		if !m.Client {
+			res.Members++
+		}
+	}
+	res.PartitionsSettled = partition == nil || partition.isSettled(ml.topologyVersion)
+	return res
+}
+
+// HealthHandler serves the health of this member as JSON, with status 503 when it is not ready.
+// It suits the readiness probes of Kubernetes
+func HealthHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		health := Health()
+		w.Header().Set("Content-Type", "application/json")
+		if !health.Ready() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(health)
+	})
+}
+
+// SubscribeMemberJoined calls fn for every member joining the cluster, unsubscribe with eventstream.Unsubscribe
+func SubscribeMemberJoined(fn func(*MemberJoinedEvent)) *eventstream.Subscription {
+	return eventstream.Subscribe(func(evt interface{}) {
+		if e, ok := evt.(*MemberJoinedEvent); ok {
+			fn(e)
+		}
+	})
+}
+
+// SubscribeMemberLeft calls fn for every member leaving the cluster
+func SubscribeMemberLeft(fn func(*MemberLeftEvent)) *eventstream.Subscription {
+	return eventstream.Subscribe(func(evt interface{}) {
+		if e, ok := evt.(*MemberLeftEvent); ok {
+			fn(e)
+		}
+	})
+}
+
+// SubscribeMemberStatus calls fn for every member event: joined, left, rejoined, unavailable and available
+func SubscribeMemberStatus(fn func(MemberStatusEvent)) *eventstream.Subscription {
+	return eventstream.Subscribe(func(evt interface{}) {
+		if e, ok := evt.(MemberStatusEvent); ok {
+			fn(e)
+		}
+	})
+}
+
+// SubscribeClusterTopology calls fn with every topology published by the cluster provider
+func SubscribeClusterTopology(fn func(ClusterTopologyEvent)) *eventstream.Subscription {
+	return eventstream.Subscribe(func(evt interface{}) {
+		if e, ok := evt.(ClusterTopologyEvent); ok {
+			fn(e)
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package cluster
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealth(t *testing.T) {
+	assert.False(t, Health().Ready())
+
+	cfg = NewClusterConfig("mycluster", "127.0.0.1:0", nil).WithTimeout(10 * time.Second)
+	defer func() { cfg = nil }()
+	address := actor.ProcessRegistry.Address
+	actor.ProcessRegistry.Address = "127.0.0.1:1"
+	defer func() { actor.ProcessRegistry.Address = address }()
+	setupMemberList()
+	defer stopMemberList()
+	setupPartition([]string{"kind"})
+	defer stopPartition()
+
+	self := &MemberStatus{Host: "127.0.0.1", Port: 1, Kinds: []string{"kind"}, Alive: true}
+	other := &MemberStatus{Host: "127.0.0.1", Port: 2, Kinds: []string{"kind"}, Alive: true}
+	eventstream.Publish(ClusterTopologyEvent{self})
+	assert.Eventually(t, func() bool { return Health().Ready() }, time.Second, 10*time.Millisecond)
+
+	// an identity this member owns until the other member joins
+	strategy := cfg.MemberStrategyBuilder("kind")
+	strategy.AddMember(self)
+	strategy.AddMember(other)
+	var name string
+	for i := 0; name == ""; i++ {
+		if n := fmt.Sprintf("name%v", i); cfg.getPartition(n, strategy) == other.Address() {
+			name = n
+		}
+	}
+	grain := rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {}))
+	defer func() { rootContext.StopFuture(grain).Wait() }()
+	_, err := rootContext.RequestFuture(partition.kindPIDMap["kind"], &TakeOwnership{Name: name, Pid: grain}, time.Second).Result()
+	assert.NoError(t, err)
+
+	// the transfer to the other member ends up in the dead letters as remoting is not started
+	transfers := make(chan *actor.DeadLetterEvent, 10)
+	sub := eventstream.Subscribe(func(m interface{}) {
+		if dl, ok := m.(*actor.DeadLetterEvent); ok && dl.PID.Equal(actor.NewPID(other.Address(), "partition-kind")) {
+			transfers <- dl
+		}
+	})
+	defer eventstream.Unsubscribe(sub)
+
+	eventstream.Publish(ClusterTopologyEvent{
+		self,
+		other,
+		&MemberStatus{Host: "127.0.0.1", Port: 3, Alive: false},
+		&MemberStatus{Host: "127.0.0.1", Port: 4, Kinds: []string{clientKind}, Alive: true},
+	})
+	var transfer *actor.DeadLetterEvent
+	select {
+	case transfer = <-transfers:
+	case <-time.After(time.Second):
+		t.Fatal("ownership not transferred")
+	}
+
+	// the partitions are not settled until the other member took the identity over
+	health := Health()
+	assert.True(t, health.Joined)
+	assert.Equal(t, 2, health.Members)
+	assert.False(t, health.PartitionsSettled)
+
+	rec := httptest.NewRecorder()
+	HealthHandler().ServeHTTP(rec, httptest.NewRequest("GET", "/health", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.JSONEq(t, `{"joined":true,"members":2,"partitionsSettled":false}`, rec.Body.String())
+
+	rootContext.Send(transfer.Sender, &TakeOwnershipResponse{})
+	assert.Eventually(t, func() bool { return Health().Ready() }, time.Second, 10*time.Millisecond)
+	rec = httptest.NewRecorder()
+	HealthHandler().ServeHTTP(rec, httptest.NewRequest("GET", "/health", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestSubscribeMemberEvents(t *testing.T) {
+	cfg = NewClusterConfig("mycluster", "127.0.0.1:0", nil)
+	defer func() { cfg = nil }()
+	setupMemberList()
+	defer stopMemberList()
+
+	var joined []*MemberJoinedEvent
+	var left []*MemberLeftEvent
+	var statuses []MemberStatusEvent
+	var topologies []ClusterTopologyEvent
+	subs := []*eventstream.Subscription{
+		SubscribeMemberJoined(func(e *MemberJoinedEvent) { joined = append(joined, e) }),
+		SubscribeMemberLeft(func(e *MemberLeftEvent) { left = append(left, e) }),
+		SubscribeMemberStatus(func(e MemberStatusEvent) { statuses = append(statuses, e) }),
+		SubscribeClusterTopology(func(e ClusterTopologyEvent) { topologies = append(topologies, e) }),
+	}
+	for _, sub := range subs {
+		defer eventstream.Unsubscribe(sub)
+	}
+
+	eventstream.Publish(ClusterTopologyEvent{&MemberStatus{Host: "127.0.0.1", Port: 1, Alive: true}})
+	eventstream.Publish(ClusterTopologyEvent{})
+
+	if assert.Len(t, joined, 1) {
+		assert.Equal(t, "127.0.0.1:1", joined[0].Name())
+	}
+	if assert.Len(t, left, 1) {
+		assert.Equal(t, "127.0.0.1:1", left[0].Name())
+	}
+	assert.Len(t, statuses, 2)
+	assert.Len(t, topologies, 2)
+}
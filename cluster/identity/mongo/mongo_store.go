@@ -0,0 +1,167 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// duplicateKeyCode is the code of the error raised when inserting a document whose _id exists
+const duplicateKeyCode = 11000
+
+// MongoStore keeps the activations and the reminders of the grains and the subscribers of the topics in MongoDB, for use with
+// cluster.NewStoreIdentityLookup, ClusterConfig.WithReminderStore and ClusterConfig.WithTopicStore. Every identity is a document
+// holding its activation and the lock used to activate it, the reminders and the subscribers are kept in the reminders and
+// subscribers collections of the same database
+type MongoStore struct {
+	client      *mongo.Client
+	collection  *mongo.Collection
+	reminders   *mongo.Collection
+	subscribers *mongo.Collection
+	timeout     time.Duration
+}
+
+type pidDocument struct {
+	Address string `bson:"address"`
+	ID      string `bson:"id"`
+	// MemberStartedAt is the start time of the member hosting the activation in unix nanoseconds
+	MemberStartedAt int64 `bson:"member_started_at"`
+}
+
+type identityDocument struct {
+	Pid *pidDocument `bson:"pid,omitempty"`
+}
+
+// New creates a store connecting to MongoDB on localhost
+func New() (*MongoStore, error) {
+	return NewWithURI("mongodb://127.0.0.1:27017")
+}
+
+func NewWithURI(uri string) (*MongoStore, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		return nil, err
+	}
+	return NewWithCollection(client.Database("protoactor").Collection("identities")), nil
+}
+
+func NewWithCollection(collection *mongo.Collection) *MongoStore {
+	return &MongoStore{
+		client:      collection.Database().Client(),
+		collection:  collection,
+		reminders:   collection.Database().Collection("reminders"),
+		subscribers: collection.Database().Collection("subscribers"),
+		timeout:     5 * time.Second,
+	}
+}
+
+func (s *MongoStore) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), s.timeout)
+}
+
+func (s *MongoStore) Get(key string) (*actor.PID, time.Time, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+	doc := &identityDocument{}
+	err := s.collection.FindOne(ctx, bson.M{"_id": key}).Decode(doc)
+	if err == mongo.ErrNoDocuments {
+		return nil, time.Time{}, nil
+	} else if err != nil {
+		return nil, time.Time{}, err
+	}
+	if doc.Pid == nil {
+		return nil, time.Time{}, nil
+	}
+	return actor.NewPID(doc.Pid.Address, doc.Pid.ID), time.Unix(0, doc.Pid.MemberStartedAt), nil
+}
+
+func (s *MongoStore) Set(key string, pid *actor.PID, memberStartedAt time.Time) error {
+	ctx, cancel := s.context()
+	defer cancel()
+	doc := &pidDocument{Address: pid.Address, ID: pid.Id, MemberStartedAt: memberStartedAt.UnixNano()}
+	_, err := s.collection.UpdateOne(ctx,
+		bson.M{"_id": key},
+		bson.M{"$set": bson.M{"pid": doc}},
+		options.Update().SetUpsert(true))
+	return err
+}
+
+// RemoveIf deletes the document of the activation, only the activation is removed while another member
+// holds the lock of the identity
+func (s *MongoStore) RemoveIf(key string, pid *actor.PID) error {
+	ctx, cancel := s.context()
+	defer cancel()
+	filter := bson.M{"_id": key, "pid.address": pid.Address, "pid.id": pid.Id}
+	res, err := s.collection.DeleteOne(ctx, bson.M{"$and": bson.A{filter, unlocked(time.Now())}})
+	if err != nil || res.DeletedCount > 0 {
+		return err
+	}
+	_, err = s.collection.UpdateOne(ctx, filter, bson.M{"$unset": bson.M{"pid": ""}})
+	return err
+}
+
+func (s *MongoStore) TryLock(key string, owner string, ttl time.Duration) (bool, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+	now := time.Now()
+	// a document locked by another owner does not match, so it is inserted again which fails on its _id
+	_, err := s.collection.UpdateOne(ctx,
+		bson.M{"_id": key, "$or": bson.A{
+			bson.M{"lock_owner": bson.M{"$exists": false}},
+			bson.M{"lock_owner": owner},
+			bson.M{"lock_expires": bson.M{"$lt": now}},
+		}},
+		bson.M{"$set": bson.M{"lock_owner": owner, "lock_expires": now.Add(ttl)}},
+		options.Update().SetUpsert(true))
+	if isDuplicateKey(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Unlock deletes the document of the identity if it has no activation, otherwise only the lock is removed
+func (s *MongoStore) Unlock(key string, owner string) error {
+	ctx, cancel := s.context()
+	defer cancel()
+	filter := bson.M{"_id": key, "lock_owner": owner}
+	res, err := s.collection.DeleteOne(ctx, bson.M{"_id": key, "lock_owner": owner, "pid": bson.M{"$exists": false}})
+	if err != nil || res.DeletedCount > 0 {
+		return err
+	}
+	_, err = s.collection.UpdateOne(ctx, filter, bson.M{"$unset": bson.M{"lock_owner": "", "lock_expires": ""}})
+	return err
+}
+
+// unlocked matches the documents without lock or whose lock expired
+func unlocked(now time.Time) bson.M {
+	return bson.M{"$or": bson.A{
+		bson.M{"lock_owner": bson.M{"$exists": false}},
+		bson.M{"lock_expires": bson.M{"$lt": now}},
+	}}
+}
+
+// Close disconnects from MongoDB
+func (s *MongoStore) Close() error {
+	ctx, cancel := s.context()
+	defer cancel()
+	return s.client.Disconnect(ctx)
+}
+
+func isDuplicateKey(err error) bool {
+	if e, ok := err.(mongo.WriteException); ok {
+		for _, we := range e.WriteErrors {
+			if we.Code == duplicateKeyCode {
+				return true
+			}
+		}
+	}
+	return false
+}
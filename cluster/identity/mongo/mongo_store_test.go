@@ -0,0 +1,111 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var _ cluster.IdentityStore = (*MongoStore)(nil)
+
+// newTestStore connects to a MongoDB running on localhost, the tests are skipped when there is none
+func newTestStore(t *testing.T) *MongoStore {
+	store, err := New()
+	if err == nil {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		err = store.client.Ping(ctx, nil)
+	}
+	if err != nil {
+		t.Skip("MongoDB is not available", err)
+	}
+	ctx, cancel := store.context()
+	defer cancel()
+	store.collection = store.collection.Database().Collection("identities_test")
+	store.reminders = store.collection.Database().Collection("reminders_test")
+	store.subscribers = store.collection.Database().Collection("subscribers_test")
+	for _, c := range []*mongo.Collection{store.collection, store.reminders, store.subscribers} {
+		if err := c.Drop(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return store
+}
+
+func TestMongoStore_Activations(t *testing.T) {
+	store := newTestStore(t)
+	defer store.Close()
+
+	pid, _, err := store.Get("kind/name")
+	assert.NoError(t, err)
+	assert.Nil(t, pid)
+
+	activation := actor.NewPID("127.0.0.1:8000", "Remote$name")
+	startedAt := time.Unix(0, 1600000000123456789)
+	assert.NoError(t, store.Set("kind/name", activation, startedAt))
+	pid, memberStartedAt, err := store.Get("kind/name")
+	assert.NoError(t, err)
+	assert.Equal(t, activation, pid)
+	assert.True(t, startedAt.Equal(memberStartedAt))
+
+	// another activation is not removed
+	assert.NoError(t, store.RemoveIf("kind/name", actor.NewPID("127.0.0.1:8001", "Remote$name")))
+	pid, _, _ = store.Get("kind/name")
+	assert.Equal(t, activation, pid)
+
+	assert.NoError(t, store.RemoveIf("kind/name", activation))
+	pid, _, _ = store.Get("kind/name")
+	assert.Nil(t, pid)
+	assert.Equal(t, int64(0), countDocuments(t, store))
+
+	// the activation of a locked identity is removed, the document once unlocked
+	assert.NoError(t, store.Set("kind/name", activation, startedAt))
+	locked, _ := store.TryLock("kind/name", "member1", time.Second)
+	assert.True(t, locked)
+	assert.NoError(t, store.RemoveIf("kind/name", activation))
+	pid, _, _ = store.Get("kind/name")
+	assert.Nil(t, pid)
+	locked, _ = store.TryLock("kind/name", "member2", time.Second)
+	assert.False(t, locked)
+	assert.NoError(t, store.Unlock("kind/name", "member1"))
+	assert.Equal(t, int64(0), countDocuments(t, store))
+}
+
+func countDocuments(t *testing.T, store *MongoStore) int64 {
+	ctx, cancel := store.context()
+	defer cancel()
+	count, err := store.collection.CountDocuments(ctx, bson.M{})
+	assert.NoError(t, err)
+	return count
+}
+
+func TestMongoStore_Lock(t *testing.T) {
+	store := newTestStore(t)
+	defer store.Close()
+
+	locked, err := store.TryLock("kind/name", "member1", time.Second)
+	assert.NoError(t, err)
+	assert.True(t, locked)
+	locked, err = store.TryLock("kind/name", "member2", time.Second)
+	assert.NoError(t, err)
+	assert.False(t, locked)
+
+	// only the owner unlocks
+	assert.NoError(t, store.Unlock("kind/name", "member2"))
+	locked, _ = store.TryLock("kind/name", "member2", time.Second)
+	assert.False(t, locked)
+	assert.NoError(t, store.Unlock("kind/name", "member1"))
+	locked, _ = store.TryLock("kind/name", "member2", time.Millisecond)
+	assert.True(t, locked)
+
+	// the lock expires
+	time.Sleep(10 * time.Millisecond)
+	locked, _ = store.TryLock("kind/name", "member1", time.Second)
+	assert.True(t, locked)
+}
@@ -0,0 +1,95 @@
+package mongo
+
+import (
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/cluster"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// reminderDocument is a reminder in the reminders collection, the due time is in nanoseconds so it is compared exactly
+type reminderDocument struct {
+	ID      string        `bson:"_id"`
+	Kind    string        `bson:"kind"`
+	GrainID string        `bson:"grain_id"`
+	Name    string        `bson:"name"`
+	DueTime int64         `bson:"due_time"`
+	Period  time.Duration `bson:"period"`
+}
+
+func reminderID(kind string, grainID string, name string) string {
+	return kind + "/" + grainID + "/" + name
+}
+
+func newReminderDocument(r *cluster.Reminder) *reminderDocument {
+	return &reminderDocument{
+		ID:      reminderID(r.Kind, r.GrainID, r.Name),
+		Kind:    r.Kind,
+		GrainID: r.GrainID,
+		Name:    r.Name,
+		DueTime: r.DueTime.UnixNano(),
+		Period:  r.Period,
+	}
+}
+
+// Save adds or replaces the reminder, MongoStore is a cluster.ReminderStore.
+// The reminders are queried by their due time, which should be indexed
+func (s *MongoStore) Save(reminder *cluster.Reminder) error {
+	ctx, cancel := s.context()
+	defer cancel()
+	doc := newReminderDocument(reminder)
+	_, err := s.reminders.ReplaceOne(ctx, bson.M{"_id": doc.ID}, doc, options.Replace().SetUpsert(true))
+	return err
+}
+
+func (s *MongoStore) Delete(kind string, grainID string, name string) error {
+	ctx, cancel := s.context()
+	defer cancel()
+	_, err := s.reminders.DeleteOne(ctx, bson.M{"_id": reminderID(kind, grainID, name)})
+	return err
+}
+
+func (s *MongoStore) ListDue(now time.Time) ([]*cluster.Reminder, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+	cursor, err := s.reminders.Find(ctx, bson.M{"due_time": bson.M{"$lte": now.UnixNano()}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var res []*cluster.Reminder
+	for cursor.Next(ctx) {
+		doc := &reminderDocument{}
+		if err := cursor.Decode(doc); err != nil {
+			return nil, err
+		}
+		res = append(res, &cluster.Reminder{
+			Kind:    doc.Kind,
+			GrainID: doc.GrainID,
+			Name:    doc.Name,
+			DueTime: time.Unix(0, doc.DueTime),
+			Period:  doc.Period,
+		})
+	}
+	return res, cursor.Err()
+}
+
+func (s *MongoStore) CompareAndSet(reminder *cluster.Reminder, next *cluster.Reminder) (bool, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+	expected := newReminderDocument(reminder)
+	filter := bson.M{"_id": expected.ID, "due_time": expected.DueTime, "period": expected.Period}
+	if next == nil {
+		res, err := s.reminders.DeleteOne(ctx, filter)
+		if err != nil {
+			return false, err
+		}
+		return res.DeletedCount > 0, nil
+	}
+	res, err := s.reminders.ReplaceOne(ctx, filter, newReminderDocument(next))
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
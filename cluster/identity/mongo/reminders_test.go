@@ -0,0 +1,58 @@
+package mongo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ cluster.ReminderStore = (*MongoStore)(nil)
+
+func TestMongoStore_Reminders(t *testing.T) {
+	store := newTestStore(t)
+	defer store.Close()
+
+	now := time.Now()
+	due := &cluster.Reminder{Kind: "kind", GrainID: "due", Name: "r", DueTime: now.Add(-time.Second), Period: time.Minute}
+	assert.NoError(t, store.Save(due))
+	assert.NoError(t, store.Save(&cluster.Reminder{Kind: "kind", GrainID: "later", Name: "r", DueTime: now.Add(time.Minute)}))
+
+	list, err := store.ListDue(now)
+	assert.NoError(t, err)
+	if assert.Len(t, list, 1) {
+		assert.Equal(t, "due", list[0].GrainID)
+		assert.True(t, due.DueTime.Equal(list[0].DueTime))
+		assert.Equal(t, time.Minute, list[0].Period)
+	}
+
+	// the reminder is only updated when it was not changed meanwhile
+	next := *list[0]
+	next.DueTime = now.Add(time.Minute)
+	changed := *list[0]
+	changed.Period = time.Hour
+	ok, err := store.CompareAndSet(&changed, &next)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = store.CompareAndSet(list[0], &next)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	list, _ = store.ListDue(now)
+	assert.Empty(t, list)
+
+	list, _ = store.ListDue(now.Add(time.Hour))
+	assert.Len(t, list, 2)
+	for _, r := range list {
+		ok, err = store.CompareAndSet(r, nil)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	}
+	list, _ = store.ListDue(now.Add(time.Hour))
+	assert.Empty(t, list)
+
+	assert.NoError(t, store.Save(due))
+	assert.NoError(t, store.Delete("kind", "due", "r"))
+	list, _ = store.ListDue(now)
+	assert.Empty(t, list)
+}
@@ -0,0 +1,66 @@
+package mongo
+
+import (
+	"github.com/AsynkronIT/protoactor-go/cluster"
+	"github.com/gogo/protobuf/proto"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// subscriberDocument is a subscriber of a topic in the subscribers collection, holding its encoding
+type subscriberDocument struct {
+	ID    string `bson:"_id"`
+	Topic string `bson:"topic"`
+	Data  []byte `bson:"data"`
+}
+
+func subscriberID(topic string, subscriber *cluster.SubscriberIdentity) string {
+	if subscriber.Pid != nil {
+		return topic + "/pid:" + subscriber.Pid.String()
+	}
+	return topic + "/grain:" + subscriber.ClusterIdentity.Kind + "/" + subscriber.ClusterIdentity.Id
+}
+
+// GetSubscribers returns the subscribers of the topic, MongoStore is a cluster.TopicStore.
+// The subscribers are queried by their topic, which should be indexed
+func (s *MongoStore) GetSubscribers(topic string) ([]*cluster.SubscriberIdentity, error) {
+	ctx, cancel := s.context()
+	defer cancel()
+	cursor, err := s.subscribers.Find(ctx, bson.M{"topic": topic})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var res []*cluster.SubscriberIdentity
+	for cursor.Next(ctx) {
+		doc := &subscriberDocument{}
+		if err := cursor.Decode(doc); err != nil {
+			return nil, err
+		}
+		subscriber := &cluster.SubscriberIdentity{}
+		if err := proto.Unmarshal(doc.Data, subscriber); err != nil {
+			return nil, err
+		}
+		res = append(res, subscriber)
+	}
+	return res, cursor.Err()
+}
+
+func (s *MongoStore) AddSubscriber(topic string, subscriber *cluster.SubscriberIdentity) error {
+	data, err := proto.Marshal(subscriber)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := s.context()
+	defer cancel()
+	doc := &subscriberDocument{ID: subscriberID(topic, subscriber), Topic: topic, Data: data}
+	_, err = s.subscribers.ReplaceOne(ctx, bson.M{"_id": doc.ID}, doc, options.Replace().SetUpsert(true))
+	return err
+}
+
+func (s *MongoStore) RemoveSubscriber(topic string, subscriber *cluster.SubscriberIdentity) error {
+	ctx, cancel := s.context()
+	defer cancel()
+	_, err := s.subscribers.DeleteOne(ctx, bson.M{"_id": subscriberID(topic, subscriber)})
+	return err
+}
@@ -0,0 +1,35 @@
+package mongo
+
+import (
+	"testing"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/cluster"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ cluster.TopicStore = (*MongoStore)(nil)
+
+func TestMongoStore_Subscribers(t *testing.T) {
+	store := newTestStore(t)
+	defer store.Close()
+
+	subscribers, err := store.GetSubscribers("topic")
+	assert.NoError(t, err)
+	assert.Empty(t, subscribers)
+
+	pid := &cluster.SubscriberIdentity{Pid: actor.NewPID("127.0.0.1:8000", "subscriber")}
+	grain := &cluster.SubscriberIdentity{ClusterIdentity: &cluster.ClusterIdentity{Id: "grain", Kind: "kind"}}
+	assert.NoError(t, store.AddSubscriber("topic", pid))
+	assert.NoError(t, store.AddSubscriber("topic", grain))
+	assert.NoError(t, store.AddSubscriber("topic", grain))
+	assert.NoError(t, store.AddSubscriber("other", grain))
+	subscribers, err = store.GetSubscribers("topic")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []*cluster.SubscriberIdentity{pid, grain}, subscribers)
+
+	assert.NoError(t, store.RemoveSubscriber("topic", &cluster.SubscriberIdentity{Pid: actor.NewPID("127.0.0.1:8000", "subscriber")}))
+	subscribers, err = store.GetSubscribers("topic")
+	assert.NoError(t, err)
+	assert.Equal(t, []*cluster.SubscriberIdentity{grain}, subscribers)
+}
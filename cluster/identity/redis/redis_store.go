@@ -0,0 +1,107 @@
+package redis
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+)
+
+// deleteIfEqual deletes the key if it holds the value
+var deleteIfEqual = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0
+`)
+
+// deleteActivationIf deletes the activation if it holds the pid
+var deleteActivationIf = redis.NewScript(`
+if redis.call("hget", KEYS[1], "pid") == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0
+`)
+
+// RedisStore keeps the activations and the reminders of the grains and the subscribers of the topics in Redis, for use with
+// cluster.NewStoreIdentityLookup, ClusterConfig.WithReminderStore and ClusterConfig.WithTopicStore
+type RedisStore struct {
+	client *redis.Client
+	prefix string
+}
+
+// New creates a store connecting to Redis on localhost
+func New() *RedisStore {
+	return NewWithClient(redis.NewClient(&redis.Options{Addr: "127.0.0.1:6379"}))
+}
+
+func NewWithClient(client *redis.Client) *RedisStore {
+	return &RedisStore{
+		client: client,
+		prefix: "protoactor:",
+	}
+}
+
+// activationKey is a hash holding the pid of the activation and the start time of its member in unix nanoseconds
+func (s *RedisStore) activationKey(key string) string {
+	return s.prefix + "activation:" + key
+}
+
+func (s *RedisStore) lockKey(key string) string {
+	return s.prefix + "lock:" + key
+}
+
+func (s *RedisStore) Get(key string) (*actor.PID, time.Time, error) {
+	values, err := s.client.HMGet(s.activationKey(key), "pid", "started_at").Result()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	data, ok := values[0].(string)
+	if !ok {
+		return nil, time.Time{}, nil
+	}
+	pid := &actor.PID{}
+	if err := proto.Unmarshal([]byte(data), pid); err != nil {
+		return nil, time.Time{}, err
+	}
+	startedAt, _ := values[1].(string)
+	nanos, err := strconv.ParseInt(startedAt, 10, 64)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return pid, time.Unix(0, nanos), nil
+}
+
+func (s *RedisStore) Set(key string, pid *actor.PID, memberStartedAt time.Time) error {
+	data, err := proto.Marshal(pid)
+	if err != nil {
+		return err
+	}
+	return s.client.HMSet(s.activationKey(key), map[string]interface{}{
+		"pid":        data,
+		"started_at": memberStartedAt.UnixNano(),
+	}).Err()
+}
+
+func (s *RedisStore) RemoveIf(key string, pid *actor.PID) error {
+	data, err := proto.Marshal(pid)
+	if err != nil {
+		return err
+	}
+	return deleteActivationIf.Run(s.client, []string{s.activationKey(key)}, data).Err()
+}
+
+func (s *RedisStore) TryLock(key string, owner string, ttl time.Duration) (bool, error) {
+	return s.client.SetNX(s.lockKey(key), owner, ttl).Result()
+}
+
+func (s *RedisStore) Unlock(key string, owner string) error {
+	return deleteIfEqual.Run(s.client, []string{s.lockKey(key)}, owner).Err()
+}
+
+// Close closes the connection to Redis
+func (s *RedisStore) Close() error {
+	return s.client.Close()
+}
@@ -0,0 +1,74 @@
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/cluster"
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ cluster.IdentityStore = (*RedisStore)(nil)
+
+func newTestStore(t *testing.T) (*RedisStore, *miniredis.Miniredis) {
+	server, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewWithClient(redis.NewClient(&redis.Options{Addr: server.Addr()})), server
+}
+
+func TestRedisStore_Activations(t *testing.T) {
+	store, server := newTestStore(t)
+	defer server.Close()
+	defer store.Close()
+
+	pid, _, err := store.Get("kind/name")
+	assert.NoError(t, err)
+	assert.Nil(t, pid)
+
+	activation := actor.NewPID("127.0.0.1:8000", "Remote$name")
+	startedAt := time.Unix(0, 1600000000123456789)
+	assert.NoError(t, store.Set("kind/name", activation, startedAt))
+	pid, memberStartedAt, err := store.Get("kind/name")
+	assert.NoError(t, err)
+	assert.Equal(t, activation, pid)
+	assert.True(t, startedAt.Equal(memberStartedAt))
+
+	// another activation is not removed
+	assert.NoError(t, store.RemoveIf("kind/name", actor.NewPID("127.0.0.1:8001", "Remote$name")))
+	pid, _, _ = store.Get("kind/name")
+	assert.Equal(t, activation, pid)
+
+	assert.NoError(t, store.RemoveIf("kind/name", activation))
+	pid, _, _ = store.Get("kind/name")
+	assert.Nil(t, pid)
+}
+
+func TestRedisStore_Lock(t *testing.T) {
+	store, server := newTestStore(t)
+	defer server.Close()
+	defer store.Close()
+
+	locked, err := store.TryLock("kind/name", "member1", time.Second)
+	assert.NoError(t, err)
+	assert.True(t, locked)
+	locked, _ = store.TryLock("kind/name", "member2", time.Second)
+	assert.False(t, locked)
+
+	// only the owner unlocks
+	assert.NoError(t, store.Unlock("kind/name", "member2"))
+	locked, _ = store.TryLock("kind/name", "member2", time.Second)
+	assert.False(t, locked)
+	assert.NoError(t, store.Unlock("kind/name", "member1"))
+	locked, _ = store.TryLock("kind/name", "member2", time.Second)
+	assert.True(t, locked)
+
+	// the lock expires
+	server.FastForward(2 * time.Second)
+	locked, _ = store.TryLock("kind/name", "member1", time.Second)
+	assert.True(t, locked)
+}
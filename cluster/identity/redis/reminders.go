@@ -0,0 +1,150 @@
+package redis
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/cluster"
+	"github.com/go-redis/redis"
+)
+
+// replaceIfEqual replaces the reminder if it holds the value, or deletes it when there is no replacement
+var replaceIfEqual = redis.NewScript(`
+if redis.call("get", KEYS[1]) ~= ARGV[1] then
+	return 0
+end
+if ARGV[2] == "" then
+	redis.call("del", KEYS[1])
+	redis.call("zrem", KEYS[2], ARGV[4])
+else
+	redis.call("set", KEYS[1], ARGV[2])
+	redis.call("zadd", KEYS[2], ARGV[3], ARGV[4])
+end
+return 1
+`)
+
+// reminderData is the stored reminder, the reminders are compared on their encoding
+type reminderData struct {
+	Kind    string        `json:"kind"`
+	GrainID string        `json:"grain_id"`
+	Name    string        `json:"name"`
+	DueTime int64         `json:"due_time"`
+	Period  time.Duration `json:"period"`
+}
+
+// The reminders are kept as keys holding their encoding, indexed by their due time in milliseconds in a sorted set
+
+func (s *RedisStore) remindersKey() string {
+	return s.prefix + "reminders"
+}
+
+func (s *RedisStore) reminderKey(id string) string {
+	return s.prefix + "reminder:" + id
+}
+
+func reminderID(kind string, grainID string, name string) string {
+	return kind + "/" + grainID + "/" + name
+}
+
+func encodeReminder(r *cluster.Reminder) (string, float64, error) {
+	data, err := json.Marshal(&reminderData{
+		Kind:    r.Kind,
+		GrainID: r.GrainID,
+		Name:    r.Name,
+		DueTime: r.DueTime.UnixNano(),
+		Period:  r.Period,
+	})
+	if err != nil {
+		return "", 0, err
+	}
+	return string(data), float64(r.DueTime.UnixNano() / int64(time.Millisecond)), nil
+}
+
+func decodeReminder(data string) (*cluster.Reminder, error) {
+	d := &reminderData{}
+	if err := json.Unmarshal([]byte(data), d); err != nil {
+		return nil, err
+	}
+	return &cluster.Reminder{
+		Kind:    d.Kind,
+		GrainID: d.GrainID,
+		Name:    d.Name,
+		DueTime: time.Unix(0, d.DueTime),
+		Period:  d.Period,
+	}, nil
+}
+
+// Save adds or replaces the reminder, RedisStore is a cluster.ReminderStore
+func (s *RedisStore) Save(reminder *cluster.Reminder) error {
+	data, score, err := encodeReminder(reminder)
+	if err != nil {
+		return err
+	}
+	id := reminderID(reminder.Kind, reminder.GrainID, reminder.Name)
+	_, err = s.client.TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.Set(s.reminderKey(id), data, 0)
+		pipe.ZAdd(s.remindersKey(), redis.Z{Score: score, Member: id})
+		return nil
+	})
+	return err
+}
+
+func (s *RedisStore) Delete(kind string, grainID string, name string) error {
+	id := reminderID(kind, grainID, name)
+	_, err := s.client.TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.Del(s.reminderKey(id))
+		pipe.ZRem(s.remindersKey(), id)
+		return nil
+	})
+	return err
+}
+
+func (s *RedisStore) ListDue(now time.Time) ([]*cluster.Reminder, error) {
+	max := strconv.FormatInt(now.UnixNano()/int64(time.Millisecond), 10)
+	ids, err := s.client.ZRangeByScore(s.remindersKey(), redis.ZRangeBy{Min: "-inf", Max: max}).Result()
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = s.reminderKey(id)
+	}
+	values, err := s.client.MGet(keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*cluster.Reminder, 0, len(values))
+	for _, v := range values {
+		// deleted since the due reminders were listed
+		data, ok := v.(string)
+		if !ok {
+			continue
+		}
+		r, err := decodeReminder(data)
+		if err != nil {
+			return nil, err
+		}
+		if !r.DueTime.After(now) {
+			res = append(res, r)
+		}
+	}
+	return res, nil
+}
+
+func (s *RedisStore) CompareAndSet(reminder *cluster.Reminder, next *cluster.Reminder) (bool, error) {
+	expected, _, err := encodeReminder(reminder)
+	if err != nil {
+		return false, err
+	}
+	var data string
+	var score float64
+	if next != nil {
+		if data, score, err = encodeReminder(next); err != nil {
+			return false, err
+		}
+	}
+	id := reminderID(reminder.Kind, reminder.GrainID, reminder.Name)
+	res, err := replaceIfEqual.Run(s.client, []string{s.reminderKey(id), s.remindersKey()}, expected, data, score, id).Int()
+	return res == 1, err
+}
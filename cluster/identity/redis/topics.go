@@ -0,0 +1,48 @@
+package redis
+
+import (
+	"github.com/AsynkronIT/protoactor-go/cluster"
+	"github.com/gogo/protobuf/proto"
+)
+
+// The subscribers of a topic are kept in a hash, holding their encoding by their PID or cluster identity
+
+func (s *RedisStore) topicKey(topic string) string {
+	return s.prefix + "topic:" + topic
+}
+
+func subscriberKey(subscriber *cluster.SubscriberIdentity) string {
+	if subscriber.Pid != nil {
+		return "pid:" + subscriber.Pid.String()
+	}
+	return "grain:" + subscriber.ClusterIdentity.Kind + "/" + subscriber.ClusterIdentity.Id
+}
+
+// GetSubscribers returns the subscribers of the topic, RedisStore is a cluster.TopicStore
+func (s *RedisStore) GetSubscribers(topic string) ([]*cluster.SubscriberIdentity, error) {
+	values, err := s.client.HVals(s.topicKey(topic)).Result()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*cluster.SubscriberIdentity, 0, len(values))
+	for _, data := range values {
+		subscriber := &cluster.SubscriberIdentity{}
+		if err := proto.Unmarshal([]byte(data), subscriber); err != nil {
+			return nil, err
+		}
+		res = append(res, subscriber)
+	}
+	return res, nil
+}
+
+func (s *RedisStore) AddSubscriber(topic string, subscriber *cluster.SubscriberIdentity) error {
+	data, err := proto.Marshal(subscriber)
+	if err != nil {
+		return err
+	}
+	return s.client.HSet(s.topicKey(topic), subscriberKey(subscriber), data).Err()
+}
+
+func (s *RedisStore) RemoveSubscriber(topic string, subscriber *cluster.SubscriberIdentity) error {
+	return s.client.HDel(s.topicKey(topic), subscriberKey(subscriber)).Err()
+}
@@ -0,0 +1,171 @@
+package cluster
+
+import (
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+	"github.com/AsynkronIT/protoactor-go/log"
+	"github.com/AsynkronIT/protoactor-go/remote"
+)
+
+// IdentityLookup locates the activations of the grains. The partition actors of the members are used when none is configured
+type IdentityLookup interface {
+	Setup()
+	// Get returns the activation of the grain, activating it if needed
+	Get(name string, kind string) (*actor.PID, remote.ResponseStatusCode)
+	Shutdown()
+}
+
+// IdentityStore keeps the activations of the grains in an external store shared by the members
+type IdentityStore interface {
+	// Get returns the activation stored for the key and the start time of the member hosting it, or nil
+	Get(key string) (*actor.PID, time.Time, error)
+	// Set stores the activation for the key, hosted by the member started at memberStartedAt, see MemberStatus.StartedAt
+	Set(key string, pid *actor.PID, memberStartedAt time.Time) error
+	// RemoveIf removes the activation stored for the key if it is pid
+	RemoveIf(key string, pid *actor.PID) error
+	// TryLock locks the key for the owner until it is unlocked or the ttl expires, returns false if another owner holds the lock
+	TryLock(key string, owner string, ttl time.Duration) (bool, error)
+	// Unlock releases the lock of the key if it is held by the owner
+	Unlock(key string, owner string) error
+}
+
+// lockRetryInterval is the interval at which a member waits for the activation of a grain locked by another member
+const lockRetryInterval = 20 * time.Millisecond
+
+// StoreIdentityLookup keeps the activations of the grains in an IdentityStore instead of the partition actors,
+// so they do not move when the topology changes. An activation is used as long as the member hosting it is in the cluster
+// and was not restarted since, the member hosting it removes it from the store when it stops
+type StoreIdentityLookup struct {
+	store        IdentityStore
+	watcher      *actor.PID
+	subscription *eventstream.Subscription
+	spawn        func(address string, name string, kind string) (*actor.PID, remote.ResponseStatusCode)
+}
+
+func NewStoreIdentityLookup(store IdentityStore) *StoreIdentityLookup {
+	return &StoreIdentityLookup{
+		store: store,
+		spawn: func(address string, name string, kind string) (*actor.PID, remote.ResponseStatusCode) {
+			res, err := remote.SpawnNamed(address, name, kind, cfg.TimeoutTime)
+			if err == actor.ErrTimeout {
+				return nil, remote.ResponseStatusCodeTIMEOUT
+			} else if err != nil {
+				return nil, remote.ResponseStatusCodeERROR
+			}
+			return res.Pid, remote.ResponseStatusCode(res.StatusCode)
+		},
+	}
+}
+
+func (l *StoreIdentityLookup) Setup() {
+	props := actor.PropsFromProducer(func() actor.Actor {
+		return &activationWatcherActor{store: l.store}
+	}).WithGuardian(actor.RestartingSupervisorStrategy())
+	l.watcher, _ = rootContext.SpawnNamed(props, "ActivationWatcher")
+	// the activations of this member are removed by the watcher, off the goroutine publishing the event
+	watcher := l.watcher
+	l.subscription = eventstream.Subscribe(func(evt interface{}) {
+		rootContext.Send(watcher, evt)
+	}).WithPredicate(func(evt interface{}) bool {
+		_, ok := evt.(*remote.ActivationTerminatedEvent)
+		return ok
+	})
+}
+
+func (l *StoreIdentityLookup) Shutdown() {
+	eventstream.Unsubscribe(l.subscription)
+	rootContext.StopFuture(l.watcher).Wait()
+}
+
+func (l *StoreIdentityLookup) Get(name string, kind string) (*actor.PID, remote.ResponseStatusCode) {
+	key := kind + "/" + name
+	owner := actor.ProcessRegistry.Address
+	deadline := time.Now().Add(cfg.TimeoutTime)
+
+	for time.Now().Before(deadline) {
+		pid, ok := l.getActivation(key)
+		if !ok {
+			return nil, remote.ResponseStatusCodeERROR
+		}
+		if pid != nil {
+			return pid, remote.ResponseStatusCodeOK
+		}
+
+		locked, err := l.store.TryLock(key, owner, 2*cfg.TimeoutTime)
+		if err != nil {
+			plog.Error("Failed to lock identity", log.String("key", key), log.Error(err))
+			return nil, remote.ResponseStatusCodeERROR
+		}
+		if !locked {
+			// another member is activating the grain
+			time.Sleep(lockRetryInterval)
+			continue
+		}
+		pid, statusCode := l.activate(key, name, kind)
+		if err := l.store.Unlock(key, owner); err != nil {
+			plog.Error("Failed to unlock identity", log.String("key", key), log.Error(err))
+		}
+		return pid, statusCode
+	}
+	return nil, remote.ResponseStatusCodeTIMEOUT
+}
+
+// getActivation returns the stored activation if its member is in the cluster, and was not restarted at the same address
+// since, which lost the activation
+func (l *StoreIdentityLookup) getActivation(key string) (*actor.PID, bool) {
+	pid, memberStartedAt, err := l.store.Get(key)
+	if err != nil {
+		plog.Error("Failed to get identity", log.String("key", key), log.Error(err))
+		return nil, false
+	}
+	if pid == nil {
+		return nil, true
+	}
+	if startedAt, ok := memberList.getStartedAt(pid.Address); !ok || !startedAt.Equal(memberStartedAt) {
+		return nil, true
+	}
+	return pid, true
+}
+
+func (l *StoreIdentityLookup) activate(key string, name string, kind string) (*actor.PID, remote.ResponseStatusCode) {
+	// the grain may have been activated while waiting for the lock
+	pid, ok := l.getActivation(key)
+	if !ok {
+		return nil, remote.ResponseStatusCodeERROR
+	}
+	if pid != nil {
+		return pid, remote.ResponseStatusCodeOK
+	}
+
+	activator := memberList.getActivatorMemberFor(kind, actor.ProcessRegistry.Address)
+	activatorStartedAt, ok := memberList.getStartedAt(activator)
+	if activator == "" || !ok {
+		return nil, remote.ResponseStatusCodeUNAVAILABLE
+	}
+	pid, statusCode := l.spawn(activator, name, kind)
+	if statusCode != remote.ResponseStatusCodeOK && statusCode != remote.ResponseStatusCodePROCESSNAMEALREADYEXIST {
+		return nil, statusCode
+	}
+	if err := l.store.Set(key, pid, activatorStartedAt); err != nil {
+		plog.Error("Failed to store identity", log.String("key", key), log.Error(err))
+		return nil, remote.ResponseStatusCodeERROR
+	}
+	return pid, remote.ResponseStatusCodeOK
+}
+
+// activationWatcherActor removes the activations hosted by this member from the store when they stop
+type activationWatcherActor struct {
+	store IdentityStore
+}
+
+func (a *activationWatcherActor) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *remote.ActivationTerminatedEvent:
+		key := msg.Kind + "/" + msg.Name
+		if err := a.store.RemoveIf(key, msg.PID); err != nil {
+			plog.Error("Failed to remove identity", log.String("key", key), log.Error(err))
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package cluster
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	"github.com/stretchr/testify/assert"
+)
+
+type memIdentityStore struct {
+	mu        sync.Mutex
+	pids      map[string]*actor.PID
+	startedAt map[string]time.Time
+	locks     map[string]string
+}
+
+func newMemIdentityStore() *memIdentityStore {
+	return &memIdentityStore{
+		pids:      make(map[string]*actor.PID),
+		startedAt: make(map[string]time.Time),
+		locks:     make(map[string]string),
+	}
+}
+
+func (s *memIdentityStore) Get(key string) (*actor.PID, time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.pids[key], s.startedAt[key], nil
+}
+
+func (s *memIdentityStore) Set(key string, pid *actor.PID, memberStartedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pids[key] = pid
+	s.startedAt[key] = memberStartedAt
+	return nil
+}
+
+func (s *memIdentityStore) RemoveIf(key string, pid *actor.PID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if current, ok := s.pids[key]; ok && current.String() == pid.String() {
+		delete(s.pids, key)
+	}
+	return nil
+}
+
+func (s *memIdentityStore) TryLock(key string, owner string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if current, ok := s.locks[key]; ok && current != owner {
+		return false, nil
+	}
+	s.locks[key] = owner
+	return true, nil
+}
+
+func (s *memIdentityStore) Unlock(key string, owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.locks[key] == owner {
+		delete(s.locks, key)
+	}
+	return nil
+}
+
+func TestStoreIdentityLookup(t *testing.T) {
+	cfg = NewClusterConfig("mycluster", "127.0.0.1:0", nil).WithTimeout(time.Second)
+	defer func() { cfg = nil }()
+	setupMemberList()
+	defer stopMemberList()
+	eventstream.Publish(ClusterTopologyEvent{
+		&MemberStatus{Host: "127.0.0.1", Port: 1, Kinds: []string{"kind"}, Alive: true},
+		&MemberStatus{Host: "127.0.0.1", Port: 2, Kinds: []string{"kind"}, Alive: true},
+	})
+
+	var spawned []*actor.PID
+	store := newMemIdentityStore()
+	lookup := NewStoreIdentityLookup(store)
+	lookup.spawn = func(address string, name string, kind string) (*actor.PID, remote.ResponseStatusCode) {
+		pid := actor.NewPID(address, "Remote$"+name)
+		spawned = append(spawned, pid)
+		return pid, remote.ResponseStatusCodeOK
+	}
+	pid, statusCode := lookup.Get("name", "kind")
+	assert.Equal(t, remote.ResponseStatusCodeOK, statusCode)
+	stored, _, _ := store.Get("kind/name")
+	assert.Equal(t, pid, stored)
+
+	// the stored activation is used while its member is in the cluster
+	again, statusCode := lookup.Get("name", "kind")
+	assert.Equal(t, remote.ResponseStatusCodeOK, statusCode)
+	assert.Equal(t, pid, again)
+	assert.Len(t, spawned, 1)
+
+	// the grain is activated again once its member left
+	eventstream.Publish(ClusterTopologyEvent{
+		&MemberStatus{Host: "127.0.0.1", Port: otherPort(pid), Kinds: []string{"kind"}, Alive: true},
+	})
+	moved, statusCode := lookup.Get("name", "kind")
+	assert.Equal(t, remote.ResponseStatusCodeOK, statusCode)
+	assert.NotEqual(t, pid.Address, moved.Address)
+	assert.Len(t, spawned, 2)
+
+	// a locked identity is waited for
+	store.TryLock("kind/locked", "other", time.Second)
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		startedAt, _ := memberList.getStartedAt(moved.Address)
+		store.Set("kind/locked", moved, startedAt)
+		store.Unlock("kind/locked", "other")
+	}()
+	locked, statusCode := lookup.Get("locked", "kind")
+	assert.Equal(t, remote.ResponseStatusCodeOK, statusCode)
+	assert.Equal(t, moved, locked)
+	assert.Len(t, spawned, 2)
+}
+
+func TestStoreIdentityLookup_MemberRestarted(t *testing.T) {
+	cfg = NewClusterConfig("mycluster", "127.0.0.1:0", nil).WithTimeout(time.Second)
+	defer func() { cfg = nil }()
+	setupMemberList()
+	defer stopMemberList()
+	member := func(startedAt time.Time) ClusterTopologyEvent {
+		kinds := []string{"kind", advertisedStartedAt(startedAt)}
+		return ClusterTopologyEvent{&MemberStatus{Host: "127.0.0.1", Port: 1, Kinds: kinds, Alive: true}}
+	}
+	started := time.Now()
+	eventstream.Publish(member(started))
+
+	spawned := 0
+	lookup := NewStoreIdentityLookup(newMemIdentityStore())
+	lookup.spawn = func(address string, name string, kind string) (*actor.PID, remote.ResponseStatusCode) {
+		spawned++
+		return actor.NewPID(address, fmt.Sprintf("Remote$%v", spawned)), remote.ResponseStatusCodeOK
+	}
+	pid, _ := lookup.Get("name", "kind")
+	again, _ := lookup.Get("name", "kind")
+	assert.Equal(t, pid, again)
+
+	// the member crashed and restarted at the same address without its activations
+	eventstream.Publish(member(started.Add(time.Minute)))
+	restarted, statusCode := lookup.Get("name", "kind")
+	assert.Equal(t, remote.ResponseStatusCodeOK, statusCode)
+	assert.Equal(t, pid.Address, restarted.Address)
+	assert.NotEqual(t, pid, restarted)
+	assert.Equal(t, 2, spawned)
+	again, _ = lookup.Get("name", "kind")
+	assert.Equal(t, restarted, again)
+}
+
+func otherPort(pid *actor.PID) int {
+	if pid.Address == "127.0.0.1:1" {
+		return 2
+	}
+	return 1
+}
+
+func TestStoreIdentityLookup_RemovesStoppedActivations(t *testing.T) {
+	store := newMemIdentityStore()
+	lookup := NewStoreIdentityLookup(store)
+	lookup.Setup()
+	defer lookup.Shutdown()
+
+	pid := actor.NewLocalPID("Remote$name")
+	store.Set("kind/name", pid, time.Time{})
+	store.Set("kind/other", pid, time.Time{})
+	// the activation stopping on the member hosting it, whichever member activated it
+	eventstream.Publish(&remote.ActivationTerminatedEvent{PID: pid, Name: "name", Kind: "kind"})
+
+	assert.Eventually(t, func() bool {
+		stored, _, _ := store.Get("kind/name")
+		return stored == nil
+	}, time.Second, 10*time.Millisecond)
+	stored, _, _ := store.Get("kind/other")
+	assert.Equal(t, pid, stored)
+}
@@ -0,0 +1,39 @@
+package cluster
+
+import (
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/log"
+	"github.com/AsynkronIT/protoactor-go/remote"
+)
+
+// inProcess is set while the cluster runs in process
+var inProcess bool
+
+// StartInProcess runs a fake cluster in this process, without remoting and without a cluster provider.
+// The grains of the kinds registered with remote.Register are activated as local actors on first use,
+// so the generated grain clients and Get work unchanged. It is meant for unit tests of code calling grains
+func StartInProcess(config *ClusterConfig) {
+	cfg = config
+	inProcess = true
+}
+
+// StopInProcess stops the fake cluster started by StartInProcess
+func StopInProcess() {
+	inProcess = false
+	cfg = nil
+}
+
+// getInProcess returns the local activation of the grain, activating it if needed
+func getInProcess(name string, kind string) (*actor.PID, remote.ResponseStatusCode) {
+	props, ok := remote.GetKnownKind(kind)
+	if !ok {
+		plog.Error("Unknown kind of in process grain", log.String("kind", kind))
+		return nil, remote.ResponseStatusCodeERROR
+	}
+	// named like the activations of the remote activator, which the grains rely on
+	pid, err := rootContext.SpawnNamed(props, "Remote$"+name)
+	if err != nil && err != actor.ErrNameExists {
+		return nil, remote.ResponseStatusCodeERROR
+	}
+	return pid, remote.ResponseStatusCodeOK
+}
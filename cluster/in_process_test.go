@@ -0,0 +1,35 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartInProcess(t *testing.T) {
+	remote.Register("inprocess", actor.PropsFromFunc(func(ctx actor.Context) {
+		if _, ok := ctx.Message().(*GrainRequest); ok {
+			RespondGrain(ctx, &ClusterIdentity{Id: ctx.Self().Id}, nil)
+		}
+	}))
+	StartInProcess(NewClusterConfig("mycluster", "127.0.0.1:0", nil))
+	defer StopInProcess()
+
+	res := &ClusterIdentity{}
+	err := CallGrain(context.Background(), "a", "inprocess", 0, &ClusterIdentity{}, res, GrainCallOptionsForKind("inprocess"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Remote$a", res.Id)
+
+	// the grain is activated once
+	pid1, status := Get("a", "inprocess")
+	assert.Equal(t, remote.ResponseStatusCodeOK, status)
+	pid2, _ := Get("a", "inprocess")
+	assert.Equal(t, pid1, pid2)
+	rootContext.Stop(pid1)
+
+	_, status = Get("a", "unknown")
+	assert.Equal(t, remote.ResponseStatusCodeERROR, status)
+}
@@ -0,0 +1,124 @@
+package cluster
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/log"
+	"github.com/AsynkronIT/protoactor-go/remote"
+)
+
+// kindVersionSeparator separates the kinds from their versions in the kinds registered with the cluster provider
+const kindVersionSeparator = "@"
+
+// VersionDrainPolicy decides what happens to the activations of a kind on a member running an older version of the kind,
+// once a member running a newer version joins
+type VersionDrainPolicy int
+
+const (
+	// KeepOldActivations keeps the activations until they stop on their own, e.g. when passivated
+	KeepOldActivations VersionDrainPolicy = iota
+	// DrainOldActivations hands off and stops the activations, they are activated on the members running the newer version
+	// when they are next requested
+	DrainOldActivations
+)
+
+// advertisedKinds returns the kinds registered with the cluster provider, with the versions of the versioned kinds
+func (c *ClusterConfig) advertisedKinds(kinds []string) []string {
+	res := make([]string, len(kinds))
+	for i, kind := range kinds {
+		res[i] = kind
+		if version, ok := c.KindVersions[kind]; ok {
+			res[i] = kind + kindVersionSeparator + strconv.Itoa(version)
+		}
+	}
+	return res
+}
+
+// parseKind splits an advertised kind into the kind and its version, which is 0 for the kinds without a version
+func parseKind(advertised string) (string, int) {
+	i := strings.LastIndex(advertised, kindVersionSeparator)
+	if i < 0 {
+		return advertised, 0
+	}
+	version, err := strconv.Atoi(advertised[i+1:])
+	if err != nil {
+		return advertised, 0
+	}
+	return advertised[:i], version
+}
+
+// withKindVersions returns a copy of the status with the versions moved from the kinds to KindVersions
+func withKindVersions(status *MemberStatus) *MemberStatus {
+	res := *status
+	res.Kinds = make([]string, len(status.Kinds))
+	res.KindVersions = make(map[string]int)
+	for i, advertised := range status.Kinds {
+		kind, version := parseKind(advertised)
+		res.Kinds[i] = kind
+		res.KindVersions[kind] = version
+	}
+	return &res
+}
+
+// latestKindVersion returns the newest version of the kind run by the alive members
+func (ml *memberListValue) latestKindVersion(kind string) int {
+	latest := 0
+	for _, m := range ml.members {
+		if version, ok := m.KindVersions[kind]; ok && m.Alive && version > latest {
+			latest = version
+		}
+	}
+	return latest
+}
+
+// selectActivator returns the first member given by next running the latest version of the kind.
+// next is called up to once per member, which makes a round robin member strategy return every member
+func (ml *memberListValue) selectActivator(kind string, next func() string) string {
+	latest := ml.latestKindVersion(kind)
+	if latest == 0 {
+		return next()
+	}
+	for i := 0; i < len(ml.members); i++ {
+		address := next()
+		if m, ok := ml.members[address]; ok && m.KindVersions[kind] == latest {
+			return address
+		}
+	}
+	// the member strategy did not give a member running the latest version
+	for address, m := range ml.members {
+		if m.Alive && m.KindVersions[kind] == latest {
+			return address
+		}
+	}
+	return ""
+}
+
+// checkKindVersions drains the activations of the kinds this member runs an older version of, as configured by the policy.
+// It may only be called with a write lock on the member list
+func (ml *memberListValue) checkKindVersions() {
+	self, ok := ml.members[actor.ProcessRegistry.Address]
+	if !ok || cfg == nil || cfg.VersionDrainPolicy != DrainOldActivations {
+		return
+	}
+	for _, kind := range self.Kinds {
+		latest := ml.latestKindVersion(kind)
+		if self.KindVersions[kind] >= latest || ml.drainedKindVersions[kind] >= latest {
+			continue
+		}
+		ml.drainedKindVersions[kind] = latest
+		plog.Info("Draining activations of an older version of the kind", log.String("kind", kind),
+			log.Int("version", self.KindVersions[kind]), log.Int("latest", latest))
+		go drainKind(kind)
+	}
+}
+
+var drainKind = func(kind string) {
+	activations, err := remote.ActivationsOfKind(kind, cfg.TimeoutTime)
+	if err != nil {
+		plog.Error("Failed to get activations", log.String("kind", kind), log.Error(err))
+		return
+	}
+	handoff(activations)
+}
@@ -0,0 +1,62 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseKind(t *testing.T) {
+	c := NewClusterConfig("mycluster", "127.0.0.1:0", nil).WithKindVersion("user", 2)
+	advertised := c.advertisedKinds([]string{"user", "order"})
+	assert.Equal(t, []string{"user@2", "order"}, advertised)
+
+	status := withKindVersions(&MemberStatus{Kinds: advertised})
+	assert.Equal(t, []string{"user", "order"}, status.Kinds)
+	assert.Equal(t, map[string]int{"user": 2, "order": 0}, status.KindVersions)
+
+	kind, version := parseKind("mail@home")
+	assert.Equal(t, "mail@home", kind)
+	assert.Equal(t, 0, version)
+}
+
+func TestMemberList_KindVersions(t *testing.T) {
+	cfg = NewClusterConfig("mycluster", "127.0.0.1:0", nil).WithVersionDrainPolicy(DrainOldActivations)
+	defer func() { cfg = nil }()
+	drained := make(chan string, 10)
+	defer func(drain func(string)) { drainKind = drain }(drainKind)
+	drainKind = func(kind string) { drained <- kind }
+
+	self := actor.ProcessRegistry.Address
+
+	ml := &memberListValue{
+		members:              make(map[string]*MemberStatus),
+		memberStrategyByKind: make(map[string]MemberStrategy),
+		drainedKindVersions:  make(map[string]int),
+	}
+	ml.members[self] = withKindVersions(&MemberStatus{Host: self, Kinds: []string{"user@1"}, Alive: true})
+	ml.members["127.0.0.1:1"] = withKindVersions(&MemberStatus{Host: "127.0.0.1", Port: 1, Kinds: []string{"user@1"}, Alive: true})
+	ml.members["127.0.0.1:2"] = withKindVersions(&MemberStatus{Host: "127.0.0.1", Port: 2, Kinds: []string{"user@2"}, Alive: true})
+
+	// activations go to the members running the latest version
+	assert.Equal(t, 2, ml.latestKindVersion("user"))
+	next := []string{self, "127.0.0.1:1", "127.0.0.1:2"}
+	assert.Equal(t, "127.0.0.1:2", ml.selectActivator("user", func() string {
+		res := next[0]
+		next = next[1:]
+		return res
+	}))
+
+	// this member runs an older version and drains its activations once
+	ml.checkKindVersions()
+	ml.checkKindVersions()
+	select {
+	case kind := <-drained:
+		assert.Equal(t, "user", kind)
+	case <-time.After(time.Second):
+		t.Fatal("activations not drained")
+	}
+	assert.Empty(t, drained)
+}
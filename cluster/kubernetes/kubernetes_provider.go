@@ -0,0 +1,297 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/cluster"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	labelCluster          = "cluster.proto.actor/cluster"
+	annotationHost        = "cluster.proto.actor/host"
+	annotationPort        = "cluster.proto.actor/port"
+	annotationKinds       = "cluster.proto.actor/kinds"
+	annotationStatusValue = "cluster.proto.actor/status-value"
+
+	namespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+)
+
+// KubernetesProvider discovers the cluster members using the Kubernetes API.
+//
+// Every member labels and annotates its own pod with the cluster name, address and kinds, and watches the pods
+// carrying the label of its cluster. A pod which is deleted or terminating leaves the cluster.
+// The service account of the pods needs permission to get, list, watch and patch pods in its namespace
+type KubernetesProvider struct {
+	deregistered          bool
+	shutdown              bool
+	client                kubernetes.Interface
+	namespace             string
+	podName               string
+	clusterName           string
+	address               string
+	port                  int
+	knownKinds            []string
+	statusValue           cluster.MemberStatusValue
+	statusValueSerializer cluster.MemberStatusValueSerializer
+	retryInterval         time.Duration
+	mu                    sync.Mutex
+	pods                  map[string]*v1.Pod
+	resourceVersion       string
+	watcher               watch.Interface
+	clusterError          error
+}
+
+// New creates a provider for a member running in a pod, using the in-cluster configuration.
+// The pod name is read from the HOSTNAME environment variable
+func New() (*KubernetesProvider, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, err
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	namespace, err := ioutil.ReadFile(namespaceFile)
+	if err != nil {
+		return nil, err
+	}
+	podName := os.Getenv("HOSTNAME")
+	if podName == "" {
+		return nil, errors.New("the pod name can not be determined, HOSTNAME is not set")
+	}
+	return NewWithClient(client, strings.TrimSpace(string(namespace)), podName), nil
+}
+
+// NewWithClient creates a provider for a member running in the pod podName in namespace
+func NewWithClient(client kubernetes.Interface, namespace, podName string) *KubernetesProvider {
+	return &KubernetesProvider{
+		client:        client,
+		namespace:     namespace,
+		podName:       podName,
+		retryInterval: 5 * time.Second,
+		pods:          make(map[string]*v1.Pod),
+	}
+}
+
+func (p *KubernetesProvider) RegisterMember(clusterName string, address string, port int, knownKinds []string,
+	statusValue cluster.MemberStatusValue, serializer cluster.MemberStatusValueSerializer) error {
+	p.clusterName = clusterName
+	p.address = address
+	p.port = port
+	p.knownKinds = knownKinds
+	p.statusValue = statusValue
+	p.statusValueSerializer = serializer
+
+	err := p.patchPod(map[string]interface{}{
+		"labels": map[string]interface{}{
+			labelCluster: clusterName,
+		},
+		"annotations": map[string]interface{}{
+			annotationHost:        address,
+			annotationPort:        strconv.Itoa(port),
+			annotationKinds:       strings.Join(knownKinds, ","),
+			annotationStatusValue: serializer.Serialize(statusValue),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// list the pods directly after registering, so the local node sees its own information upon startup
+	return p.listPods()
+}
+
+func (p *KubernetesProvider) MonitorMemberStatusChanges() {
+	go func() {
+		for !p.shutdown {
+			if err := p.watchPods(); err != nil && !p.shutdown {
+				log.Printf("[CLUSTER] [KUBERNETES] Error watching pods %v", err)
+				time.Sleep(p.retryInterval)
+			}
+			if p.shutdown {
+				return
+			}
+			// the watch ended, list again to not miss any changes
+			if err := p.listPods(); err != nil {
+				log.Printf("[CLUSTER] [KUBERNETES] Error listing pods %v", err)
+				time.Sleep(p.retryInterval)
+			}
+		}
+	}()
+}
+
+func (p *KubernetesProvider) UpdateMemberStatusValue(statusValue cluster.MemberStatusValue) error {
+	p.statusValue = statusValue
+	if p.statusValue == nil {
+		return nil
+	}
+	return p.patchPod(map[string]interface{}{
+		"annotations": map[string]interface{}{
+			annotationStatusValue: p.statusValueSerializer.Serialize(statusValue),
+		},
+	})
+}
+
+func (p *KubernetesProvider) DeregisterMember() error {
+	// a null value removes the label or annotation
+	err := p.patchPod(map[string]interface{}{
+		"labels": map[string]interface{}{
+			labelCluster: nil,
+		},
+		"annotations": map[string]interface{}{
+			annotationHost:        nil,
+			annotationPort:        nil,
+			annotationKinds:       nil,
+			annotationStatusValue: nil,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	p.deregistered = true
+	return nil
+}
+
+func (p *KubernetesProvider) Shutdown() error {
+	p.shutdown = true
+	p.mu.Lock()
+	if p.watcher != nil {
+		p.watcher.Stop()
+	}
+	p.mu.Unlock()
+	if !p.deregistered {
+		return p.DeregisterMember()
+	}
+	return nil
+}
+
+// GetHealthStatus returns an error if the cluster health status has problems
+func (p *KubernetesProvider) GetHealthStatus() error {
+	return p.clusterError
+}
+
+func (p *KubernetesProvider) patchPod(metadata map[string]interface{}) error {
+	data, err := json.Marshal(map[string]interface{}{"metadata": metadata})
+	if err != nil {
+		return err
+	}
+	_, err = p.client.CoreV1().Pods(p.namespace).Patch(p.podName, types.MergePatchType, data)
+	p.clusterError = err
+	return err
+}
+
+func (p *KubernetesProvider) listOptions() metav1.ListOptions {
+	return metav1.ListOptions{LabelSelector: labelCluster + "=" + p.clusterName}
+}
+
+func (p *KubernetesProvider) listPods() error {
+	pods, err := p.client.CoreV1().Pods(p.namespace).List(p.listOptions())
+	p.clusterError = err
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	p.pods = make(map[string]*v1.Pod, len(pods.Items))
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		p.pods[pod.Name] = pod
+	}
+	p.resourceVersion = pods.ResourceVersion
+	p.mu.Unlock()
+
+	p.notifyStatuses()
+	return nil
+}
+
+// watchPods applies the changes to the pods of the cluster until the watch ends
+func (p *KubernetesProvider) watchPods() error {
+	options := p.listOptions()
+	p.mu.Lock()
+	options.ResourceVersion = p.resourceVersion
+	p.mu.Unlock()
+
+	watcher, err := p.client.CoreV1().Pods(p.namespace).Watch(options)
+	p.clusterError = err
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	p.watcher = watcher
+	p.mu.Unlock()
+	defer watcher.Stop()
+
+	for event := range watcher.ResultChan() {
+		pod, ok := event.Object.(*v1.Pod)
+		if !ok {
+			// e.g. the resource version is too old
+			return fmt.Errorf("unexpected watch event %v %T", event.Type, event.Object)
+		}
+
+		p.mu.Lock()
+		switch event.Type {
+		case watch.Added, watch.Modified:
+			if pod.Labels[labelCluster] == p.clusterName {
+				p.pods[pod.Name] = pod
+			} else {
+				delete(p.pods, pod.Name)
+			}
+		case watch.Deleted:
+			delete(p.pods, pod.Name)
+		}
+		p.resourceVersion = pod.ResourceVersion
+		p.mu.Unlock()
+
+		p.notifyStatuses()
+	}
+	return nil
+}
+
+func (p *KubernetesProvider) notifyStatuses() {
+	p.mu.Lock()
+	res := make(cluster.ClusterTopologyEvent, 0, len(p.pods))
+	for _, pod := range p.pods {
+		host := pod.Annotations[annotationHost]
+		port, err := strconv.Atoi(pod.Annotations[annotationPort])
+		if host == "" || err != nil {
+			// not registered yet
+			continue
+		}
+		var kinds []string
+		if value := pod.Annotations[annotationKinds]; value != "" {
+			kinds = strings.Split(value, ",")
+		}
+		res = append(res, &cluster.MemberStatus{
+			MemberID:    fmt.Sprintf("%v/%v:%v", p.clusterName, host, port),
+			Host:        host,
+			Port:        port,
+			Kinds:       kinds,
+			Alive:       pod.Status.Phase == v1.PodRunning && pod.DeletionTimestamp == nil,
+			StatusValue: p.statusValueSerializer.Deserialize(pod.Annotations[annotationStatusValue]),
+		})
+	}
+	p.mu.Unlock()
+
+	sort.Slice(res, func(i, j int) bool { return res[i].MemberID < res[j].MemberID })
+
+	// publish the current cluster topology onto the event stream
+	eventstream.Publish(res)
+}
@@ -0,0 +1,109 @@
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/cluster"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newPod(name string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Status:     v1.PodStatus{Phase: v1.PodRunning},
+	}
+}
+
+func subscribeTopology() (chan cluster.ClusterTopologyEvent, func()) {
+	events := make(chan cluster.ClusterTopologyEvent, 10)
+	sub := eventstream.Subscribe(func(evt interface{}) {
+		if topology, ok := evt.(cluster.ClusterTopologyEvent); ok {
+			events <- topology
+		}
+	})
+	return events, func() { eventstream.Unsubscribe(sub) }
+}
+
+func nextTopology(t *testing.T, events chan cluster.ClusterTopologyEvent) cluster.ClusterTopologyEvent {
+	select {
+	case topology := <-events:
+		return topology
+	case <-time.After(5 * time.Second):
+		t.Fatal("no topology published")
+		return nil
+	}
+}
+
+func TestRegisterMember(t *testing.T) {
+	client := fake.NewSimpleClientset(newPod("pod1"), newPod("other"))
+	events, unsubscribe := subscribeTopology()
+	defer unsubscribe()
+
+	p := NewWithClient(client, "default", "pod1")
+	err := p.RegisterMember("mycluster", "10.0.0.1", 8000, []string{"a", "b"}, nil, &cluster.NilMemberStatusValueSerializer{})
+	assert.NoError(t, err)
+
+	pod, err := client.CoreV1().Pods("default").Get("pod1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "mycluster", pod.Labels[labelCluster])
+	assert.Equal(t, "10.0.0.1", pod.Annotations[annotationHost])
+	assert.Equal(t, "8000", pod.Annotations[annotationPort])
+	assert.Equal(t, "a,b", pod.Annotations[annotationKinds])
+
+	topology := nextTopology(t, events)
+	if assert.Len(t, topology, 1) {
+		assert.Equal(t, "mycluster/10.0.0.1:8000", topology[0].MemberID)
+		assert.Equal(t, "10.0.0.1", topology[0].Host)
+		assert.Equal(t, 8000, topology[0].Port)
+		assert.Equal(t, []string{"a", "b"}, topology[0].Kinds)
+		assert.True(t, topology[0].Alive)
+	}
+
+	assert.NoError(t, p.DeregisterMember())
+	pod, _ = client.CoreV1().Pods("default").Get("pod1", metav1.GetOptions{})
+	assert.NotContains(t, pod.Labels, labelCluster)
+	assert.NotContains(t, pod.Annotations, annotationHost)
+}
+
+func TestMonitorMemberStatusChanges(t *testing.T) {
+	client := fake.NewSimpleClientset(newPod("pod1"), newPod("pod2"))
+	events, unsubscribe := subscribeTopology()
+	defer unsubscribe()
+
+	p1 := NewWithClient(client, "default", "pod1")
+	assert.NoError(t, p1.RegisterMember("mycluster", "10.0.0.1", 8000, []string{"a"}, nil, &cluster.NilMemberStatusValueSerializer{}))
+	assert.Len(t, nextTopology(t, events), 1)
+
+	p1.MonitorMemberStatusChanges()
+	defer p1.Shutdown()
+	// wait for the watch to be established
+	for i := 0; i < 100; i++ {
+		p1.mu.Lock()
+		watching := p1.watcher != nil
+		p1.mu.Unlock()
+		if watching {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// another member joins
+	p2 := NewWithClient(client, "default", "pod2")
+	assert.NoError(t, p2.RegisterMember("mycluster", "10.0.0.2", 8000, []string{"a"}, nil, &cluster.NilMemberStatusValueSerializer{}))
+	// the topology published by p2 itself
+	assert.Len(t, nextTopology(t, events), 2)
+	// the topology published by the watch of p1
+	assert.Len(t, nextTopology(t, events), 2)
+
+	// and its pod is deleted
+	assert.NoError(t, client.CoreV1().Pods("default").Delete("pod2", &metav1.DeleteOptions{}))
+	topology := nextTopology(t, events)
+	if assert.Len(t, topology, 1) {
+		assert.Equal(t, "mycluster/10.0.0.1:8000", topology[0].MemberID)
+	}
+}
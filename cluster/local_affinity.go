@@ -0,0 +1,28 @@
+package cluster
+
+// RequesterAwareMemberStrategy is implemented by the member strategies placing the activations depending on the member requesting them
+type RequesterAwareMemberStrategy interface {
+	MemberStrategy
+	// GetActivatorFor returns the member to activate a grain requested by the member with the given address on
+	GetActivatorFor(requester string) string
+}
+
+type localAffinityMemberStrategy struct {
+	MemberStrategy
+}
+
+// NewLocalAffinityMemberStrategy returns a member strategy activating a grain on the member where it was first requested,
+// if that member hosts the kind, which saves the hops between members for workloads with a natural locality.
+// It falls back to the default member strategy otherwise
+func NewLocalAffinityMemberStrategy(kind string) MemberStrategy {
+	return &localAffinityMemberStrategy{MemberStrategy: newDefaultMemberStrategy(kind)}
+}
+
+func (m *localAffinityMemberStrategy) GetActivatorFor(requester string) string {
+	for _, member := range m.GetAllMembers() {
+		if member.Alive && member.Address() == requester {
+			return requester
+		}
+	}
+	return m.GetActivator()
+}
@@ -0,0 +1,21 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalAffinityMemberStrategy(t *testing.T) {
+	ms := NewLocalAffinityMemberStrategy("kind")
+	ms.AddMember(&MemberStatus{Host: "127.0.0.1", Port: 1, Alive: true})
+	ms.AddMember(&MemberStatus{Host: "127.0.0.1", Port: 2, Alive: true})
+
+	s := ms.(RequesterAwareMemberStrategy)
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, "127.0.0.1:1", s.GetActivatorFor("127.0.0.1:1"))
+		assert.Equal(t, "127.0.0.1:2", s.GetActivatorFor("127.0.0.1:2"))
+	}
+	// the requester does not host the kind
+	assert.Contains(t, []string{"127.0.0.1:1", "127.0.0.1:2"}, s.GetActivatorFor("127.0.0.1:3"))
+}
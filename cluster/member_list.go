@@ -2,6 +2,7 @@ package cluster
 
 import (
 	"sync"
+	"time"
 
 	"github.com/AsynkronIT/protoactor-go/eventstream"
 	"github.com/AsynkronIT/protoactor-go/remote"
@@ -18,6 +19,13 @@ type memberListValue struct {
 	memberStrategyByKind map[string]MemberStrategy
 
 	membershipSub *eventstream.Subscription
+
+	// topologyVersion is incremented by every member event, the partitions settle the versions, see Health
+	topologyVersion uint64
+	// drainedKindVersions is the latest version of the kinds this member has drained its activations for
+	drainedKindVersions map[string]int
+	// lastTopology is the last topology published by the cluster provider, republished when a partition is simulated
+	lastTopology ClusterTopologyEvent
 }
 
 func setupMemberList() {
@@ -25,6 +33,7 @@ func setupMemberList() {
 		mutex:                &sync.RWMutex{},
 		members:              make(map[string]*MemberStatus),
 		memberStrategyByKind: make(map[string]MemberStrategy),
+		drainedKindVersions:  make(map[string]int),
 	}
 
 	memberList.membershipSub = eventstream.
@@ -62,35 +71,100 @@ func (ml *memberListValue) getPartitionMember(name, kind string) string {
 
 	var res string
 	if memberStrategy, ok := ml.memberStrategyByKind[kind]; ok {
-		res = memberStrategy.GetPartition(name)
+		res = cfg.getPartition(name, memberStrategy)
 	}
 	return res
 }
 
+// getPartitionMemberWithout returns the member owning the identity once the member with the given address has left
+func (ml *memberListValue) getPartitionMemberWithout(name, kind, address string) string {
+	ml.mutex.RLock()
+	defer ml.mutex.RUnlock()
+
+	memberStrategy, ok := ml.memberStrategyByKind[kind]
+	if !ok {
+		return ""
+	}
+	remaining := cfg.MemberStrategyBuilder(kind)
+	for _, m := range memberStrategy.GetAllMembers() {
+		if m.Address() != address {
+			remaining.AddMember(m)
+		}
+	}
+	if len(remaining.GetAllMembers()) == 0 {
+		return ""
+	}
+	return cfg.getPartition(name, remaining)
+}
+
 func (ml *memberListValue) getActivatorMember(kind string) string {
 	ml.mutex.RLock()
 	defer ml.mutex.RUnlock()
 
 	var res string
 	if memberStrategy, ok := ml.memberStrategyByKind[kind]; ok {
-		res = memberStrategy.GetActivator()
+		res = ml.selectActivator(kind, memberStrategy.GetActivator)
+	}
+	return res
+}
+
+// getActivatorMemberFor returns the member to activate a grain requested by the member with the given address on
+func (ml *memberListValue) getActivatorMemberFor(kind string, requester string) string {
+	ml.mutex.RLock()
+	defer ml.mutex.RUnlock()
+
+	var res string
+	switch memberStrategy := ml.memberStrategyByKind[kind].(type) {
+	case RequesterAwareMemberStrategy:
+		first := true
+		res = ml.selectActivator(kind, func() string {
+			if first {
+				first = false
+				return memberStrategy.GetActivatorFor(requester)
+			}
+			return memberStrategy.GetActivator()
+		})
+	case MemberStrategy:
+		res = ml.selectActivator(kind, memberStrategy.GetActivator)
 	}
 	return res
 }
 
+func (ml *memberListValue) isMember(address string) bool {
+	ml.mutex.RLock()
+	defer ml.mutex.RUnlock()
+
+	m, ok := ml.members[address]
+	return ok && m.Alive
+}
+
+// getStartedAt returns the start time of the member with the given address, false if it is not an alive member
+func (ml *memberListValue) getStartedAt(address string) (time.Time, bool) {
+	ml.mutex.RLock()
+	defer ml.mutex.RUnlock()
+
+	m, ok := ml.members[address]
+	if !ok || !m.Alive {
+		return time.Time{}, false
+	}
+	return m.StartedAt, true
+}
+
 func (ml *memberListValue) updateClusterTopology(m interface{}) {
 	ml.mutex.Lock()
 	defer ml.mutex.Unlock()
 
 	msg, _ := m.(ClusterTopologyEvent)
+	ml.lastTopology = msg
 
 	// build a lookup for the new statuses
 	tmp := make(map[string]*MemberStatus)
-	for _, new := range msg {
-		tmp[new.Address()] = new
+	for _, new := range reachableTopology(msg) {
+		tmp[new.Address()] = withKindVersions(withClient(withStartedAt(new)))
 	}
 
 	// first remove old ones
+	version := ml.topologyVersion
 	for key, old := range ml.members {
 		new := tmp[key]
 		if new == nil {
@@ -104,6 +178,16 @@ func (ml *memberListValue) updateClusterTopology(m interface{}) {
 		ml.members[key] = new
 		ml.updateAndNotify(new, old)
 	}
+	ml.checkKindVersions()
+	if ml.topologyVersion != version && partition != nil {
+		partition.settle(ml.topologyVersion)
+	}
+}
+
+// publish publishes the member event, it may only be called with a write lock on the member list
+func (ml *memberListValue) publish(evt MemberStatusEvent) {
+	ml.topologyVersion++
+	eventstream.PublishUnsafe(evt)
 }
 
 // updateAndNotify updates the member strategy and notifies all listeners. This function may only be called with an
@@ -131,7 +215,7 @@ func (ml *memberListValue) updateAndNotify(new *MemberStatus, old *MemberStatus)
 			Kinds: old.Kinds,
 		}
 		left := &MemberLeftEvent{MemberMeta: meta}
-		eventstream.PublishUnsafe(left)
+		ml.publish(left)
 		delete(ml.members, old.Address()) // remove this member as it has left
 
 		rt := &remote.EndpointTerminatedEvent{
@@ -157,13 +241,13 @@ func (ml *memberListValue) updateAndNotify(new *MemberStatus, old *MemberStatus)
 			Kinds: new.Kinds,
 		}
 		joined := &MemberJoinedEvent{MemberMeta: meta}
-		eventstream.PublishUnsafe(joined)
+		ml.publish(joined)
 
 		return
 	}
 
 	// update MemberStrategy
-	if new.Alive != old.Alive || new.MemberID != old.MemberID || new.StatusValue != nil && !new.StatusValue.IsSame(old.StatusValue) {
+	if new.Alive != old.Alive || new.MemberID != old.MemberID || !new.StartedAt.Equal(old.StartedAt) || new.StatusValue != nil && !new.StatusValue.IsSame(old.StatusValue) {
 		for _, k := range new.Kinds {
 			if _, ok := ml.memberStrategyByKind[k]; !ok {
 				ml.memberStrategyByKind[k] = cfg.MemberStrategyBuilder(k)
@@ -172,7 +256,7 @@ func (ml *memberListValue) updateAndNotify(new *MemberStatus, old *MemberStatus)
 		}
 	}
 
-	if new.MemberID != old.MemberID {
+	if new.MemberID != old.MemberID || !new.StartedAt.Equal(old.StartedAt) {
 		// notify member rejoined
 		meta := MemberMeta{
 			Host:  new.Host,
@@ -180,7 +264,7 @@ func (ml *memberListValue) updateAndNotify(new *MemberStatus, old *MemberStatus)
 			Kinds: new.Kinds,
 		}
 		joined := &MemberRejoinedEvent{MemberMeta: meta}
-		eventstream.PublishUnsafe(joined)
+		ml.publish(joined)
 
 		return
 	}
@@ -192,7 +276,7 @@ func (ml *memberListValue) updateAndNotify(new *MemberStatus, old *MemberStatus)
 			Kinds: new.Kinds,
 		}
 		unavailable := &MemberUnavailableEvent{MemberMeta: meta}
-		eventstream.PublishUnsafe(unavailable)
+		ml.publish(unavailable)
 
 		return
 	}
@@ -204,6 +288,6 @@ func (ml *memberListValue) updateAndNotify(new *MemberStatus, old *MemberStatus)
 			Kinds: new.Kinds,
 		}
 		available := &MemberAvailableEvent{MemberMeta: meta}
-		eventstream.PublishUnsafe(available)
+		ml.publish(available)
 	}
 }
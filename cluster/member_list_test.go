@@ -10,6 +10,7 @@ import (
 
 func TestPublishRaceCondition(t *testing.T) {
 	setupMemberList()
+	defer stopMemberList()
 	rounds := 1000
 
 	var wg sync.WaitGroup
@@ -1,6 +1,10 @@
 package cluster
 
-import "strconv"
+import (
+	"strconv"
+	"strings"
+	"time"
+)
 
 type MemberStatus struct {
 	MemberID    string
@@ -9,6 +13,62 @@ type MemberStatus struct {
 	Kinds       []string
 	Alive       bool
 	StatusValue MemberStatusValue
+	// KindVersions are the versions of the kinds run by the member, set by the member list
+	KindVersions map[string]int
+	// Client is true for the processes started with StartClient, which host no kinds and are not counted as members.
+	// It is set by the member list
+	Client bool
+	// StartedAt is the time the member started, advertised by the member itself so all members agree on it.
+	// It tells a member restarted at the same address apart, it is zero for members not advertising it.
+	// It is set by the member list
+	StartedAt time.Time
+}
+
+// startedAtKindPrefix prefixes the start time of a member, in unix nanoseconds, advertised in place of a kind
+const startedAtKindPrefix = "$started="
+
+// advertisedStartedAt returns the kind advertising the start time of this member
+func advertisedStartedAt(startedAt time.Time) string {
+	return startedAtKindPrefix + strconv.FormatInt(startedAt.UnixNano(), 10)
+}
+
+// withStartedAt moves the start time advertised by the member from the kinds to StartedAt
+func withStartedAt(status *MemberStatus) *MemberStatus {
+	res := *status
+	res.Kinds = make([]string, 0, len(status.Kinds))
+	for _, kind := range status.Kinds {
+		if !strings.HasPrefix(kind, startedAtKindPrefix) {
+			res.Kinds = append(res.Kinds, kind)
+			continue
+		}
+		if nanos, err := strconv.ParseInt(kind[len(startedAtKindPrefix):], 10, 64); err == nil {
+			res.StartedAt = time.Unix(0, nanos)
+		}
+	}
+	return &res
+}
+
+// clientKind is advertised by the clients in place of kinds, so the members tell them apart
+const clientKind = "$client"
+
+func advertisesClient(kinds []string) bool {
+	for _, kind := range kinds {
+		if kind == clientKind {
+			return true
+		}
+	}
+	return false
+}
+
+// withClient marks the status of a client, which advertises no kinds
+func withClient(status *MemberStatus) *MemberStatus {
+	if !advertisesClient(status.Kinds) {
+		return status
+	}
+	res := *status
+	res.Kinds = []string{}
+	res.Client = true
+	return &res
 }
 
 func (m *MemberStatus) Address() string {
@@ -0,0 +1,185 @@
+package cluster
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/log"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	"github.com/AsynkronIT/protoactor-go/scheduler"
+)
+
+// metricsInterval is the interval over which the request rates are measured
+const metricsInterval = 1 * time.Second
+
+var metrics *metricsValue
+
+// metricsValue counts the events of this member, the counters are read by the metrics actor
+type metricsValue struct {
+	mu         sync.Mutex
+	requests   map[string]int64
+	rebalances int64
+	pid        *actor.PID
+}
+
+func setupMetrics() {
+	m := &metricsValue{requests: make(map[string]int64)}
+	props := actor.PropsFromProducer(func() actor.Actor {
+		return &metricsActor{
+			metrics:      m,
+			lastRequests: make(map[string]int64),
+			requestRates: make(map[string]float64),
+		}
+	}).WithGuardian(actor.RestartingSupervisorStrategy())
+	m.pid, _ = rootContext.SpawnNamed(props, "metrics")
+	metrics = m
+}
+
+func stopMetrics() {
+	if metrics != nil {
+		rootContext.StopFuture(metrics.pid).Wait()
+		metrics = nil
+	}
+}
+
+func (m *metricsValue) addRequest(kind string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	m.requests[kind]++
+	m.mu.Unlock()
+}
+
+func (m *metricsValue) addRebalance() {
+	if m == nil {
+		return
+	}
+	atomic.AddInt64(&m.rebalances, 1)
+}
+
+type metricsTick struct{}
+
+// metricsActor answers the metrics of this member to the members aggregating the metrics of the cluster
+type metricsActor struct {
+	metrics      *metricsValue
+	lastRequests map[string]int64
+	requestRates map[string]float64
+	cancelTick   scheduler.CancelFunc
+}
+
+func (state *metricsActor) Receive(ctx actor.Context) {
+	switch ctx.Message().(type) {
+	case *actor.Started:
+		s := scheduler.NewTimerScheduler(scheduler.WithContext(ctx))
+		state.cancelTick = s.SendRepeatedly(metricsInterval, metricsInterval, ctx.Self(), &metricsTick{})
+	case *actor.Stopping:
+		state.cancelTick()
+	case *metricsTick:
+		state.updateRequestRates(metricsInterval)
+	case *MemberMetricsRequest:
+		activations := make(map[string]int64)
+		counts, err := remote.ActivationCounts(cfg.TimeoutTime)
+		if err != nil {
+			plog.Error("Failed to get activation counts", log.Error(err))
+		}
+		for kind, count := range counts {
+			activations[kind] = int64(count)
+		}
+		requestRates := make(map[string]float64, len(state.requestRates))
+		for kind, rate := range state.requestRates {
+			requestRates[kind] = rate
+		}
+		ctx.Respond(&MemberMetrics{
+			Address:      actor.ProcessRegistry.Address,
+			Activations:  activations,
+			RequestRates: requestRates,
+			Rebalances:   atomic.LoadInt64(&state.metrics.rebalances),
+		})
+	}
+}
+
+func (state *metricsActor) updateRequestRates(interval time.Duration) {
+	state.metrics.mu.Lock()
+	defer state.metrics.mu.Unlock()
+	for kind, count := range state.metrics.requests {
+		state.requestRates[kind] = float64(count-state.lastRequests[kind]) / interval.Seconds()
+		state.lastRequests[kind] = count
+	}
+}
+
+// ClusterMetrics is a snapshot of the metrics of all members of the cluster
+type ClusterMetrics struct {
+	Members []*MemberMetrics `json:"members"`
+	// Activations is the number of alive activations in the cluster per kind
+	Activations map[string]int64 `json:"activations"`
+	// RequestRates is the number of activations requested from all members per second and kind
+	RequestRates map[string]float64 `json:"requestRates"`
+	// Rebalances is the number of identities transferred between members
+	Rebalances int64 `json:"rebalances"`
+	// Unreachable lists the members which did not answer their metrics in time
+	Unreachable []string `json:"unreachable,omitempty"`
+}
+
+// Metrics requests the metrics of all members and aggregates them, members which do not answer within the timeout are reported as unreachable
+func Metrics(timeout time.Duration) *ClusterMetrics {
+	var addresses []string
+	if ml := memberList; ml != nil {
+		ml.mutex.RLock()
+		for address, m := range ml.members {
+			if m.Alive {
+				addresses = append(addresses, address)
+			}
+		}
+		ml.mutex.RUnlock()
+	}
+	sort.Strings(addresses)
+
+	futures := make([]*actor.Future, len(addresses))
+	for i, address := range addresses {
+		futures[i] = rootContext.RequestFuture(actor.NewPID(address, "metrics"), &MemberMetricsRequest{}, timeout)
+	}
+	members := make([]*MemberMetrics, 0, len(addresses))
+	var unreachable []string
+	for i, f := range futures {
+		res, err := f.Result()
+		if m, ok := res.(*MemberMetrics); ok && err == nil {
+			members = append(members, m)
+		} else {
+			unreachable = append(unreachable, addresses[i])
+		}
+	}
+	return newClusterMetrics(members, unreachable)
+}
+
+func newClusterMetrics(members []*MemberMetrics, unreachable []string) *ClusterMetrics {
+	res := &ClusterMetrics{
+		Members:      members,
+		Activations:  make(map[string]int64),
+		RequestRates: make(map[string]float64),
+		Unreachable:  unreachable,
+	}
+	for _, m := range members {
+		for kind, count := range m.Activations {
+			res.Activations[kind] += count
+		}
+		for kind, rate := range m.RequestRates {
+			res.RequestRates[kind] += rate
+		}
+		res.Rebalances += m.Rebalances
+	}
+	return res
+}
+
+// MetricsHandler serves the metrics of the cluster as JSON, so they do not have to be scraped from every member
+func MetricsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Metrics(cfg.TimeoutTime))
+	})
+}
@@ -0,0 +1,45 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsActor_RequestRates(t *testing.T) {
+	m := &metricsValue{requests: make(map[string]int64)}
+	state := &metricsActor{metrics: m, lastRequests: make(map[string]int64), requestRates: make(map[string]float64)}
+
+	for i := 0; i < 10; i++ {
+		m.addRequest("a")
+	}
+	state.updateRequestRates(2 * time.Second)
+	assert.Equal(t, map[string]float64{"a": 5}, state.requestRates)
+
+	m.addRequest("a")
+	m.addRequest("b")
+	state.updateRequestRates(time.Second)
+	assert.Equal(t, map[string]float64{"a": 1, "b": 1}, state.requestRates)
+
+	state.updateRequestRates(time.Second)
+	assert.Equal(t, map[string]float64{"a": 0, "b": 0}, state.requestRates)
+
+	// the counters are not set up when the cluster is not started
+	var stopped *metricsValue
+	stopped.addRequest("a")
+	stopped.addRebalance()
+}
+
+func TestNewClusterMetrics(t *testing.T) {
+	res := newClusterMetrics([]*MemberMetrics{
+		{Address: "127.0.0.1:1", Activations: map[string]int64{"a": 2}, RequestRates: map[string]float64{"a": 1.5}, Rebalances: 1},
+		{Address: "127.0.0.1:2", Activations: map[string]int64{"a": 1, "b": 3}, RequestRates: map[string]float64{"b": 2}, Rebalances: 2},
+	}, []string{"127.0.0.1:3"})
+
+	assert.Equal(t, map[string]int64{"a": 3, "b": 3}, res.Activations)
+	assert.Equal(t, map[string]float64{"a": 1.5, "b": 2}, res.RequestRates)
+	assert.Equal(t, int64(3), res.Rebalances)
+	assert.Equal(t, []string{"127.0.0.1:3"}, res.Unreachable)
+	assert.Len(t, res.Members, 2)
+}
@@ -1,6 +1,8 @@
 package cluster
 
 import (
+	"sync"
+
 	"github.com/AsynkronIT/protoactor-go/actor"
 	"github.com/AsynkronIT/protoactor-go/eventstream"
 	"github.com/AsynkronIT/protoactor-go/log"
@@ -12,11 +14,15 @@ var partition *partitionValue
 type partitionValue struct {
 	kindPIDMap        map[string]*actor.PID
 	partitionKindsSub *eventstream.Subscription
+
+	mutex   sync.Mutex
+	settled map[string]uint64 // latest topology version settled by the partition actor of each kind
 }
 
 func setupPartition(kinds []string) {
 	partition = &partitionValue{
 		kindPIDMap: make(map[string]*actor.PID),
+		settled:    make(map[string]uint64),
 	}
 
 	for _, kind := range kinds {
@@ -49,6 +55,34 @@ func (p *partitionValue) partitionForKind(address, kind string) *actor.PID {
 	return pid
 }
 
+// settle makes the partition actors report the topology version settled once they have handled its member events
+// and the identities they no longer own are taken over by their new owners
+func (p *partitionValue) settle(version uint64) {
+	for _, kindPID := range p.kindPIDMap {
+		rootContext.Send(kindPID, &settlePartition{version: version})
+	}
+}
+
+func (p *partitionValue) reportSettled(kind string, version uint64) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if version > p.settled[kind] {
+		p.settled[kind] = version
+	}
+}
+
+// isSettled returns true when the partition actors of all kinds have settled the topology version
+func (p *partitionValue) isSettled(version uint64) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for kind := range p.kindPIDMap {
+		if p.settled[kind] < version {
+			return false
+		}
+	}
+	return true
+}
+
 type spawningProcess struct {
 	*actor.Future
 	spawningAddress string
@@ -59,6 +93,33 @@ type partitionActor struct {
 	keyNameMap map[string]string           // actor/grain key to name
 	spawnings  map[string]*spawningProcess // spawning actor/grain futures
 	kind       string
+	leaving    bool   // the member is leaving, the identities are owned by the other members
+	transfers  int    // identities transferred to other members which did not take them over yet
+	settling   uint64 // latest topology version to report settled once the transfers are done
+}
+
+// settlePartition is sent to the partition actors after the member events of a topology version
+type settlePartition struct {
+	version uint64
+}
+
+// leavePartition makes a partition actor hand over its identities to the members owning them once this member has left
+type leavePartition struct{}
+
+type leavePartitionResponse struct{}
+
+// leavePartitions hands over the identities owned by the partition actors of this member, which forward the requests
+// they still receive to the new owners until they are stopped
+func leavePartitions() {
+	futures := make([]*actor.Future, 0, len(partition.kindPIDMap))
+	for _, kindPID := range partition.kindPIDMap {
+		futures = append(futures, rootContext.RequestFuture(kindPID, &leavePartition{}, cfg.TimeoutTime))
+	}
+	for _, f := range futures {
+		if _, err := f.Result(); err != nil {
+			plog.Error("Failed to hand over the partition", log.Error(err))
+		}
+	}
 }
 
 func spawnPartitionActor(kind string) *actor.PID {
@@ -88,6 +149,13 @@ func (state *partitionActor) Receive(context actor.Context) {
 		state.terminated(msg)
 	case *TakeOwnership:
 		state.takeOwnership(msg, context)
+	case *settlePartition:
+		state.settling = msg.version
+		state.reportSettled()
+	case *BroadcastRequest:
+		state.broadcast(msg)
+	case *leavePartition:
+		state.leave(context)
 	case *MemberJoinedEvent:
 		state.memberJoined(msg, context)
 	case *MemberRejoinedEvent:
@@ -106,6 +174,11 @@ func (state *partitionActor) Receive(context actor.Context) {
 }
 
 func (state *partitionActor) spawn(msg *remote.ActorPidRequest, context actor.Context) {
+	if state.leaving {
+		state.forward(msg.Name, msg, context)
+		return
+	}
+
 	// Check if exist in current partition dictionary
 	pid := state.partition[msg.Name]
 	if pid != nil {
@@ -128,8 +201,13 @@ func (state *partitionActor) spawn(msg *remote.ActorPidRequest, context actor.Co
 		return
 	}
 
-	// Get activator
-	activator := memberList.getActivatorMember(msg.Kind)
+	// Get activator, the requests are made from futures of the requesting member
+	var activator string
+	if sender := context.Sender(); sender != nil {
+		activator = memberList.getActivatorMemberFor(msg.Kind, sender.Address)
+	} else {
+		activator = memberList.getActivatorMember(msg.Kind)
+	}
 	if activator == "" {
 		// No activator currently available, return unavailable
 		context.Respond(remote.ActorPidRespUnavailable)
@@ -164,6 +242,9 @@ func (state *partitionActor) spawn(msg *remote.ActorPidRequest, context actor.Co
 			state.partition[msg.Name] = pid
 			state.keyNameMap[pid.String()] = msg.Name
 			context.Watch(pid)
+			if state.leaving {
+				state.transferToSuccessor(msg.Name, context)
+			}
 		}
 
 		context.Respond(response)
@@ -285,10 +366,19 @@ func (state *partitionActor) memberJoined(msg *MemberJoinedEvent, context actor.
 func (state *partitionActor) transferOwnership(actorID string, address string, context actor.Context) {
 	pid := state.partition[actorID]
 	owner := partition.partitionForKind(address, state.kind)
-	context.Send(owner, &TakeOwnership{
+	future := context.RequestFuture(owner, &TakeOwnership{
 		Pid:  pid,
 		Name: actorID,
+	}, cfg.TimeoutTime)
+	state.transfers++
+	context.AwaitFuture(future, func(res interface{}, err error) {
+		if err != nil {
+			plog.Error("Partition failed to transfer ownership", log.String("kind", state.kind), log.String("name", actorID), log.String("address", address), log.Error(err))
+		}
+		state.transfers--
+		state.reportSettled()
 	})
+	metrics.addRebalance()
 	// we can safely delete this entry as the consistent hash no longer points to us
 	delete(state.partition, actorID)
 	delete(state.keyNameMap, pid.String())
@@ -296,16 +386,60 @@ func (state *partitionActor) transferOwnership(actorID string, address string, c
 }
 
 func (state *partitionActor) takeOwnership(msg *TakeOwnership, context actor.Context) {
+	if state.leaving {
+		state.forward(msg.Name, msg, context)
+		return
+	}
 	// Check again if I'm the owner
 	address := memberList.getPartitionMember(msg.Name, state.kind)
 	if address != "" && address != actor.ProcessRegistry.Address {
-		// if not, forward to the correct owner
+		// if not, forward to the correct owner, which acknowledges it to the previous owner
 		owner := partition.partitionForKind(address, state.kind)
-		context.Send(owner, msg)
+		context.Forward(owner)
 		return
 	}
 	// Cache ownership
 	state.partition[msg.Name] = msg.Pid
 	state.keyNameMap[msg.Pid.String()] = msg.Name
 	context.Watch(msg.Pid)
+	if context.Sender() != nil {
+		context.Respond(&TakeOwnershipResponse{})
+	}
+}
+
+// reportSettled reports the topology version settled once the identities transferred to other members are taken over
+func (state *partitionActor) reportSettled() {
+	if state.transfers == 0 && partition != nil {
+		partition.reportSettled(state.kind, state.settling)
+	}
+}
+
+func (state *partitionActor) leave(context actor.Context) {
+	plog.Info("Handing over partition", log.String("kind", state.kind), log.Int("identities", len(state.partition)))
+	state.leaving = true
+	for actorID := range state.partition {
+		state.transferToSuccessor(actorID, context)
+	}
+	context.Respond(&leavePartitionResponse{})
+}
+
+// transferToSuccessor transfers the ownership of the identity to the member owning it once this member has left
+func (state *partitionActor) transferToSuccessor(actorID string, context actor.Context) {
+	address := memberList.getPartitionMemberWithout(actorID, state.kind, actor.ProcessRegistry.Address)
+	if address == "" {
+		return
+	}
+	state.transferOwnership(actorID, address, context)
+}
+
+// forward forwards the message for the identity to the member owning it once this member has left
+func (state *partitionActor) forward(actorID string, msg interface{}, context actor.Context) {
+	address := memberList.getPartitionMemberWithout(actorID, state.kind, actor.ProcessRegistry.Address)
+	if address == "" {
+		if _, ok := msg.(*remote.ActorPidRequest); ok {
+			context.Respond(remote.ActorPidRespUnavailable)
+		}
+		return
+	}
+	context.Forward(partition.partitionForKind(address, state.kind))
 }
@@ -0,0 +1,76 @@
+package cluster
+
+import (
+	"hash/fnv"
+	"strconv"
+)
+
+// PartitionStrategy decides which member owns an identity, i.e. which member's partition actor locates its activation.
+// All members must use the same strategy and partition count, as every member locates the owners of the identities itself
+type PartitionStrategy interface {
+	// GetPartition returns the address of the member owning the key among the members of the kind,
+	// or "" if none of them is alive
+	GetPartition(key string, members []*MemberStatus) string
+}
+
+// partitionKey returns the key the identity is partitioned by, which is the identity itself
+// or the partition it is hashed to when the number of partitions is configured
+func (c *ClusterConfig) partitionKey(name string) string {
+	if c.PartitionCount <= 0 {
+		return name
+	}
+	hasher := fnv.New32a()
+	hasher.Write([]byte(name))
+	return "partition-" + strconv.Itoa(int(hasher.Sum32()%uint32(c.PartitionCount)))
+}
+
+func (c *ClusterConfig) getPartition(name string, memberStrategy MemberStrategy) string {
+	key := c.partitionKey(name)
+	if c.PartitionStrategy != nil {
+		return c.PartitionStrategy.GetPartition(key, memberStrategy.GetAllMembers())
+	}
+	return memberStrategy.GetPartition(key)
+}
+
+// RendezvousPartitionStrategy places an identity on the alive member with the highest hash of the identity and the member
+// address (highest random weight hashing). A leaving member only moves its own identities and a joining member only takes
+// identities from the other members, which moves the fewest identities when the topology changes
+type RendezvousPartitionStrategy struct{}
+
+// NewRendezvousPartitionStrategy returns a partition strategy independent of the member strategies,
+// which select the members to activate the grains on
+func NewRendezvousPartitionStrategy() PartitionStrategy {
+	return &RendezvousPartitionStrategy{}
+}
+
+func (s *RendezvousPartitionStrategy) GetPartition(key string, members []*MemberStatus) string {
+	var res string
+	var maxScore uint64
+	for _, m := range members {
+		if !m.Alive {
+			continue
+		}
+		address := m.Address()
+		score := rendezvousScore(key, address)
+		if res == "" || score > maxScore || score == maxScore && address > res {
+			res = address
+			maxScore = score
+		}
+	}
+	return res
+}
+
+func rendezvousScore(key string, address string) uint64 {
+	hasher := fnv.New64a()
+	hasher.Write([]byte(key))
+	hasher.Write([]byte{0})
+	hasher.Write([]byte(address))
+	// mix the bits, as the hashes of similar addresses differ little
+	h := hasher.Sum64()
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
@@ -0,0 +1,101 @@
+package cluster
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type firstMemberPartitionStrategy struct {
+	keys []string
+}
+
+func (s *firstMemberPartitionStrategy) GetPartition(key string, members []*MemberStatus) string {
+	s.keys = append(s.keys, key)
+	return members[0].Address()
+}
+
+func testMemberStrategy(ports ...int) MemberStrategy {
+	ms := newDefaultMemberStrategy("kind")
+	for _, port := range ports {
+		ms.AddMember(&MemberStatus{Host: "127.0.0.1", Port: port, Alive: true})
+	}
+	return ms
+}
+
+func TestClusterConfig_PartitionStrategy(t *testing.T) {
+	ms := testMemberStrategy(1, 2, 3)
+	strategy := &firstMemberPartitionStrategy{}
+	c := NewClusterConfig("mycluster", "127.0.0.1:0", nil).WithPartitionStrategy(strategy)
+
+	assert.Equal(t, "127.0.0.1:1", c.getPartition("a", ms))
+	assert.Equal(t, []string{"a"}, strategy.keys)
+}
+
+func TestClusterConfig_PartitionCount(t *testing.T) {
+	ms := testMemberStrategy(1, 2, 3)
+	c := NewClusterConfig("mycluster", "127.0.0.1:0", nil)
+	assert.Equal(t, "a", c.partitionKey("a"))
+
+	c.WithPartitionCount(4)
+	partitions := make(map[string]bool)
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"} {
+		key := c.partitionKey(name)
+		partitions[key] = true
+		// the identities of a partition are owned by the same member
+		assert.Equal(t, ms.GetPartition(key), c.getPartition(name, ms))
+	}
+	assert.True(t, len(partitions) <= 4)
+}
+
+func placeIdentities(strategy PartitionStrategy, count int, members []*MemberStatus) map[string]string {
+	res := make(map[string]string, count)
+	for i := 0; i < count; i++ {
+		key := "identity" + strconv.Itoa(i)
+		res[key] = strategy.GetPartition(key, members)
+	}
+	return res
+}
+
+func TestRendezvousPartitionStrategy_Distribution(t *testing.T) {
+	placements := placeIdentities(NewRendezvousPartitionStrategy(), 10000, members(8001, 8002, 8003, 8004))
+	counts := make(map[string]int)
+	for _, address := range placements {
+		counts[address]++
+	}
+	assert.Len(t, counts, 4)
+	for address, count := range counts {
+		assert.InDelta(t, 2500, count, 300, "member %v", address)
+	}
+}
+
+func TestRendezvousPartitionStrategy_Replacements(t *testing.T) {
+	const count = 10000
+	strategy := NewRendezvousPartitionStrategy()
+	before := placeIdentities(strategy, count, members(8001, 8002, 8003, 8004))
+
+	// a joining member only takes identities from the others, about a fifth of them
+	joined := placeIdentities(strategy, count, members(8001, 8002, 8003, 8004, 8005))
+	moved := 0
+	for key, address := range joined {
+		if address != before[key] {
+			moved++
+			assert.Equal(t, "127.0.0.1:8005", address)
+		}
+	}
+	assert.InDelta(t, count/5, moved, count/20)
+
+	// a leaving member only moves its own identities
+	left := members(8001, 8002, 8003, 8004)
+	left[1].Alive = false
+	after := placeIdentities(strategy, count, left)
+	moved = 0
+	for key, address := range after {
+		if address != before[key] {
+			moved++
+			assert.Equal(t, "127.0.0.1:8002", before[key])
+		}
+	}
+	assert.InDelta(t, count/4, moved, count/20)
+}
@@ -0,0 +1,58 @@
+package cluster
+
+import (
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/log"
+	"github.com/AsynkronIT/protoactor-go/remote"
+)
+
+// Passivatable is implemented by grains which need to persist their state before they are passivated,
+// generated grains forward PrePassivate to the grain implementation
+type Passivatable interface {
+	PrePassivate(ctx actor.Context)
+}
+
+// setupPassivation applies the idle timeouts to the registered props of the kinds
+func setupPassivation(timeouts map[string]time.Duration) {
+	for kind, timeout := range timeouts {
+		props, ok := remote.GetKnownKind(kind)
+		if !ok {
+			plog.Info("Idle timeout configured for an unknown kind", log.String("kind", kind))
+			continue
+		}
+		remote.Register(kind, props.WithReceiverMiddleware(IdlePassivation(timeout)))
+	}
+}
+
+// IdlePassivation returns a receiver middleware stopping the actor once it has not received a message for the given timeout.
+// The actor is given the chance to persist its state when it implements Passivatable. Once stopped, the partition
+// owning the actor forgets it, so the next request for its identity activates it again.
+//
+// It relies on the receive timeout of the actor, which must not be changed by the actor itself
+func IdlePassivation(timeout time.Duration) actor.ReceiverMiddleware {
+	return func(next actor.ReceiverFunc) actor.ReceiverFunc {
+		return func(c actor.ReceiverContext, envelope *actor.MessageEnvelope) {
+			ctx, ok := c.(actor.Context)
+			if !ok {
+				next(c, envelope)
+				return
+			}
+
+			switch envelope.Message.(type) {
+			case *actor.Started:
+				next(c, envelope)
+				ctx.SetReceiveTimeout(timeout)
+			case *actor.ReceiveTimeout:
+				plog.Debug("Passivating idle actor", log.Stringer("pid", ctx.Self()))
+				if p, ok := ctx.Actor().(Passivatable); ok {
+					p.PrePassivate(ctx)
+				}
+				ctx.Stop(ctx.Self())
+			default:
+				next(c, envelope)
+			}
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package cluster
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	"github.com/stretchr/testify/assert"
+)
+
+type passivatableGrain struct {
+	passivated *int32
+}
+
+func (g *passivatableGrain) Receive(ctx actor.Context) {}
+
+func (g *passivatableGrain) PrePassivate(ctx actor.Context) {
+	atomic.AddInt32(g.passivated, 1)
+}
+
+func TestIdlePassivation(t *testing.T) {
+	var passivated int32
+	props := actor.PropsFromProducer(func() actor.Actor { return &passivatableGrain{&passivated} }).
+		WithReceiverMiddleware(IdlePassivation(100 * time.Millisecond))
+	pid := rootContext.Spawn(props)
+
+	// messages keep the actor alive
+	for i := 0; i < 4; i++ {
+		time.Sleep(50 * time.Millisecond)
+		rootContext.Send(pid, "keepalive")
+	}
+	_, found := actor.ProcessRegistry.LocalPIDs.Get(pid.Id)
+	assert.True(t, found)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&passivated))
+
+	time.Sleep(300 * time.Millisecond)
+	_, found = actor.ProcessRegistry.LocalPIDs.Get(pid.Id)
+	assert.False(t, found)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&passivated))
+}
+
+func TestSetupPassivation(t *testing.T) {
+	var passivated int32
+	remote.Register("passivatable", actor.PropsFromProducer(func() actor.Actor { return &passivatableGrain{&passivated} }))
+
+	setupPassivation(map[string]time.Duration{"passivatable": 50 * time.Millisecond, "unknown": time.Second})
+
+	props, ok := remote.GetKnownKind("passivatable")
+	assert.True(t, ok)
+	rootContext.Spawn(props)
+	time.Sleep(200 * time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&passivated))
+}
@@ -9,9 +9,22 @@
 
 	It has these top-level messages:
 		TakeOwnership
+		TakeOwnershipResponse
 		GrainRequest
 		GrainResponse
 		GrainErrorResponse
+		ClusterIdentity
+		SubscriberIdentity
+		SubscribeRequest
+		SubscribeResponse
+		UnsubscribeRequest
+		UnsubscribeResponse
+		PublishRequest
+		PublishResponse
+		ReminderFired
+		BroadcastRequest
+		MemberMetricsRequest
+		MemberMetrics
 */
 package cluster
 
@@ -23,8 +36,11 @@ import actor "github.com/AsynkronIT/protoactor-go/actor"
 
 import bytes "bytes"
 
+import binary "encoding/binary"
+
 import strings "strings"
 import reflect "reflect"
+import sortkeys "github.com/gogo/protobuf/sortkeys"
 
 import io "io"
 
@@ -62,14 +78,22 @@ func (m *TakeOwnership) GetName() string {
 	return ""
 }
 
+type TakeOwnershipResponse struct {
+}
+
+func (m *TakeOwnershipResponse) Reset()                    { *m = TakeOwnershipResponse{} }
+func (*TakeOwnershipResponse) ProtoMessage()               {}
+func (*TakeOwnershipResponse) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{1} }
+
 type GrainRequest struct {
-	MethodIndex int32  `protobuf:"varint,1,opt,name=method_index,json=methodIndex,proto3" json:"method_index,omitempty"`
-	MessageData []byte `protobuf:"bytes,2,opt,name=message_data,json=messageData,proto3" json:"message_data,omitempty"`
+	MethodIndex int32             `protobuf:"varint,1,opt,name=method_index,json=methodIndex,proto3" json:"method_index,omitempty"`
+	MessageData []byte            `protobuf:"bytes,2,opt,name=message_data,json=messageData,proto3" json:"message_data,omitempty"`
+	Headers     map[string]string `protobuf:"bytes,3,rep,name=headers" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *GrainRequest) Reset()                    { *m = GrainRequest{} }
 func (*GrainRequest) ProtoMessage()               {}
-func (*GrainRequest) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{1} }
+func (*GrainRequest) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{2} }
 
 func (m *GrainRequest) GetMethodIndex() int32 {
 	if m != nil {
@@ -85,13 +109,20 @@ func (m *GrainRequest) GetMessageData() []byte {
 	return nil
 }
 
+func (m *GrainRequest) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
 type GrainResponse struct {
 	MessageData []byte `protobuf:"bytes,1,opt,name=message_data,json=messageData,proto3" json:"message_data,omitempty"`
 }
 
 func (m *GrainResponse) Reset()                    { *m = GrainResponse{} }
 func (*GrainResponse) ProtoMessage()               {}
-func (*GrainResponse) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{2} }
+func (*GrainResponse) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{3} }
 
 func (m *GrainResponse) GetMessageData() []byte {
 	if m != nil {
@@ -106,7 +137,7 @@ type GrainErrorResponse struct {
 
 func (m *GrainErrorResponse) Reset()                    { *m = GrainErrorResponse{} }
 func (*GrainErrorResponse) ProtoMessage()               {}
-func (*GrainErrorResponse) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{3} }
+func (*GrainErrorResponse) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{4} }
 
 func (m *GrainErrorResponse) GetErr() string {
 	if m != nil {
@@ -115,18 +146,264 @@ func (m *GrainErrorResponse) GetErr() string {
 	return ""
 }
 
+type ClusterIdentity struct {
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+}
+
+func (m *ClusterIdentity) Reset()                    { *m = ClusterIdentity{} }
+func (*ClusterIdentity) ProtoMessage()               {}
+func (*ClusterIdentity) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{5} }
+
+func (m *ClusterIdentity) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ClusterIdentity) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+// SubscriberIdentity is either the PID of an actor or the identity of a grain
+type SubscriberIdentity struct {
+	Pid             *actor.PID       `protobuf:"bytes,1,opt,name=pid" json:"pid,omitempty"`
+	ClusterIdentity *ClusterIdentity `protobuf:"bytes,2,opt,name=cluster_identity,json=clusterIdentity" json:"cluster_identity,omitempty"`
+}
+
+func (m *SubscriberIdentity) Reset()                    { *m = SubscriberIdentity{} }
+func (*SubscriberIdentity) ProtoMessage()               {}
+func (*SubscriberIdentity) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{6} }
+
+func (m *SubscriberIdentity) GetPid() *actor.PID {
+	if m != nil {
+		return m.Pid
+	}
+	return nil
+}
+
+func (m *SubscriberIdentity) GetClusterIdentity() *ClusterIdentity {
+	if m != nil {
+		return m.ClusterIdentity
+	}
+	return nil
+}
+
+type SubscribeRequest struct {
+	Subscriber *SubscriberIdentity `protobuf:"bytes,1,opt,name=subscriber" json:"subscriber,omitempty"`
+}
+
+func (m *SubscribeRequest) Reset()                    { *m = SubscribeRequest{} }
+func (*SubscribeRequest) ProtoMessage()               {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{7} }
+
+func (m *SubscribeRequest) GetSubscriber() *SubscriberIdentity {
+	if m != nil {
+		return m.Subscriber
+	}
+	return nil
+}
+
+type SubscribeResponse struct {
+}
+
+func (m *SubscribeResponse) Reset()                    { *m = SubscribeResponse{} }
+func (*SubscribeResponse) ProtoMessage()               {}
+func (*SubscribeResponse) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{8} }
+
+type UnsubscribeRequest struct {
+	Subscriber *SubscriberIdentity `protobuf:"bytes,1,opt,name=subscriber" json:"subscriber,omitempty"`
+}
+
+func (m *UnsubscribeRequest) Reset()                    { *m = UnsubscribeRequest{} }
+func (*UnsubscribeRequest) ProtoMessage()               {}
+func (*UnsubscribeRequest) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{9} }
+
+func (m *UnsubscribeRequest) GetSubscriber() *SubscriberIdentity {
+	if m != nil {
+		return m.Subscriber
+	}
+	return nil
+}
+
+type UnsubscribeResponse struct {
+}
+
+func (m *UnsubscribeResponse) Reset()                    { *m = UnsubscribeResponse{} }
+func (*UnsubscribeResponse) ProtoMessage()               {}
+func (*UnsubscribeResponse) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{10} }
+
+type PublishRequest struct {
+	MessageData  []byte `protobuf:"bytes,1,opt,name=message_data,json=messageData,proto3" json:"message_data,omitempty"`
+	TypeName     string `protobuf:"bytes,2,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	SerializerId int32  `protobuf:"varint,3,opt,name=serializer_id,json=serializerId,proto3" json:"serializer_id,omitempty"`
+}
+
+func (m *PublishRequest) Reset()                    { *m = PublishRequest{} }
+func (*PublishRequest) ProtoMessage()               {}
+func (*PublishRequest) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{11} }
+
+func (m *PublishRequest) GetMessageData() []byte {
+	if m != nil {
+		return m.MessageData
+	}
+	return nil
+}
+
+func (m *PublishRequest) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *PublishRequest) GetSerializerId() int32 {
+	if m != nil {
+		return m.SerializerId
+	}
+	return 0
+}
+
+type PublishResponse struct {
+	// failed is the number of subscribers the message could not be delivered to
+	Failed int32 `protobuf:"varint,1,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (m *PublishResponse) Reset()                    { *m = PublishResponse{} }
+func (*PublishResponse) ProtoMessage()               {}
+func (*PublishResponse) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{12} }
+
+func (m *PublishResponse) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+// ReminderFired is sent to a grain when one of its reminders is due
+type ReminderFired struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *ReminderFired) Reset()                    { *m = ReminderFired{} }
+func (*ReminderFired) ProtoMessage()               {}
+func (*ReminderFired) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{13} }
+
+func (m *ReminderFired) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// BroadcastRequest is sent to the partition actors of a kind to deliver a message to the activations they know of
+type BroadcastRequest struct {
+	MessageData  []byte `protobuf:"bytes,1,opt,name=message_data,json=messageData,proto3" json:"message_data,omitempty"`
+	TypeName     string `protobuf:"bytes,2,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	SerializerId int32  `protobuf:"varint,3,opt,name=serializer_id,json=serializerId,proto3" json:"serializer_id,omitempty"`
+}
+
+func (m *BroadcastRequest) Reset()                    { *m = BroadcastRequest{} }
+func (*BroadcastRequest) ProtoMessage()               {}
+func (*BroadcastRequest) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{14} }
+
+func (m *BroadcastRequest) GetMessageData() []byte {
+	if m != nil {
+		return m.MessageData
+	}
+	return nil
+}
+
+func (m *BroadcastRequest) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *BroadcastRequest) GetSerializerId() int32 {
+	if m != nil {
+		return m.SerializerId
+	}
+	return 0
+}
+
+type MemberMetricsRequest struct {
+}
+
+func (m *MemberMetricsRequest) Reset()                    { *m = MemberMetricsRequest{} }
+func (*MemberMetricsRequest) ProtoMessage()               {}
+func (*MemberMetricsRequest) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{15} }
+
+// MemberMetrics are the metrics of a member since it started
+type MemberMetrics struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// activations is the number of alive activations on the member per kind
+	Activations map[string]int64 `protobuf:"bytes,2,rep,name=activations" json:"activations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// request_rates is the number of activations requested from the member per second and kind, over the last second
+	RequestRates map[string]float64 `protobuf:"bytes,3,rep,name=request_rates,json=requestRates" json:"request_rates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	// rebalances is the number of identities the member transferred to other members as the topology changed
+	Rebalances int64 `protobuf:"varint,4,opt,name=rebalances,proto3" json:"rebalances,omitempty"`
+}
+
+func (m *MemberMetrics) Reset()                    { *m = MemberMetrics{} }
+func (*MemberMetrics) ProtoMessage()               {}
+func (*MemberMetrics) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{16} }
+
+func (m *MemberMetrics) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MemberMetrics) GetActivations() map[string]int64 {
+	if m != nil {
+		return m.Activations
+	}
+	return nil
+}
+
+func (m *MemberMetrics) GetRequestRates() map[string]float64 {
+	if m != nil {
+		return m.RequestRates
+	}
+	return nil
+}
+
+func (m *MemberMetrics) GetRebalances() int64 {
+	if m != nil {
+		return m.Rebalances
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*TakeOwnership)(nil), "cluster.TakeOwnership")
+	proto.RegisterType((*TakeOwnershipResponse)(nil), "cluster.TakeOwnershipResponse")
 	proto.RegisterType((*GrainRequest)(nil), "cluster.GrainRequest")
 	proto.RegisterType((*GrainResponse)(nil), "cluster.GrainResponse")
 	proto.RegisterType((*GrainErrorResponse)(nil), "cluster.GrainErrorResponse")
+	proto.RegisterType((*ClusterIdentity)(nil), "cluster.ClusterIdentity")
+	proto.RegisterType((*SubscriberIdentity)(nil), "cluster.SubscriberIdentity")
+	proto.RegisterType((*SubscribeRequest)(nil), "cluster.SubscribeRequest")
+	proto.RegisterType((*SubscribeResponse)(nil), "cluster.SubscribeResponse")
+	proto.RegisterType((*UnsubscribeRequest)(nil), "cluster.UnsubscribeRequest")
+	proto.RegisterType((*UnsubscribeResponse)(nil), "cluster.UnsubscribeResponse")
+	proto.RegisterType((*PublishRequest)(nil), "cluster.PublishRequest")
+	proto.RegisterType((*PublishResponse)(nil), "cluster.PublishResponse")
+	proto.RegisterType((*ReminderFired)(nil), "cluster.ReminderFired")
+	proto.RegisterType((*BroadcastRequest)(nil), "cluster.BroadcastRequest")
+	proto.RegisterType((*MemberMetricsRequest)(nil), "cluster.MemberMetricsRequest")
+	proto.RegisterType((*MemberMetrics)(nil), "cluster.MemberMetrics")
 }
 func (this *TakeOwnership) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	}
 
 	that1, ok := that.(*TakeOwnership)
@@ -139,10 +416,7 @@ func (this *TakeOwnership) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
@@ -154,13 +428,31 @@ func (this *TakeOwnership) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *GrainRequest) Equal(that interface{}) bool {
+func (this *TakeOwnershipResponse) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
+		return this == nil
+	}
+
+	that1, ok := that.(*TakeOwnershipResponse)
+	if !ok {
+		that2, ok := that.(TakeOwnershipResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
+	return true
+}
+func (this *GrainRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
 	that1, ok := that.(*GrainRequest)
 	if !ok {
@@ -172,10 +464,7 @@ func (this *GrainRequest) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
@@ -185,14 +474,19 @@ func (this *GrainRequest) Equal(that interface{}) bool {
 	if !bytes.Equal(this.MessageData, that1.MessageData) {
 		return false
 	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *GrainResponse) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	}
 
 	that1, ok := that.(*GrainResponse)
@@ -205,10 +499,7 @@ func (this *GrainResponse) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
@@ -219,10 +510,7 @@ func (this *GrainResponse) Equal(that interface{}) bool {
 }
 func (this *GrainErrorResponse) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	}
 
 	that1, ok := that.(*GrainErrorResponse)
@@ -235,10 +523,7 @@ func (this *GrainErrorResponse) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
@@ -247,70 +532,323 @@ func (this *GrainErrorResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (m *TakeOwnership) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *ClusterIdentity) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *TakeOwnership) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Pid != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintProtos(dAtA, i, uint64(m.Pid.Size()))
-		n1, err := m.Pid.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*ClusterIdentity)
+	if !ok {
+		that2, ok := that.(ClusterIdentity)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i += n1
 	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintProtos(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *GrainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Id != that1.Id {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.Kind != that1.Kind {
+		return false
+	}
+	return true
 }
+func (this *SubscriberIdentity) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GrainRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.MethodIndex != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintProtos(dAtA, i, uint64(m.MethodIndex))
+	that1, ok := that.(*SubscriberIdentity)
+	if !ok {
+		that2, ok := that.(SubscriberIdentity)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.MessageData) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintProtos(dAtA, i, uint64(len(m.MessageData)))
-		i += copy(dAtA[i:], m.MessageData)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
+	if !this.Pid.Equal(that1.Pid) {
+		return false
+	}
+	if !this.ClusterIdentity.Equal(that1.ClusterIdentity) {
+		return false
+	}
+	return true
 }
-
-func (m *GrainResponse) Marshal() (dAtA []byte, err error) {
+func (this *SubscribeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubscribeRequest)
+	if !ok {
+		that2, ok := that.(SubscribeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Subscriber.Equal(that1.Subscriber) {
+		return false
+	}
+	return true
+}
+func (this *SubscribeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SubscribeResponse)
+	if !ok {
+		that2, ok := that.(SubscribeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *UnsubscribeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnsubscribeRequest)
+	if !ok {
+		that2, ok := that.(UnsubscribeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Subscriber.Equal(that1.Subscriber) {
+		return false
+	}
+	return true
+}
+func (this *UnsubscribeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnsubscribeResponse)
+	if !ok {
+		that2, ok := that.(UnsubscribeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *PublishRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PublishRequest)
+	if !ok {
+		that2, ok := that.(PublishRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.MessageData, that1.MessageData) {
+		return false
+	}
+	if this.TypeName != that1.TypeName {
+		return false
+	}
+	if this.SerializerId != that1.SerializerId {
+		return false
+	}
+	return true
+}
+func (this *PublishResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PublishResponse)
+	if !ok {
+		that2, ok := that.(PublishResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Failed != that1.Failed {
+		return false
+	}
+	return true
+}
+func (this *ReminderFired) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReminderFired)
+	if !ok {
+		that2, ok := that.(ReminderFired)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	return true
+}
+func (this *BroadcastRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BroadcastRequest)
+	if !ok {
+		that2, ok := that.(BroadcastRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.MessageData, that1.MessageData) {
+		return false
+	}
+	if this.TypeName != that1.TypeName {
+		return false
+	}
+	if this.SerializerId != that1.SerializerId {
+		return false
+	}
+	return true
+}
+func (this *MemberMetricsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MemberMetricsRequest)
+	if !ok {
+		that2, ok := that.(MemberMetricsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MemberMetrics) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MemberMetrics)
+	if !ok {
+		that2, ok := that.(MemberMetrics)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if len(this.Activations) != len(that1.Activations) {
+		return false
+	}
+	for i := range this.Activations {
+		if this.Activations[i] != that1.Activations[i] {
+			return false
+		}
+	}
+	if len(this.RequestRates) != len(that1.RequestRates) {
+		return false
+	}
+	for i := range this.RequestRates {
+		if this.RequestRates[i] != that1.RequestRates[i] {
+			return false
+		}
+	}
+	if this.Rebalances != that1.Rebalances {
+		return false
+	}
+	return true
+}
+func (m *TakeOwnership) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -320,21 +858,31 @@ func (m *GrainResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GrainResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TakeOwnership) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.MessageData) > 0 {
+	if m.Pid != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintProtos(dAtA, i, uint64(len(m.MessageData)))
-		i += copy(dAtA[i:], m.MessageData)
+		i = encodeVarintProtos(dAtA, i, uint64(m.Pid.Size()))
+		n1, err := m.Pid.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
 	return i, nil
 }
 
-func (m *GrainErrorResponse) Marshal() (dAtA []byte, err error) {
+func (m *TakeOwnershipResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -344,158 +892,2141 @@ func (m *GrainErrorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GrainErrorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TakeOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Err) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintProtos(dAtA, i, uint64(len(m.Err)))
-		i += copy(dAtA[i:], m.Err)
-	}
 	return i, nil
 }
 
-func encodeFixed64Protos(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Protos(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintProtos(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GrainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *TakeOwnership) Size() (n int) {
+
+func (m *GrainRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Pid != nil {
-		l = m.Pid.Size()
-		n += 1 + l + sovProtos(uint64(l))
+	if m.MethodIndex != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(m.MethodIndex))
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovProtos(uint64(l))
+	if len(m.MessageData) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(len(m.MessageData)))
+		i += copy(dAtA[i:], m.MessageData)
 	}
-	return n
+	if len(m.Headers) > 0 {
+		for k, _ := range m.Headers {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Headers[k]
+			mapSize := 1 + len(k) + sovProtos(uint64(len(k))) + 1 + len(v) + sovProtos(uint64(len(v)))
+			i = encodeVarintProtos(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintProtos(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintProtos(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
 }
 
-func (m *GrainRequest) Size() (n int) {
+func (m *GrainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrainResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.MethodIndex != 0 {
-		n += 1 + sovProtos(uint64(m.MethodIndex))
+	if len(m.MessageData) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(len(m.MessageData)))
+		i += copy(dAtA[i:], m.MessageData)
 	}
-	l = len(m.MessageData)
-	if l > 0 {
-		n += 1 + l + sovProtos(uint64(l))
+	return i, nil
+}
+
+func (m *GrainErrorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GrainResponse) Size() (n int) {
+func (m *GrainErrorResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MessageData)
-	if l > 0 {
-		n += 1 + l + sovProtos(uint64(l))
+	if len(m.Err) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(len(m.Err)))
+		i += copy(dAtA[i:], m.Err)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GrainErrorResponse) Size() (n int) {
+func (m *ClusterIdentity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterIdentity) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Err)
-	if l > 0 {
-		n += 1 + l + sovProtos(uint64(l))
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
 	}
-	return n
+	if len(m.Kind) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(len(m.Kind)))
+		i += copy(dAtA[i:], m.Kind)
+	}
+	return i, nil
 }
 
-func sovProtos(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *SubscriberIdentity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriberIdentity) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pid != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(m.Pid.Size()))
+		n2, err := m.Pid.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n2
 	}
-	return n
+	if m.ClusterIdentity != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(m.ClusterIdentity.Size()))
+		n3, err := m.ClusterIdentity.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
 }
-func sozProtos(x uint64) (n int) {
-	return sovProtos(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func (this *TakeOwnership) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Subscriber != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(m.Subscriber.Size()))
+		n4, err := m.Subscriber.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
 	}
-	s := strings.Join([]string{`&TakeOwnership{`,
-		`Pid:` + strings.Replace(fmt.Sprintf("%v", this.Pid), "PID", "actor.PID", 1) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
+	return i, nil
 }
-func (this *GrainRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SubscribeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&GrainRequest{`,
-		`MethodIndex:` + fmt.Sprintf("%v", this.MethodIndex) + `,`,
-		`MessageData:` + fmt.Sprintf("%v", this.MessageData) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *GrainResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SubscribeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *UnsubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&GrainResponse{`,
-		`MessageData:` + fmt.Sprintf("%v", this.MessageData) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *GrainErrorResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *UnsubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Subscriber != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(m.Subscriber.Size()))
+		n5, err := m.Subscriber.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+
+func (m *UnsubscribeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsubscribeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *PublishRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PublishRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageData) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(len(m.MessageData)))
+		i += copy(dAtA[i:], m.MessageData)
+	}
+	if len(m.TypeName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(len(m.TypeName)))
+		i += copy(dAtA[i:], m.TypeName)
+	}
+	if m.SerializerId != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(m.SerializerId))
+	}
+	return i, nil
+}
+
+func (m *PublishResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PublishResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Failed != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(m.Failed))
+	}
+	return i, nil
+}
+
+func (m *ReminderFired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReminderFired) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	return i, nil
+}
+
+func (m *BroadcastRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BroadcastRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageData) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(len(m.MessageData)))
+		i += copy(dAtA[i:], m.MessageData)
+	}
+	if len(m.TypeName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(len(m.TypeName)))
+		i += copy(dAtA[i:], m.TypeName)
+	}
+	if m.SerializerId != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(m.SerializerId))
+	}
+	return i, nil
+}
+
+func (m *MemberMetricsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberMetricsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *MemberMetrics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberMetrics) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if len(m.Activations) > 0 {
+		for k, _ := range m.Activations {
+			dAtA[i] = 0x12
+			i++
+			v := m.Activations[k]
+			mapSize := 1 + len(k) + sovProtos(uint64(len(k))) + 1 + sovProtos(uint64(v))
+			i = encodeVarintProtos(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintProtos(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintProtos(dAtA, i, uint64(v))
+		}
+	}
+	if len(m.RequestRates) > 0 {
+		for k, _ := range m.RequestRates {
+			dAtA[i] = 0x1a
+			i++
+			v := m.RequestRates[k]
+			mapSize := 1 + len(k) + sovProtos(uint64(len(k))) + 1 + 8
+			i = encodeVarintProtos(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintProtos(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x11
+			i++
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i += 8
+		}
+	}
+	if m.Rebalances != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(m.Rebalances))
+	}
+	return i, nil
+}
+
+func encodeVarintProtos(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *TakeOwnership) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pid != nil {
+		l = m.Pid.Size()
+		n += 1 + l + sovProtos(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProtos(uint64(l))
+	}
+	return n
+}
+
+func (m *TakeOwnershipResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GrainRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.MethodIndex != 0 {
+		n += 1 + sovProtos(uint64(m.MethodIndex))
+	}
+	l = len(m.MessageData)
+	if l > 0 {
+		n += 1 + l + sovProtos(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovProtos(uint64(len(k))) + 1 + len(v) + sovProtos(uint64(len(v)))
+			n += mapEntrySize + 1 + sovProtos(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *GrainResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.MessageData)
+	if l > 0 {
+		n += 1 + l + sovProtos(uint64(l))
+	}
+	return n
+}
+
+func (m *GrainErrorResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Err)
+	if l > 0 {
+		n += 1 + l + sovProtos(uint64(l))
+	}
+	return n
+}
+
+func (m *ClusterIdentity) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovProtos(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovProtos(uint64(l))
+	}
+	return n
+}
+
+func (m *SubscriberIdentity) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pid != nil {
+		l = m.Pid.Size()
+		n += 1 + l + sovProtos(uint64(l))
+	}
+	if m.ClusterIdentity != nil {
+		l = m.ClusterIdentity.Size()
+		n += 1 + l + sovProtos(uint64(l))
+	}
+	return n
+}
+
+func (m *SubscribeRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Subscriber != nil {
+		l = m.Subscriber.Size()
+		n += 1 + l + sovProtos(uint64(l))
+	}
+	return n
+}
+
+func (m *SubscribeResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnsubscribeRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Subscriber != nil {
+		l = m.Subscriber.Size()
+		n += 1 + l + sovProtos(uint64(l))
+	}
+	return n
+}
+
+func (m *UnsubscribeResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PublishRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.MessageData)
+	if l > 0 {
+		n += 1 + l + sovProtos(uint64(l))
+	}
+	l = len(m.TypeName)
+	if l > 0 {
+		n += 1 + l + sovProtos(uint64(l))
+	}
+	if m.SerializerId != 0 {
+		n += 1 + sovProtos(uint64(m.SerializerId))
+	}
+	return n
+}
+
+func (m *PublishResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Failed != 0 {
+		n += 1 + sovProtos(uint64(m.Failed))
+	}
+	return n
+}
+
+func (m *ReminderFired) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProtos(uint64(l))
+	}
+	return n
+}
+
+func (m *BroadcastRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.MessageData)
+	if l > 0 {
+		n += 1 + l + sovProtos(uint64(l))
+	}
+	l = len(m.TypeName)
+	if l > 0 {
+		n += 1 + l + sovProtos(uint64(l))
+	}
+	if m.SerializerId != 0 {
+		n += 1 + sovProtos(uint64(m.SerializerId))
+	}
+	return n
+}
+
+func (m *MemberMetricsRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MemberMetrics) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProtos(uint64(l))
+	}
+	if len(m.Activations) > 0 {
+		for k, v := range m.Activations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovProtos(uint64(len(k))) + 1 + sovProtos(uint64(v))
+			n += mapEntrySize + 1 + sovProtos(uint64(mapEntrySize))
+		}
+	}
+	if len(m.RequestRates) > 0 {
+		for k, v := range m.RequestRates {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovProtos(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovProtos(uint64(mapEntrySize))
+		}
+	}
+	if m.Rebalances != 0 {
+		n += 1 + sovProtos(uint64(m.Rebalances))
+	}
+	return n
+}
+
+func sovProtos(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozProtos(x uint64) (n int) {
+	return sovProtos(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *TakeOwnership) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TakeOwnership{`,
+		`Pid:` + strings.Replace(fmt.Sprintf("%v", this.Pid), "PID", "actor.PID", 1) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TakeOwnershipResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TakeOwnershipResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GrainRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k, _ := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{`&GrainRequest{`,
+		`MethodIndex:` + fmt.Sprintf("%v", this.MethodIndex) + `,`,
+		`MessageData:` + fmt.Sprintf("%v", this.MessageData) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GrainResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GrainResponse{`,
+		`MessageData:` + fmt.Sprintf("%v", this.MessageData) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GrainErrorResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GrainErrorResponse{`,
+		`Err:` + fmt.Sprintf("%v", this.Err) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterIdentity) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterIdentity{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SubscriberIdentity) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SubscriberIdentity{`,
+		`Pid:` + strings.Replace(fmt.Sprintf("%v", this.Pid), "PID", "actor.PID", 1) + `,`,
+		`ClusterIdentity:` + strings.Replace(fmt.Sprintf("%v", this.ClusterIdentity), "ClusterIdentity", "ClusterIdentity", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SubscribeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SubscribeRequest{`,
+		`Subscriber:` + strings.Replace(fmt.Sprintf("%v", this.Subscriber), "SubscriberIdentity", "SubscriberIdentity", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SubscribeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SubscribeResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnsubscribeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnsubscribeRequest{`,
+		`Subscriber:` + strings.Replace(fmt.Sprintf("%v", this.Subscriber), "SubscriberIdentity", "SubscriberIdentity", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnsubscribeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnsubscribeResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PublishRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PublishRequest{`,
+		`MessageData:` + fmt.Sprintf("%v", this.MessageData) + `,`,
+		`TypeName:` + fmt.Sprintf("%v", this.TypeName) + `,`,
+		`SerializerId:` + fmt.Sprintf("%v", this.SerializerId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PublishResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PublishResponse{`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReminderFired) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReminderFired{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BroadcastRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BroadcastRequest{`,
+		`MessageData:` + fmt.Sprintf("%v", this.MessageData) + `,`,
+		`TypeName:` + fmt.Sprintf("%v", this.TypeName) + `,`,
+		`SerializerId:` + fmt.Sprintf("%v", this.SerializerId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MemberMetricsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MemberMetricsRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MemberMetrics) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForActivations := make([]string, 0, len(this.Activations))
+	for k, _ := range this.Activations {
+		keysForActivations = append(keysForActivations, k)
+	}
+	sortkeys.Strings(keysForActivations)
+	mapStringForActivations := "map[string]int64{"
+	for _, k := range keysForActivations {
+		mapStringForActivations += fmt.Sprintf("%v: %v,", k, this.Activations[k])
+	}
+	mapStringForActivations += "}"
+	keysForRequestRates := make([]string, 0, len(this.RequestRates))
+	for k, _ := range this.RequestRates {
+		keysForRequestRates = append(keysForRequestRates, k)
+	}
+	sortkeys.Strings(keysForRequestRates)
+	mapStringForRequestRates := "map[string]float64{"
+	for _, k := range keysForRequestRates {
+		mapStringForRequestRates += fmt.Sprintf("%v: %v,", k, this.RequestRates[k])
+	}
+	mapStringForRequestRates += "}"
+	s := strings.Join([]string{`&MemberMetrics{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Activations:` + mapStringForActivations + `,`,
+		`RequestRates:` + mapStringForRequestRates + `,`,
+		`Rebalances:` + fmt.Sprintf("%v", this.Rebalances) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringProtos(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *TakeOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakeOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakeOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pid == nil {
+				m.Pid = &actor.PID{}
+			}
+			if err := m.Pid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProtos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakeOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakeOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakeOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProtos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MethodIndex", wireType)
+			}
+			m.MethodIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MethodIndex |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProtos
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageData = append(m.MessageData[:0], dAtA[iNdEx:postIndex]...)
+			if m.MessageData == nil {
+				m.MessageData = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProtos
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProtos
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthProtos
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProtos
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthProtos
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipProtos(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthProtos
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProtos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProtos
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageData = append(m.MessageData[:0], dAtA[iNdEx:postIndex]...)
+			if m.MessageData == nil {
+				m.MessageData = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProtos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrainErrorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrainErrorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrainErrorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Err = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProtos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterIdentity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterIdentity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProtos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscriberIdentity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriberIdentity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriberIdentity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pid == nil {
+				m.Pid = &actor.PID{}
+			}
+			if err := m.Pid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterIdentity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClusterIdentity == nil {
+				m.ClusterIdentity = &ClusterIdentity{}
+			}
+			if err := m.ClusterIdentity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProtos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriber", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Subscriber == nil {
+				m.Subscriber = &SubscriberIdentity{}
+			}
+			if err := m.Subscriber.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProtos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProtos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriber", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Subscriber == nil {
+				m.Subscriber = &SubscriberIdentity{}
+			}
+			if err := m.Subscriber.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProtos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsubscribeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsubscribeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsubscribeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProtos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PublishRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublishRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublishRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProtos
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageData = append(m.MessageData[:0], dAtA[iNdEx:postIndex]...)
+			if m.MessageData == nil {
+				m.MessageData = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SerializerId", wireType)
+			}
+			m.SerializerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SerializerId |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProtos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PublishResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublishResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublishResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProtos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&GrainErrorResponse{`,
-		`Err:` + fmt.Sprintf("%v", this.Err) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringProtos(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *TakeOwnership) Unmarshal(dAtA []byte) error {
+func (m *ReminderFired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -518,46 +3049,13 @@ func (m *TakeOwnership) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TakeOwnership: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReminderFired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TakeOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReminderFired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProtos
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthProtos
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pid == nil {
-				m.Pid = &actor.PID{}
-			}
-			if err := m.Pid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -607,7 +3105,7 @@ func (m *TakeOwnership) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GrainRequest) Unmarshal(dAtA []byte) error {
+func (m *BroadcastRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -630,17 +3128,17 @@ func (m *GrainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GrainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BroadcastRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GrainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BroadcastRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MethodIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageData", wireType)
 			}
-			m.MethodIndex = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtos
@@ -650,16 +3148,28 @@ func (m *GrainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MethodIndex |= (int32(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProtos
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageData = append(m.MessageData[:0], dAtA[iNdEx:postIndex]...)
+			if m.MessageData == nil {
+				m.MessageData = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProtos
@@ -669,23 +3179,40 @@ func (m *GrainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProtos
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MessageData = append(m.MessageData[:0], dAtA[iNdEx:postIndex]...)
-			if m.MessageData == nil {
-				m.MessageData = []byte{}
-			}
+			m.TypeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SerializerId", wireType)
+			}
+			m.SerializerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SerializerId |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtos(dAtA[iNdEx:])
@@ -707,7 +3234,7 @@ func (m *GrainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GrainResponse) Unmarshal(dAtA []byte) error {
+func (m *MemberMetricsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -730,43 +3257,12 @@ func (m *GrainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GrainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemberMetricsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GrainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemberMetricsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageData", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProtos
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProtos
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MessageData = append(m.MessageData[:0], dAtA[iNdEx:postIndex]...)
-			if m.MessageData == nil {
-				m.MessageData = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtos(dAtA[iNdEx:])
@@ -788,7 +3284,7 @@ func (m *GrainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GrainErrorResponse) Unmarshal(dAtA []byte) error {
+func (m *MemberMetrics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -811,15 +3307,15 @@ func (m *GrainErrorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GrainErrorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemberMetrics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GrainErrorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemberMetrics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -844,8 +3340,234 @@ func (m *GrainErrorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Err = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Activations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Activations == nil {
+				m.Activations = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProtos
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProtos
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthProtos
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProtos
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipProtos(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthProtos
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Activations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequestRates == nil {
+				m.RequestRates = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProtos
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProtos
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthProtos
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipProtos(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthProtos
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RequestRates[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebalances", wireType)
+			}
+			m.Rebalances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rebalances |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtos(dAtA[iNdEx:])
@@ -975,25 +3697,51 @@ var (
 func init() { proto.RegisterFile("protos.proto", fileDescriptorProtos) }
 
 var fileDescriptorProtos = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xcf, 0x4b, 0xc3, 0x30,
-	0x1c, 0xc5, 0x1b, 0xe7, 0x0f, 0x96, 0x75, 0x20, 0x39, 0x8d, 0x21, 0x61, 0xee, 0x20, 0x3b, 0xb8,
-	0x16, 0x26, 0x78, 0x9f, 0x4c, 0x64, 0x27, 0xa5, 0xec, 0x3e, 0xd2, 0x36, 0xb6, 0x65, 0x36, 0xa9,
-	0x49, 0x8a, 0x7a, 0xf3, 0x4f, 0xf0, 0xcf, 0xf0, 0x4f, 0xf1, 0xb8, 0xa3, 0x47, 0x1b, 0x2f, 0x1e,
-	0xf7, 0x27, 0x48, 0xbf, 0x15, 0x19, 0xec, 0x94, 0xf7, 0x5e, 0xde, 0xe7, 0x05, 0x82, 0xdd, 0x42,
-	0x49, 0x23, 0xb5, 0x07, 0x07, 0x39, 0x8a, 0x1e, 0x4a, 0x6d, 0xb8, 0xea, 0x8f, 0x93, 0xcc, 0xa4,
-	0x65, 0xe8, 0x45, 0x32, 0xf7, 0x13, 0x99, 0x48, 0x1f, 0xee, 0xc3, 0xf2, 0x1e, 0x1c, 0x18, 0x50,
-	0x0d, 0xd7, 0xbf, 0xdc, 0xaa, 0x4f, 0xf5, 0x8b, 0x58, 0x29, 0x29, 0xe6, 0x8b, 0x06, 0x62, 0x91,
-	0x91, 0x6a, 0x9c, 0x48, 0x1f, 0x84, 0xbf, 0xfd, 0xde, 0x70, 0x8a, 0xbb, 0x0b, 0xb6, 0xe2, 0xb7,
-	0x4f, 0x82, 0x2b, 0x9d, 0x66, 0x05, 0x39, 0xc1, 0xad, 0x22, 0x8b, 0x7b, 0x68, 0x80, 0x46, 0x9d,
-	0x09, 0xf6, 0x00, 0xf1, 0xee, 0xe6, 0xb3, 0xa0, 0x8e, 0x09, 0xc1, 0xfb, 0x82, 0xe5, 0xbc, 0xb7,
-	0x37, 0x40, 0xa3, 0x76, 0x00, 0x7a, 0xb8, 0xc0, 0xee, 0x8d, 0x62, 0x99, 0x08, 0xf8, 0x63, 0xc9,
-	0xb5, 0x21, 0xa7, 0xd8, 0xcd, 0xb9, 0x49, 0x65, 0xbc, 0xcc, 0x44, 0xcc, 0x9f, 0x61, 0xea, 0x20,
-	0xe8, 0x34, 0xd9, 0xbc, 0x8e, 0x9a, 0x8a, 0xd6, 0x2c, 0xe1, 0xcb, 0x98, 0x19, 0x06, 0x73, 0x6e,
-	0x5d, 0x81, 0x6c, 0xc6, 0x0c, 0x1b, 0x4e, 0x70, 0xf7, 0x6f, 0x55, 0x17, 0x52, 0x68, 0xbe, 0xc3,
-	0xa0, 0x5d, 0xe6, 0x0c, 0x13, 0x60, 0xae, 0x95, 0x92, 0xea, 0x1f, 0x3c, 0xc6, 0x2d, 0xae, 0x14,
-	0xf4, 0xdb, 0x41, 0x2d, 0xaf, 0xce, 0xd7, 0x15, 0x75, 0x3e, 0x2b, 0xea, 0x6c, 0x2a, 0xea, 0xbc,
-	0x5a, 0x8a, 0xde, 0x2d, 0x45, 0x1f, 0x96, 0xa2, 0xb5, 0xa5, 0xe8, 0xcb, 0x52, 0xf4, 0x63, 0xa9,
-	0xb3, 0xb1, 0x14, 0xbd, 0x7d, 0x53, 0x27, 0x3c, 0x84, 0x9f, 0xba, 0xf8, 0x0d, 0x00, 0x00, 0xff,
-	0xff, 0x95, 0x67, 0xe6, 0x64, 0xa9, 0x01, 0x00, 0x00,
+	// 736 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xce, 0xc4, 0xfd, 0xb9, 0x39, 0x49, 0xda, 0x74, 0xfa, 0x73, 0xad, 0xf4, 0xca, 0xca, 0x75,
+	0xa5, 0x7b, 0x73, 0xa5, 0x5b, 0x47, 0x0a, 0x02, 0xa1, 0x82, 0x40, 0xfd, 0x03, 0xb2, 0x28, 0x2d,
+	0xa6, 0xac, 0xa3, 0xb1, 0x3d, 0x4d, 0x46, 0x49, 0xec, 0x30, 0x33, 0x6e, 0x09, 0x2b, 0x1e, 0x81,
+	0xc7, 0xe0, 0x51, 0x58, 0xa1, 0x2e, 0x59, 0xd2, 0xc0, 0x82, 0x65, 0x1f, 0x01, 0x79, 0xec, 0xa4,
+	0x4e, 0x23, 0xda, 0x0d, 0x62, 0xe5, 0x73, 0x8e, 0xcf, 0xf9, 0xbe, 0x6f, 0xce, 0x99, 0x33, 0x50,
+	0xe8, 0xf3, 0x40, 0x06, 0xc2, 0x52, 0x1f, 0x3c, 0xef, 0x76, 0x43, 0x21, 0x29, 0x2f, 0x6f, 0xb6,
+	0x98, 0x6c, 0x87, 0x8e, 0xe5, 0x06, 0xbd, 0x5a, 0x2b, 0x68, 0x05, 0x35, 0xf5, 0xdf, 0x09, 0x4f,
+	0x94, 0xa7, 0x1c, 0x65, 0xc5, 0x75, 0xe5, 0x7b, 0xa9, 0xf4, 0x6d, 0x31, 0xf0, 0x3b, 0x3c, 0xf0,
+	0x1b, 0xc7, 0x71, 0x11, 0x71, 0x65, 0xc0, 0x37, 0x5b, 0x41, 0x4d, 0x19, 0xb5, 0x34, 0x9f, 0xb9,
+	0x0d, 0xc5, 0x63, 0xd2, 0xa1, 0x87, 0x67, 0x3e, 0xe5, 0xa2, 0xcd, 0xfa, 0xf8, 0x2f, 0xd0, 0xfa,
+	0xcc, 0xd3, 0x51, 0x05, 0x55, 0xf3, 0x75, 0xb0, 0x54, 0x89, 0x75, 0xd4, 0xd8, 0xb3, 0xa3, 0x30,
+	0xc6, 0x30, 0xe3, 0x93, 0x1e, 0xd5, 0xb3, 0x15, 0x54, 0xcd, 0xd9, 0xca, 0x36, 0xff, 0x84, 0xd5,
+	0x09, 0x08, 0x9b, 0x8a, 0x7e, 0xe0, 0x0b, 0x6a, 0x7e, 0x42, 0x50, 0x78, 0xca, 0x09, 0xf3, 0x6d,
+	0xfa, 0x3a, 0xa4, 0x42, 0xe2, 0xbf, 0xa1, 0xd0, 0xa3, 0xb2, 0x1d, 0x78, 0x4d, 0xe6, 0x7b, 0xf4,
+	0x8d, 0x22, 0x99, 0xb5, 0xf3, 0x71, 0xac, 0x11, 0x85, 0xe2, 0x14, 0x21, 0x48, 0x8b, 0x36, 0x3d,
+	0x22, 0x89, 0x22, 0x2a, 0x44, 0x29, 0x2a, 0xb6, 0x47, 0x24, 0xc1, 0x0f, 0x61, 0xbe, 0x4d, 0x89,
+	0x47, 0xb9, 0xd0, 0xb5, 0x8a, 0x56, 0xcd, 0xd7, 0x4d, 0x2b, 0x69, 0x9a, 0x95, 0x66, 0xb3, 0x9e,
+	0xc5, 0x49, 0xfb, 0xbe, 0xe4, 0x03, 0x7b, 0x54, 0x52, 0xde, 0x82, 0x42, 0xfa, 0x07, 0x2e, 0x81,
+	0xd6, 0xa1, 0x03, 0x25, 0x25, 0x67, 0x47, 0x26, 0x5e, 0x81, 0xd9, 0x53, 0xd2, 0x0d, 0x47, 0x87,
+	0x8c, 0x9d, 0xad, 0xec, 0x7d, 0x64, 0xd6, 0xa1, 0x98, 0x30, 0xc4, 0x27, 0x9c, 0x52, 0x8b, 0xa6,
+	0xd4, 0x9a, 0xff, 0x00, 0x56, 0x35, 0xfb, 0x9c, 0x07, 0x7c, 0x5c, 0x58, 0x02, 0x8d, 0x72, 0x3e,
+	0x62, 0xa5, 0x9c, 0x9b, 0x77, 0x61, 0x71, 0x37, 0x3e, 0x45, 0xc3, 0xa3, 0xbe, 0x64, 0x72, 0x80,
+	0x17, 0x20, 0x9b, 0x4c, 0x22, 0x67, 0x67, 0xe3, 0xe6, 0x77, 0x98, 0xef, 0x8d, 0x9a, 0x1f, 0xd9,
+	0xe6, 0x19, 0xe0, 0x97, 0xa1, 0x23, 0x5c, 0xce, 0x9c, 0x54, 0xe5, 0xcd, 0x43, 0xdc, 0x85, 0x52,
+	0xd2, 0xb0, 0x26, 0x4b, 0x2a, 0x14, 0x66, 0xbe, 0xae, 0x8f, 0x3b, 0x79, 0x4d, 0x8b, 0xbd, 0xe8,
+	0x4e, 0x06, 0xcc, 0x43, 0x28, 0x8d, 0x89, 0x47, 0xf3, 0x7d, 0x00, 0x20, 0xc6, 0x62, 0x12, 0xf6,
+	0xf5, 0x31, 0xe4, 0xb4, 0x4e, 0x3b, 0x95, 0x6e, 0x2e, 0xc3, 0x52, 0x0a, 0x30, 0xb9, 0x42, 0x2f,
+	0x00, 0xbf, 0xf2, 0xc5, 0x2f, 0xe5, 0x59, 0x85, 0xe5, 0x09, 0xc8, 0x84, 0x29, 0x84, 0x85, 0xa3,
+	0xd0, 0xe9, 0x32, 0xd1, 0x9e, 0xb8, 0xad, 0x37, 0x0e, 0x17, 0xaf, 0x43, 0x4e, 0x0e, 0xfa, 0xb4,
+	0x99, 0xda, 0x89, 0x3f, 0xa2, 0xc0, 0x73, 0xd2, 0xa3, 0x78, 0x03, 0x8a, 0x82, 0x72, 0x46, 0xba,
+	0xec, 0xad, 0xea, 0xb4, 0xae, 0xa9, 0xeb, 0x5e, 0xb8, 0x0a, 0x36, 0x3c, 0xf3, 0x3f, 0x58, 0x1c,
+	0xd3, 0x26, 0x77, 0x63, 0x0d, 0xe6, 0x4e, 0x08, 0xeb, 0x52, 0x2f, 0xd9, 0x8f, 0xc4, 0x33, 0x37,
+	0xa0, 0x68, 0xd3, 0x5e, 0xb4, 0x39, 0xfc, 0x09, 0xe3, 0xf4, 0x6a, 0x19, 0x51, 0x6a, 0x19, 0xcf,
+	0xa0, 0xb4, 0xc3, 0x03, 0xe2, 0xb9, 0x44, 0xc8, 0xdf, 0x7a, 0x90, 0x35, 0x58, 0x39, 0xa0, 0x3d,
+	0x87, 0xf2, 0x03, 0x2a, 0x39, 0x73, 0x45, 0x42, 0x6e, 0x7e, 0xcb, 0x42, 0x71, 0xe2, 0x07, 0xd6,
+	0x61, 0x9e, 0x78, 0x1e, 0xa7, 0x42, 0x24, 0xca, 0x47, 0x2e, 0x6e, 0x40, 0x9e, 0xb8, 0x92, 0x9d,
+	0x12, 0xc9, 0x02, 0x5f, 0xe8, 0x59, 0xb5, 0xdd, 0xff, 0x8e, 0x07, 0x3b, 0x01, 0x63, 0x6d, 0x5f,
+	0x65, 0xc6, 0x2b, 0x9e, 0xae, 0xc5, 0x07, 0x50, 0xe4, 0xb1, 0x82, 0x26, 0x27, 0x92, 0x8e, 0x9e,
+	0x8a, 0xea, 0x4f, 0xc0, 0x12, 0xb5, 0x76, 0x94, 0x1a, 0xa3, 0x15, 0x78, 0x2a, 0x84, 0x0d, 0x00,
+	0x4e, 0x1d, 0xd2, 0x25, 0xbe, 0x4b, 0x85, 0x3e, 0x53, 0x41, 0x55, 0xcd, 0x4e, 0x45, 0xca, 0x8f,
+	0xa0, 0x74, 0x5d, 0xcf, 0x6d, 0x2f, 0x8b, 0x96, 0x7a, 0x59, 0xca, 0x8f, 0x61, 0x69, 0x4a, 0xc2,
+	0x6d, 0x00, 0x28, 0x05, 0xb0, 0xf3, 0xff, 0xf9, 0x85, 0x91, 0xf9, 0x7c, 0x61, 0x64, 0x2e, 0x2f,
+	0x8c, 0xcc, 0xbb, 0xa1, 0x81, 0x3e, 0x0c, 0x0d, 0xf4, 0x71, 0x68, 0xa0, 0xf3, 0xa1, 0x81, 0xbe,
+	0x0c, 0x0d, 0xf4, 0x7d, 0x68, 0x64, 0x2e, 0x87, 0x06, 0x7a, 0xff, 0xd5, 0xc8, 0x38, 0x73, 0xea,
+	0xf1, 0xbf, 0xf3, 0x23, 0x00, 0x00, 0xff, 0xff, 0x99, 0x98, 0x2d, 0xe9, 0x7c, 0x06, 0x00, 0x00,
 }
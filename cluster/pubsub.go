@@ -0,0 +1,281 @@
+package cluster
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/log"
+	"github.com/AsynkronIT/protoactor-go/remote"
+)
+
+// TopicActorKind is the kind of the virtual actors holding the subscribers of the topics, registered on every member
+const TopicActorKind = "prototopic"
+
+// DeliveryGuarantee tells how the messages published to a topic are delivered to its subscribers
+type DeliveryGuarantee int
+
+const (
+	// AtMostOnce sends the messages to the subscribers without waiting for them to be received
+	AtMostOnce DeliveryGuarantee = iota
+	// AtLeastOnce resends the messages until the subscribers respond to them, subscribers must respond to every message.
+	// Messages may be received more than once and in a different order than they were published
+	AtLeastOnce
+)
+
+// atLeastOnceRetries is the number of times a message is resent to a subscriber which does not respond
+const atLeastOnceRetries = 3
+
+// TopicStore persists the subscribers of the topics, it is shared by all members so the subscribers are kept when a topic
+// is activated again, such as on another member after the member hosting it left
+type TopicStore interface {
+	GetSubscribers(topic string) ([]*SubscriberIdentity, error)
+	// AddSubscriber adds the subscriber, or replaces the one with the same PID or cluster identity
+	AddSubscriber(topic string, subscriber *SubscriberIdentity) error
+	RemoveSubscriber(topic string, subscriber *SubscriberIdentity) error
+}
+
+// ErrPublishFailed is returned by Publish when the message could not be delivered to all subscribers
+var ErrPublishFailed = errors.New("cluster: publish failed to deliver to all subscribers")
+
+// Subscribe subscribes the actor to the topic, it is unsubscribed when it stops
+func Subscribe(topic string, pid *actor.PID) error {
+	return requestTopic(topic, &SubscribeRequest{Subscriber: &SubscriberIdentity{Pid: pid}})
+}
+
+// SubscribeIdentity subscribes the grain to the topic, the grain is activated when a message is delivered
+func SubscribeIdentity(topic string, id string, kind string) error {
+	return requestTopic(topic, &SubscribeRequest{Subscriber: &SubscriberIdentity{ClusterIdentity: &ClusterIdentity{Id: id, Kind: kind}}})
+}
+
+// Unsubscribe unsubscribes the actor from the topic
+func Unsubscribe(topic string, pid *actor.PID) error {
+	return requestTopic(topic, &UnsubscribeRequest{Subscriber: &SubscriberIdentity{Pid: pid}})
+}
+
+// UnsubscribeIdentity unsubscribes the grain from the topic
+func UnsubscribeIdentity(topic string, id string, kind string) error {
+	return requestTopic(topic, &UnsubscribeRequest{Subscriber: &SubscriberIdentity{ClusterIdentity: &ClusterIdentity{Id: id, Kind: kind}}})
+}
+
+// Publish delivers the message to all subscribers of the topic, using the delivery guarantee configured for the topic.
+// It returns once the message has been sent to the subscribers, or received by them for AtLeastOnce topics
+func Publish(topic string, message interface{}) error {
+	serializerID := remote.SerializerIDFor(message)
+	data, typeName, err := remote.Serialize(message, serializerID)
+	if err != nil {
+		return err
+	}
+	return requestTopic(topic, &PublishRequest{MessageData: data, TypeName: typeName, SerializerId: serializerID})
+}
+
+// publishTimeout is the time to wait for the topic to respond to a published message, the AtLeastOnce topics
+// retry the delivery for up to atLeastOnceRetries times the timeout before responding
+func publishTimeout(topic string) time.Duration {
+	if cfg.TopicDeliveryGuarantees[topic] == AtLeastOnce {
+		return (atLeastOnceRetries + 1) * cfg.TimeoutTime
+	}
+	return cfg.TimeoutTime
+}
+
+func requestTopic(topic string, message interface{}) error {
+	pid, statusCode := Get(topic, TopicActorKind)
+	if statusCode != remote.ResponseStatusCodeOK && statusCode != remote.ResponseStatusCodePROCESSNAMEALREADYEXIST {
+		return fmt.Errorf("get topic PID failed with StatusCode: %v", statusCode)
+	}
+	timeout := cfg.TimeoutTime
+	if _, ok := message.(*PublishRequest); ok {
+		timeout = publishTimeout(topic)
+	}
+	res, err := rootContext.RequestFuture(pid, message, timeout).Result()
+	if err != nil {
+		return err
+	}
+	if res, ok := res.(*PublishResponse); ok && res.Failed > 0 {
+		return ErrPublishFailed
+	}
+	return nil
+}
+
+func newTopicActor() actor.Actor {
+	return &topicActor{
+		subscribers: make(map[string]*SubscriberIdentity),
+		getPid:      Get,
+	}
+}
+
+// topicActor holds the subscribers of a topic and fans out the messages published to it.
+// The subscribers are persisted in the TopicStore when configured, otherwise they are lost when the topic is activated again
+type topicActor struct {
+	topic       string
+	guarantee   DeliveryGuarantee
+	subscribers map[string]*SubscriberIdentity
+	getPid      func(name string, kind string) (*actor.PID, remote.ResponseStatusCode)
+}
+
+func subscriberKey(s *SubscriberIdentity) string {
+	if s.Pid != nil {
+		return s.Pid.String()
+	}
+	return s.ClusterIdentity.Kind + "/" + s.ClusterIdentity.Id
+}
+
+func (state *topicActor) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *actor.Started:
+		state.topic = remote.ActivatedName(ctx.Self().Id)
+		if cfg != nil {
+			state.guarantee = cfg.TopicDeliveryGuarantees[state.topic]
+		}
+		state.loadSubscribers(ctx)
+	case *SubscribeRequest:
+		state.subscribers[subscriberKey(msg.Subscriber)] = msg.Subscriber
+		if msg.Subscriber.Pid != nil {
+			ctx.Watch(msg.Subscriber.Pid)
+		}
+		if store := topicStore(); store != nil {
+			if err := store.AddSubscriber(state.topic, msg.Subscriber); err != nil {
+				plog.Error("Topic failed to persist subscriber", log.String("topic", state.topic), log.Error(err))
+			}
+		}
+		ctx.Respond(&SubscribeResponse{})
+	case *UnsubscribeRequest:
+		state.removeSubscriber(msg.Subscriber)
+		if msg.Subscriber.Pid != nil {
+			ctx.Unwatch(msg.Subscriber.Pid)
+		}
+		ctx.Respond(&UnsubscribeResponse{})
+	case *actor.Terminated:
+		state.removeSubscriber(&SubscriberIdentity{Pid: msg.Who})
+	case *PublishRequest:
+		message, err := remote.Deserialize(msg.MessageData, msg.TypeName, msg.SerializerId)
+		if err != nil {
+			plog.Error("Topic failed to deserialize published message", log.String("topic", state.topic), log.Error(err))
+			ctx.Respond(&PublishResponse{Failed: int32(len(state.subscribers))})
+			return
+		}
+		if state.guarantee == AtLeastOnce {
+			state.publishAtLeastOnce(ctx, message)
+		} else {
+			state.publishAtMostOnce(ctx, message)
+		}
+	}
+}
+
+func topicStore() TopicStore {
+	if cfg == nil {
+		return nil
+	}
+	return cfg.TopicStore
+}
+
+// loadSubscribers gets the subscribers persisted before the topic was activated again
+func (state *topicActor) loadSubscribers(ctx actor.Context) {
+	store := topicStore()
+	if store == nil {
+		return
+	}
+	subscribers, err := store.GetSubscribers(state.topic)
+	if err != nil {
+		plog.Error("Topic failed to get subscribers", log.String("topic", state.topic), log.Error(err))
+		return
+	}
+	for _, subscriber := range subscribers {
+		state.subscribers[subscriberKey(subscriber)] = subscriber
+		if subscriber.Pid != nil {
+			ctx.Watch(subscriber.Pid)
+		}
+	}
+}
+
+func (state *topicActor) removeSubscriber(subscriber *SubscriberIdentity) {
+	delete(state.subscribers, subscriberKey(subscriber))
+	if store := topicStore(); store != nil {
+		if err := store.RemoveSubscriber(state.topic, subscriber); err != nil {
+			plog.Error("Topic failed to remove subscriber", log.String("topic", state.topic), log.Error(err))
+		}
+	}
+}
+
+func (state *topicActor) publishAtMostOnce(ctx actor.Context, message interface{}) {
+	failed := int32(0)
+	for _, subscriber := range state.subscribers {
+		pid := state.resolve(subscriber)
+		if pid == nil {
+			failed++
+			continue
+		}
+		ctx.Send(pid, message)
+	}
+	ctx.Respond(&PublishResponse{Failed: failed})
+}
+
+// publishAtLeastOnce delivers the message outside of the actor, so a slow subscriber does not block the topic
+func (state *topicActor) publishAtLeastOnce(ctx actor.Context, message interface{}) {
+	subscribers := make([]*SubscriberIdentity, 0, len(state.subscribers))
+	for _, subscriber := range state.subscribers {
+		subscribers = append(subscribers, subscriber)
+	}
+	sender := ctx.Sender()
+
+	go func() {
+		var failed int32
+		var wg sync.WaitGroup
+		wg.Add(len(subscribers))
+		for _, subscriber := range subscribers {
+			go func(subscriber *SubscriberIdentity) {
+				defer wg.Done()
+				if !state.deliver(subscriber, message) {
+					atomic.AddInt32(&failed, 1)
+				}
+			}(subscriber)
+		}
+		wg.Wait()
+		if sender != nil {
+			rootContext.Send(sender, &PublishResponse{Failed: failed})
+		}
+	}()
+}
+
+// deliver gives up after atLeastOnceRetries attempts or times the timeout, so the topic responds before the publisher times out
+func (state *topicActor) deliver(subscriber *SubscriberIdentity, message interface{}) bool {
+	deadline := time.Now().Add(atLeastOnceRetries * cfg.TimeoutTime)
+	for i := 0; i < atLeastOnceRetries; i++ {
+		pid := state.resolve(subscriber)
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			break
+		}
+		if pid == nil {
+			continue
+		}
+		timeout := cfg.TimeoutTime
+		if remaining < timeout {
+			timeout = remaining
+		}
+		_, err := rootContext.RequestFuture(pid, message, timeout).Result()
+		if err == nil {
+			return true
+		}
+		if subscriber.ClusterIdentity != nil {
+			// the grain may have moved, get it again
+			RemoveCache(subscriber.ClusterIdentity.Id)
+		}
+	}
+	plog.Info("Topic failed to deliver message", log.String("topic", state.topic), log.String("subscriber", subscriberKey(subscriber)))
+	return false
+}
+
+func (state *topicActor) resolve(subscriber *SubscriberIdentity) *actor.PID {
+	if subscriber.Pid != nil {
+		return subscriber.Pid
+	}
+	pid, statusCode := state.getPid(subscriber.ClusterIdentity.Id, subscriber.ClusterIdentity.Kind)
+	if statusCode != remote.ResponseStatusCodeOK && statusCode != remote.ResponseStatusCodePROCESSNAMEALREADYEXIST {
+		return nil
+	}
+	return pid
+}
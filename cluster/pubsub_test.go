@@ -0,0 +1,181 @@
+package cluster
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	"github.com/stretchr/testify/assert"
+)
+
+func spawnTestTopic(t *testing.T, topic string, getPid func(string, string) (*actor.PID, remote.ResponseStatusCode)) *actor.PID {
+	props := actor.PropsFromProducer(func() actor.Actor {
+		a := newTopicActor().(*topicActor)
+		if getPid != nil {
+			a.getPid = getPid
+		}
+		return a
+	})
+	pid, err := rootContext.SpawnNamed(props, "Remote$"+topic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pid
+}
+
+func spawnSubscriber(received chan interface{}, respond bool) *actor.PID {
+	return rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(*ClusterIdentity); ok {
+			received <- msg
+			if respond {
+				ctx.Respond(msg)
+			}
+		}
+	}))
+}
+
+func publishRequest(t *testing.T, message interface{}) *PublishRequest {
+	data, typeName, err := remote.Serialize(message, remote.ProtoSerializerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &PublishRequest{MessageData: data, TypeName: typeName, SerializerId: remote.ProtoSerializerID}
+}
+
+func publish(t *testing.T, topic *actor.PID, message interface{}) *PublishResponse {
+	res, err := rootContext.RequestFuture(topic, publishRequest(t, message), publishTimeout(remote.ActivatedName(topic.Id))).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.(*PublishResponse)
+}
+
+func TestTopic_AtMostOnce(t *testing.T) {
+	cfg = NewClusterConfig("mycluster", "127.0.0.1:0", nil)
+	defer func() { cfg = nil }()
+
+	grain := make(chan interface{}, 10)
+	grainPid := spawnSubscriber(grain, false)
+	topic := spawnTestTopic(t, "at-most-once", func(id string, kind string) (*actor.PID, remote.ResponseStatusCode) {
+		assert.Equal(t, "grain1", id)
+		assert.Equal(t, "kind", kind)
+		return grainPid, remote.ResponseStatusCodeOK
+	})
+	defer rootContext.Stop(topic)
+
+	received := make(chan interface{}, 10)
+	subscriber := spawnSubscriber(received, false)
+	_, err := rootContext.RequestFuture(topic, &SubscribeRequest{Subscriber: &SubscriberIdentity{Pid: subscriber}}, time.Second).Result()
+	assert.NoError(t, err)
+	_, err = rootContext.RequestFuture(topic, &SubscribeRequest{Subscriber: &SubscriberIdentity{ClusterIdentity: &ClusterIdentity{Id: "grain1", Kind: "kind"}}}, time.Second).Result()
+	assert.NoError(t, err)
+
+	assert.Equal(t, int32(0), publish(t, topic, &ClusterIdentity{Id: "hello"}).Failed)
+	assert.Equal(t, &ClusterIdentity{Id: "hello"}, <-received)
+	assert.Equal(t, &ClusterIdentity{Id: "hello"}, <-grain)
+
+	// a stopped subscriber is unsubscribed
+	rootContext.StopFuture(subscriber).Wait()
+	_, err = rootContext.RequestFuture(topic, &UnsubscribeRequest{Subscriber: &SubscriberIdentity{ClusterIdentity: &ClusterIdentity{Id: "grain1", Kind: "kind"}}}, time.Second).Result()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), publish(t, topic, &ClusterIdentity{Id: "again"}).Failed)
+	assert.Len(t, received, 0)
+	assert.Len(t, grain, 0)
+}
+
+func TestTopic_AtLeastOnce(t *testing.T) {
+	cfg = NewClusterConfig("mycluster", "127.0.0.1:0", nil).
+		WithTimeout(50*time.Millisecond).
+		WithTopicDeliveryGuarantee("at-least-once", AtLeastOnce)
+	defer func() { cfg = nil }()
+
+	topic := spawnTestTopic(t, "at-least-once", nil)
+	defer rootContext.Stop(topic)
+
+	acked := make(chan interface{}, 10)
+	unacked := make(chan interface{}, 10)
+	for _, subscriber := range []*actor.PID{spawnSubscriber(acked, true), spawnSubscriber(unacked, false)} {
+		_, err := rootContext.RequestFuture(topic, &SubscribeRequest{Subscriber: &SubscriberIdentity{Pid: subscriber}}, time.Second).Result()
+		assert.NoError(t, err)
+	}
+
+	// the subscriber which does not respond receives the message once per attempt and counts as failed,
+	// the topic responds before the publisher times out
+	assert.Equal(t, int32(1), publish(t, topic, &ClusterIdentity{Id: "hello"}).Failed)
+	assert.Len(t, acked, 1)
+	assert.Len(t, unacked, atLeastOnceRetries)
+}
+
+type memoryTopicStore struct {
+	mu          sync.Mutex
+	subscribers map[string]map[string]*SubscriberIdentity
+}
+
+func newMemoryTopicStore() *memoryTopicStore {
+	return &memoryTopicStore{subscribers: make(map[string]map[string]*SubscriberIdentity)}
+}
+
+func (s *memoryTopicStore) GetSubscribers(topic string) ([]*SubscriberIdentity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := make([]*SubscriberIdentity, 0, len(s.subscribers[topic]))
+	for _, subscriber := range s.subscribers[topic] {
+		res = append(res, subscriber)
+	}
+	return res, nil
+}
+
+func (s *memoryTopicStore) AddSubscriber(topic string, subscriber *SubscriberIdentity) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.subscribers[topic] == nil {
+		s.subscribers[topic] = make(map[string]*SubscriberIdentity)
+	}
+	s.subscribers[topic][subscriberKey(subscriber)] = subscriber
+	return nil
+}
+
+func (s *memoryTopicStore) RemoveSubscriber(topic string, subscriber *SubscriberIdentity) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.subscribers[topic], subscriberKey(subscriber))
+	return nil
+}
+
+func TestTopic_KeepsSubscribersWhenActivatedAgain(t *testing.T) {
+	store := newMemoryTopicStore()
+	cfg = NewClusterConfig("mycluster", "127.0.0.1:0", nil).WithTopicStore(store)
+	defer func() { cfg = nil }()
+
+	grain := make(chan interface{}, 10)
+	grainPid := spawnSubscriber(grain, false)
+	getPid := func(id string, kind string) (*actor.PID, remote.ResponseStatusCode) {
+		return grainPid, remote.ResponseStatusCodeOK
+	}
+	topic := spawnTestTopic(t, "reactivated", getPid)
+
+	received := make(chan interface{}, 10)
+	subscriber := spawnSubscriber(received, false)
+	_, err := rootContext.RequestFuture(topic, &SubscribeRequest{Subscriber: &SubscriberIdentity{Pid: subscriber}}, time.Second).Result()
+	assert.NoError(t, err)
+	_, err = rootContext.RequestFuture(topic, &SubscribeRequest{Subscriber: &SubscriberIdentity{ClusterIdentity: &ClusterIdentity{Id: "grain1", Kind: "kind"}}}, time.Second).Result()
+	assert.NoError(t, err)
+
+	// the topic is activated again, e.g. after the member hosting it left
+	rootContext.StopFuture(topic).Wait()
+	topic = spawnTestTopic(t, "reactivated", getPid)
+	defer rootContext.Stop(topic)
+
+	assert.Equal(t, int32(0), publish(t, topic, &ClusterIdentity{Id: "hello"}).Failed)
+	assert.Equal(t, &ClusterIdentity{Id: "hello"}, <-received)
+	assert.Equal(t, &ClusterIdentity{Id: "hello"}, <-grain)
+
+	// the reactivated topic watches the subscribers again and removes the stopped ones from the store
+	rootContext.StopFuture(subscriber).Wait()
+	assert.Eventually(t, func() bool {
+		subscribers, _ := store.GetSubscribers("reactivated")
+		return len(subscribers) == 1
+	}, time.Second, 10*time.Millisecond)
+}
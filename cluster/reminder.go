@@ -0,0 +1,156 @@
+package cluster
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/log"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	"github.com/AsynkronIT/protoactor-go/scheduler"
+)
+
+// reminderTickInterval is the interval at which the due reminders are fired, which is the precision of the reminders
+const reminderTickInterval = 1 * time.Second
+
+// ErrNoReminderStore is returned when registering a reminder without a ReminderStore configured
+var ErrNoReminderStore = errors.New("cluster: no reminder store configured")
+
+// Reminder is a persistent timer of a grain, it fires even when the grain is not activated
+type Reminder struct {
+	Kind    string
+	GrainID string
+	Name    string
+	// DueTime is the time the reminder fires next
+	DueTime time.Time
+	// Period is the interval at which the reminder fires after its due time, it fires once when zero
+	Period time.Duration
+}
+
+// ReminderStore persists the reminders, it is shared by all members
+type ReminderStore interface {
+	// Save adds or replaces the reminder with the same kind, grain and name
+	Save(reminder *Reminder) error
+	Delete(kind string, grainID string, name string) error
+	// ListDue returns the reminders whose due time is not after now
+	ListDue(now time.Time) ([]*Reminder, error)
+	// CompareAndSet replaces the reminder by next, or deletes it when next is nil, only if the stored reminder still has
+	// the due time and the period of the given one. It returns false when the reminder was replaced or deleted meanwhile
+	CompareAndSet(reminder *Reminder, next *Reminder) (bool, error)
+}
+
+// Remindable is implemented by the grains using reminders, generated grains receive ReminderFired through it
+type Remindable interface {
+	ReceiveReminder(name string, ctx GrainContext)
+}
+
+// RegisterReminder registers a reminder sending ReminderFired to the grain after dueTime, then every period.
+// Registering a reminder with the name of an existing reminder of the grain replaces it
+func RegisterReminder(kind string, grainID string, name string, dueTime time.Duration, period time.Duration) error {
+	if cfg.ReminderStore == nil {
+		return ErrNoReminderStore
+	}
+	return cfg.ReminderStore.Save(&Reminder{
+		Kind:    kind,
+		GrainID: grainID,
+		Name:    name,
+		DueTime: time.Now().Add(dueTime),
+		Period:  period,
+	})
+}
+
+// UnregisterReminder removes the reminder of the grain
+func UnregisterReminder(kind string, grainID string, name string) error {
+	if cfg.ReminderStore == nil {
+		return ErrNoReminderStore
+	}
+	return cfg.ReminderStore.Delete(kind, grainID, name)
+}
+
+var reminders *actor.PID
+
+func setupReminders(store ReminderStore) {
+	if store == nil {
+		return
+	}
+	props := actor.PropsFromProducer(func() actor.Actor {
+		return &reminderActor{
+			store: store,
+			owns: func(r *Reminder) bool {
+				return memberList.getPartitionMember(r.GrainID, r.Kind) == actor.ProcessRegistry.Address
+			},
+			deliver: func(r *Reminder) error {
+				pid, statusCode := Get(r.GrainID, r.Kind)
+				if statusCode != remote.ResponseStatusCodeOK && statusCode != remote.ResponseStatusCodePROCESSNAMEALREADYEXIST {
+					return fmt.Errorf("cluster: failed to activate grain, status code %v", statusCode)
+				}
+				rootContext.Send(pid, &ReminderFired{Name: r.Name})
+				return nil
+			},
+		}
+	}).WithGuardian(actor.RestartingSupervisorStrategy())
+	reminders, _ = rootContext.SpawnNamed(props, "reminders")
+}
+
+func stopReminders() {
+	if reminders != nil {
+		rootContext.StopFuture(reminders).Wait()
+		reminders = nil
+	}
+}
+
+type reminderTick struct{}
+
+// reminderActor fires the due reminders of the grains whose identities are owned by this member,
+// so every reminder is fired by a single member and by another one when its member leaves
+type reminderActor struct {
+	store      ReminderStore
+	owns       func(*Reminder) bool
+	deliver    func(*Reminder) error
+	cancelTick scheduler.CancelFunc
+}
+
+func (state *reminderActor) Receive(ctx actor.Context) {
+	switch ctx.Message().(type) {
+	case *actor.Started:
+		s := scheduler.NewTimerScheduler(scheduler.WithContext(ctx))
+		state.cancelTick = s.SendRepeatedly(reminderTickInterval, reminderTickInterval, ctx.Self(), &reminderTick{})
+	case *actor.Stopping:
+		state.cancelTick()
+	case *reminderTick:
+		state.fireDueReminders(time.Now())
+	}
+}
+
+func (state *reminderActor) fireDueReminders(now time.Time) {
+	list, err := state.store.ListDue(now)
+	if err != nil {
+		plog.Error("Failed to list reminders", log.Error(err))
+		return
+	}
+	for _, r := range list {
+		if !state.owns(r) {
+			continue
+		}
+		if err := state.deliver(r); err != nil {
+			// fired again on the next tick
+			plog.Info("Failed to fire reminder", log.String("kind", r.Kind), log.String("id", r.GrainID), log.String("name", r.Name), log.Error(err))
+			continue
+		}
+
+		// the reminder is not updated when it was registered again or unregistered while firing
+		var next *Reminder
+		if r.Period > 0 {
+			next = &Reminder{}
+			*next = *r
+			// skip the periods missed while the reminder could not fire
+			for !next.DueTime.After(now) {
+				next.DueTime = next.DueTime.Add(next.Period)
+			}
+		}
+		if _, err := state.store.CompareAndSet(r, next); err != nil {
+			plog.Error("Failed to update reminder", log.String("kind", r.Kind), log.String("id", r.GrainID), log.String("name", r.Name), log.Error(err))
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package cluster
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type inMemoryReminderStore struct {
+	mu        sync.Mutex
+	reminders map[string]*Reminder
+}
+
+func newInMemoryReminderStore() *inMemoryReminderStore {
+	return &inMemoryReminderStore{reminders: make(map[string]*Reminder)}
+}
+
+func (s *inMemoryReminderStore) Save(reminder *Reminder) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := *reminder
+	s.reminders[r.Kind+"/"+r.GrainID+"/"+r.Name] = &r
+	return nil
+}
+
+func (s *inMemoryReminderStore) Delete(kind string, grainID string, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.reminders, kind+"/"+grainID+"/"+name)
+	return nil
+}
+
+func (s *inMemoryReminderStore) ListDue(now time.Time) ([]*Reminder, error) {
+	list, _ := s.List()
+	res := make([]*Reminder, 0, len(list))
+	for _, r := range list {
+		if !r.DueTime.After(now) {
+			res = append(res, r)
+		}
+	}
+	return res, nil
+}
+
+func (s *inMemoryReminderStore) CompareAndSet(reminder *Reminder, next *Reminder) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := reminder.Kind + "/" + reminder.GrainID + "/" + reminder.Name
+	stored, ok := s.reminders[key]
+	if !ok || !stored.DueTime.Equal(reminder.DueTime) || stored.Period != reminder.Period {
+		return false, nil
+	}
+	if next == nil {
+		delete(s.reminders, key)
+	} else {
+		r := *next
+		s.reminders[key] = &r
+	}
+	return true, nil
+}
+
+func (s *inMemoryReminderStore) List() ([]*Reminder, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := make([]*Reminder, 0, len(s.reminders))
+	for _, r := range s.reminders {
+		copied := *r
+		res = append(res, &copied)
+	}
+	return res, nil
+}
+
+func TestReminderActor_FireDueReminders(t *testing.T) {
+	store := newInMemoryReminderStore()
+	now := time.Now()
+	store.Save(&Reminder{Kind: "kind", GrainID: "once", Name: "r", DueTime: now.Add(-time.Second)})
+	store.Save(&Reminder{Kind: "kind", GrainID: "periodic", Name: "r", DueTime: now.Add(-5 * time.Second), Period: 2 * time.Second})
+	store.Save(&Reminder{Kind: "kind", GrainID: "later", Name: "r", DueTime: now.Add(time.Minute)})
+	store.Save(&Reminder{Kind: "kind", GrainID: "other", Name: "r", DueTime: now.Add(-time.Second)})
+
+	var fired []string
+	state := &reminderActor{
+		store: store,
+		owns:  func(r *Reminder) bool { return r.GrainID != "other" },
+		deliver: func(r *Reminder) error {
+			fired = append(fired, r.GrainID)
+			return nil
+		},
+	}
+	state.fireDueReminders(now)
+
+	assert.ElementsMatch(t, []string{"once", "periodic"}, fired)
+	list, _ := store.List()
+	assert.Len(t, list, 3)
+	for _, r := range list {
+		if r.GrainID == "periodic" {
+			// the missed periods are skipped
+			assert.Equal(t, now.Add(time.Second), r.DueTime)
+		}
+	}
+}
+
+func TestReminderActor_RetriesFailedDelivery(t *testing.T) {
+	store := newInMemoryReminderStore()
+	now := time.Now()
+	store.Save(&Reminder{Kind: "kind", GrainID: "grain", Name: "r", DueTime: now.Add(-time.Second)})
+
+	failing := true
+	fired := 0
+	state := &reminderActor{
+		store: store,
+		owns:  func(r *Reminder) bool { return true },
+		deliver: func(r *Reminder) error {
+			if failing {
+				return errors.New("unavailable")
+			}
+			fired++
+			return nil
+		},
+	}
+	state.fireDueReminders(now)
+	list, _ := store.List()
+	assert.Len(t, list, 1)
+
+	failing = false
+	state.fireDueReminders(now)
+	assert.Equal(t, 1, fired)
+	list, _ = store.List()
+	assert.Empty(t, list)
+}
+
+func TestRegisterReminder(t *testing.T) {
+	cfg = NewClusterConfig("mycluster", "127.0.0.1:0", nil)
+	assert.Equal(t, ErrNoReminderStore, RegisterReminder("kind", "grain", "r", time.Second, 0))
+
+	store := newInMemoryReminderStore()
+	cfg.WithReminderStore(store)
+	assert.NoError(t, RegisterReminder("kind", "grain", "r", time.Second, time.Minute))
+	list, _ := store.List()
+	if assert.Len(t, list, 1) {
+		assert.Equal(t, time.Minute, list[0].Period)
+	}
+	assert.NoError(t, UnregisterReminder("kind", "grain", "r"))
+	list, _ = store.List()
+	assert.Empty(t, list)
+}
+
+func TestReminderActor_KeepsReminderRegisteredWhileFiring(t *testing.T) {
+	store := newInMemoryReminderStore()
+	now := time.Now()
+	store.Save(&Reminder{Kind: "kind", GrainID: "grain", Name: "r", DueTime: now.Add(-time.Second), Period: time.Minute})
+
+	registered := &Reminder{Kind: "kind", GrainID: "grain", Name: "r", DueTime: now.Add(time.Hour), Period: time.Hour}
+	state := &reminderActor{
+		store: store,
+		owns:  func(r *Reminder) bool { return true },
+		deliver: func(r *Reminder) error {
+			// the grain registers the reminder again when it fires
+			return store.Save(registered)
+		},
+	}
+	state.fireDueReminders(now)
+
+	list, _ := store.List()
+	if assert.Len(t, list, 1) {
+		assert.Equal(t, registered, list[0])
+	}
+}
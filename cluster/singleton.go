@@ -0,0 +1,143 @@
+package cluster
+
+import (
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+	"github.com/AsynkronIT/protoactor-go/log"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	"github.com/AsynkronIT/protoactor-go/scheduler"
+)
+
+// singletonRetryInterval is the interval at which the activation of unavailable singletons is retried
+const singletonRetryInterval = 1 * time.Second
+
+var singletons *singletonValue
+
+type singletonValue struct {
+	manager         *actor.PID
+	memberStatusSub *eventstream.Subscription
+}
+
+// Singletons are grains with the name of their kind, so the partition owning that name ensures there is exactly one activation.
+// Every member keeps them activated, so they are activated again on another member as soon as their member leaves.
+func setupSingletons(kinds []string) {
+	singletons = &singletonValue{}
+	if len(kinds) == 0 {
+		return
+	}
+
+	props := actor.PropsFromProducer(func() actor.Actor {
+		return &singletonManagerActor{
+			kinds:  kinds,
+			pids:   make(map[string]*actor.PID),
+			getPid: Get,
+		}
+	}).WithGuardian(actor.RestartingSupervisorStrategy())
+	singletons.manager, _ = rootContext.SpawnNamed(props, "singletons")
+
+	singletons.memberStatusSub = eventstream.Subscribe(func(m interface{}) {
+		rootContext.Send(singletons.manager, &activateSingletons{})
+	}).WithPredicate(func(m interface{}) bool {
+		_, ok := m.(MemberStatusEvent)
+		return ok
+	})
+}
+
+func stopSingletons() {
+	if singletons.manager != nil {
+		rootContext.StopFuture(singletons.manager).Wait()
+		eventstream.Unsubscribe(singletons.memberStatusSub)
+	}
+	singletons = nil
+}
+
+// SingletonProxy returns a local PID routing the messages to the current activation of the singleton kind
+func SingletonProxy(kind string) *actor.PID {
+	props := actor.PropsFromProducer(func() actor.Actor {
+		return &singletonProxyActor{kind: kind, getPid: Get}
+	})
+	pid, _ := rootContext.SpawnNamed(props, "singleton-proxy-"+kind)
+	return pid
+}
+
+type activateSingletons struct{}
+
+type singletonManagerActor struct {
+	kinds     []string
+	pids      map[string]*actor.PID // kind to the PID of its activation
+	retrying  bool
+	scheduler *scheduler.TimerScheduler
+	getPid    func(name string, kind string) (*actor.PID, remote.ResponseStatusCode)
+}
+
+func (state *singletonManagerActor) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *actor.Started:
+		state.scheduler = scheduler.NewTimerScheduler(scheduler.WithContext(ctx))
+		state.activate(ctx)
+	case *activateSingletons:
+		state.retrying = false
+		state.activate(ctx)
+	case *actor.Terminated:
+		for kind, pid := range state.pids {
+			if pid.String() == msg.Who.String() {
+				plog.Info("Singleton terminated, activating it again", log.String("kind", kind))
+				delete(state.pids, kind)
+				RemoveCache(kind)
+			}
+		}
+		state.activate(ctx)
+	}
+}
+
+func (state *singletonManagerActor) activate(ctx actor.Context) {
+	failed := false
+	for _, kind := range state.kinds {
+		if _, ok := state.pids[kind]; ok {
+			continue
+		}
+		pid, statusCode := state.getPid(kind, kind)
+		if statusCode != remote.ResponseStatusCodeOK && statusCode != remote.ResponseStatusCodePROCESSNAMEALREADYEXIST {
+			failed = true
+			continue
+		}
+		state.pids[kind] = pid
+		ctx.Watch(pid)
+	}
+	if failed && !state.retrying {
+		state.retrying = true
+		state.scheduler.SendOnce(singletonRetryInterval, ctx.Self(), &activateSingletons{})
+	}
+}
+
+// singletonProxyActor forwards the messages to the current activation of a singleton
+type singletonProxyActor struct {
+	kind   string
+	target *actor.PID
+	getPid func(name string, kind string) (*actor.PID, remote.ResponseStatusCode)
+}
+
+func (state *singletonProxyActor) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *actor.Terminated:
+		if state.target != nil && state.target.String() == msg.Who.String() {
+			state.target = nil
+			RemoveCache(state.kind)
+		}
+	case actor.SystemMessage, actor.AutoReceiveMessage:
+		// ignore
+	default:
+		if state.target == nil {
+			pid, statusCode := state.getPid(state.kind, state.kind)
+			if statusCode != remote.ResponseStatusCodeOK && statusCode != remote.ResponseStatusCodePROCESSNAMEALREADYEXIST {
+				plog.Info("Singleton unavailable, dropping message", log.String("kind", state.kind), log.Object("status", statusCode))
+				return
+			}
+			state.target = pid
+			ctx.Watch(pid)
+		}
+		ctx.Forward(state.target)
+	}
+}
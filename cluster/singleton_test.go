@@ -0,0 +1,85 @@
+package cluster
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	"github.com/stretchr/testify/assert"
+)
+
+// testActivations activates a local actor per get, as the partition does when the previous activation is gone
+type testActivations struct {
+	mu       sync.Mutex
+	current  *actor.PID
+	count    int
+	received chan interface{}
+}
+
+func (a *testActivations) get(name string, kind string) (*actor.PID, remote.ResponseStatusCode) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.current == nil {
+		a.count++
+		a.current = rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+			if msg, ok := ctx.Message().(string); ok {
+				a.received <- msg
+			}
+		}))
+	}
+	return a.current, remote.ResponseStatusCodeOK
+}
+
+func (a *testActivations) stop() {
+	a.mu.Lock()
+	pid := a.current
+	a.current = nil
+	a.mu.Unlock()
+	rootContext.StopFuture(pid).Wait()
+}
+
+func (a *testActivations) activations() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.count
+}
+
+func TestSingletonManager_ActivatesAgain(t *testing.T) {
+	setupPidCache()
+	defer stopPidCache()
+
+	activations := &testActivations{received: make(chan interface{}, 10)}
+	manager := rootContext.Spawn(actor.PropsFromProducer(func() actor.Actor {
+		return &singletonManagerActor{kinds: []string{"singleton"}, pids: make(map[string]*actor.PID), getPid: activations.get}
+	}))
+	defer rootContext.Stop(manager)
+
+	assert.Eventually(t, func() bool { return activations.activations() == 1 }, time.Second, 10*time.Millisecond)
+
+	// the activation stops, as when its member leaves
+	activations.stop()
+	assert.Eventually(t, func() bool { return activations.activations() == 2 }, time.Second, 10*time.Millisecond)
+}
+
+func TestSingletonProxy_RoutesToCurrentActivation(t *testing.T) {
+	setupPidCache()
+	defer stopPidCache()
+
+	activations := &testActivations{received: make(chan interface{}, 10)}
+	proxy := rootContext.Spawn(actor.PropsFromProducer(func() actor.Actor {
+		return &singletonProxyActor{kind: "singleton", getPid: activations.get}
+	}))
+	defer rootContext.Stop(proxy)
+
+	rootContext.Send(proxy, "first")
+	assert.Equal(t, "first", <-activations.received)
+
+	activations.stop()
+	// wait for the proxy to see the activation terminate
+	time.Sleep(50 * time.Millisecond)
+	rootContext.Send(proxy, "second")
+	assert.Equal(t, "second", <-activations.received)
+	assert.Equal(t, 2, activations.activations())
+}
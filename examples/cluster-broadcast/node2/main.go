@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"github.com/AsynkronIT/goconsole"
 	"github.com/AsynkronIT/protoactor-go/actor"
@@ -68,7 +69,7 @@ func startNode(port int64)  {
 
 func calcAdd(grainId string, addNumber int64)  {
 	calcGrain := shared.GetCalculatorGrain(grainId)
-	total1, err := calcGrain.Add(&shared.NumberRequest{ Number: addNumber})
+	total1, err := calcGrain.Add(context.Background(), &shared.NumberRequest{ Number: addNumber})
 	if err != nil {
 		panic(err)
 	}
@@ -78,7 +79,7 @@ func calcAdd(grainId string, addNumber int64)  {
 
 func getAll()  {
 	trackerGrain := shared.GetTrackerGrain("singleTrackerGrain")
-	totals, err := trackerGrain.BroadcastGetCounts(&shared.Noop{})
+	totals, err := trackerGrain.BroadcastGetCounts(context.Background(), &shared.Noop{})
 	if err != nil {
 		panic(err)
 	}
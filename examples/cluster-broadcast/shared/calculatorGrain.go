@@ -1,6 +1,10 @@
 package shared
 
-import "github.com/AsynkronIT/protoactor-go/cluster"
+import (
+	"context"
+
+	"github.com/AsynkronIT/protoactor-go/cluster"
+)
 
 type CalcGrain struct {
 	cluster.Grain
@@ -13,14 +17,14 @@ func (c *CalcGrain) Init(id string)  {
 
 	// register with the tracker
 	trackerGrain := GetTrackerGrain("singleTrackerGrain")
-	trackerGrain.RegisterGrain(&RegisterMessage{GrainId: c.ID()})
+	trackerGrain.RegisterGrain(context.Background(), &RegisterMessage{GrainId: c.ID()})
 }
 
 func (c *CalcGrain) Terminate()  {
 
 	// deregister with the tracker
 	trackerGrain := GetTrackerGrain("singleTrackerGrain")
-	trackerGrain.DeregisterGrain(&RegisterMessage{GrainId: c.ID()})
+	trackerGrain.DeregisterGrain(context.Background(), &RegisterMessage{GrainId: c.ID()})
 }
 
 func (c *CalcGrain) Add(n *NumberRequest, ctx cluster.GrainContext) (*CountResponse, error) {
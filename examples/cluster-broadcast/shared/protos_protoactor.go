@@ -2,6 +2,7 @@
 package shared
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -45,63 +46,42 @@ type Calculator interface {
 		
 }
 
+// CalculatorClient calls a Calculator grain, depend on it rather than on CalculatorGrain to replace the grain by a mock in tests
+type CalculatorClient interface {
+		
+	Add(ctx context.Context, r *NumberRequest, opts ...cluster.GrainCallOption) (*CountResponse, error)
+		
+	Subtract(ctx context.Context, r *NumberRequest, opts ...cluster.GrainCallOption) (*CountResponse, error)
+		
+	GetCurrent(ctx context.Context, r *Noop, opts ...cluster.GrainCallOption) (*CountResponse, error)
+		
+}
+
+var _ CalculatorClient = (*CalculatorGrain)(nil)
+
 // CalculatorGrain holds the base data for the CalculatorGrain
 type CalculatorGrain struct {
 	ID string
 }
 	
-// Add requests the execution on to the cluster using default options
-func (g *CalculatorGrain) Add(r *NumberRequest) (*CountResponse, error) {
-	return g.AddWithOpts(r, cluster.DefaultGrainCallOptions())
+// Add requests the execution on to the cluster until ctx is done, using the options of the kind changed by opts
+func (g *CalculatorGrain) Add(ctx context.Context, r *NumberRequest, opts ...cluster.GrainCallOption) (*CountResponse, error) {
+	res := &CountResponse{}
+	err := cluster.CallGrain(ctx, g.ID, "Calculator", 0, r, res, cluster.ApplyGrainCallOptions("Calculator", opts...))
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 // AddWithOpts requests the execution on to the cluster
 func (g *CalculatorGrain) AddWithOpts(r *NumberRequest, opts *cluster.GrainCallOptions) (*CountResponse, error) {
-	fun := func() (*CountResponse, error) {
-			pid, statusCode := cluster.Get(g.ID, "Calculator")
-			if statusCode != remote.ResponseStatusCodeOK {
-				return nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode)
-			}
-			bytes, err := proto.Marshal(r)
-			if err != nil {
-				return nil, err
-			}
-			request := &cluster.GrainRequest{MethodIndex: 0, MessageData: bytes}
-			response, err := rootContext.RequestFuture(pid, request, opts.Timeout).Result()
-			if err != nil {
-				return nil, err
-			}
-			switch msg := response.(type) {
-			case *cluster.GrainResponse:
-				result := &CountResponse{}
-				err = proto.Unmarshal(msg.MessageData, result)
-				if err != nil {
-					return nil, err
-				}
-				return result, nil
-			case *cluster.GrainErrorResponse:
-				return nil, errors.New(msg.Err)
-			default:
-				return nil, errors.New("unknown response")
-			}
-		}
-	
-	var res *CountResponse
-	var err error
-	for i := 0; i < opts.RetryCount; i++ {
-		res, err = fun()
-		if err == nil || err.Error() != "future: timeout" {
-			return res, err
-		} else if opts.RetryAction != nil {
-				opts.RetryAction(i)
-		}
-	}
-	return nil, err
+	return g.Add(context.Background(), r, cluster.WithCallOptions(opts))
 }
 
-// AddChan allows to use a channel to execute the method using default options
+// AddChan allows to use a channel to execute the method using the options of the kind
 func (g *CalculatorGrain) AddChan(r *NumberRequest) (<-chan *CountResponse, <-chan error) {
-	return g.AddChanWithOpts(r, cluster.DefaultGrainCallOptions())
+	return g.AddChanWithOpts(r, cluster.GrainCallOptionsForKind("Calculator"))
 }
 
 // AddChanWithOpts allows to use a channel to execute the method
@@ -120,59 +100,66 @@ func (g *CalculatorGrain) AddChanWithOpts(r *NumberRequest, opts *cluster.GrainC
 	}()
 	return c, e
 }
-	
-// Subtract requests the execution on to the cluster using default options
-func (g *CalculatorGrain) Subtract(r *NumberRequest) (*CountResponse, error) {
-	return g.SubtractWithOpts(r, cluster.DefaultGrainCallOptions())
+
+// AddReenter requests the execution on to the cluster from a grain using the options of the kind,
+// the calling grain processes other messages until cont is called with the result
+func (g *CalculatorGrain) AddReenter(ctx cluster.GrainContext, r *NumberRequest, cont func(*CountResponse, error)) {
+	g.AddReenterWithOpts(ctx, r, cluster.GrainCallOptionsForKind("Calculator"), cont)
 }
 
-// SubtractWithOpts requests the execution on to the cluster
-func (g *CalculatorGrain) SubtractWithOpts(r *NumberRequest, opts *cluster.GrainCallOptions) (*CountResponse, error) {
-	fun := func() (*CountResponse, error) {
-			pid, statusCode := cluster.Get(g.ID, "Calculator")
-			if statusCode != remote.ResponseStatusCodeOK {
-				return nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode)
-			}
-			bytes, err := proto.Marshal(r)
-			if err != nil {
-				return nil, err
-			}
-			request := &cluster.GrainRequest{MethodIndex: 1, MessageData: bytes}
-			response, err := rootContext.RequestFuture(pid, request, opts.Timeout).Result()
+// AddReenterWithOpts requests the execution on to the cluster from a grain, the call is not retried
+func (g *CalculatorGrain) AddReenterWithOpts(ctx cluster.GrainContext, r *NumberRequest, opts *cluster.GrainCallOptions, cont func(*CountResponse, error)) {
+	pid, statusCode := cluster.Get(g.ID, "Calculator")
+	if statusCode != remote.ResponseStatusCodeOK && statusCode != remote.ResponseStatusCodePROCESSNAMEALREADYEXIST {
+		cont(nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode))
+		return
+	}
+	bytes, err := proto.Marshal(r)
+	if err != nil {
+		cont(nil, err)
+		return
+	}
+	request := &cluster.GrainRequest{MethodIndex: 0, MessageData: bytes}
+	ctx.AwaitFuture(ctx.RequestFuture(pid, request, opts.Timeout), func(response interface{}, err error) {
+		if err != nil {
+			cont(nil, err)
+			return
+		}
+		switch msg := response.(type) {
+		case *cluster.GrainResponse:
+			result := &CountResponse{}
+			err = proto.Unmarshal(msg.MessageData, result)
 			if err != nil {
-				return nil, err
-			}
-			switch msg := response.(type) {
-			case *cluster.GrainResponse:
-				result := &CountResponse{}
-				err = proto.Unmarshal(msg.MessageData, result)
-				if err != nil {
-					return nil, err
-				}
-				return result, nil
-			case *cluster.GrainErrorResponse:
-				return nil, errors.New(msg.Err)
-			default:
-				return nil, errors.New("unknown response")
-			}
+				cont(nil, err)
+				return
+			}
+			cont(result, nil)
+		case *cluster.GrainErrorResponse:
+			cont(nil, errors.New(msg.Err))
+		default:
+			cont(nil, errors.New("unknown response"))
 		}
+	})
+}
 	
-	var res *CountResponse
-	var err error
-	for i := 0; i < opts.RetryCount; i++ {
-		res, err = fun()
-		if err == nil || err.Error() != "future: timeout" {
-			return res, err
-		} else if opts.RetryAction != nil {
-				opts.RetryAction(i)
-		}
+// Subtract requests the execution on to the cluster until ctx is done, using the options of the kind changed by opts
+func (g *CalculatorGrain) Subtract(ctx context.Context, r *NumberRequest, opts ...cluster.GrainCallOption) (*CountResponse, error) {
+	res := &CountResponse{}
+	err := cluster.CallGrain(ctx, g.ID, "Calculator", 1, r, res, cluster.ApplyGrainCallOptions("Calculator", opts...))
+	if err != nil {
+		return nil, err
 	}
-	return nil, err
+	return res, nil
+}
+
+// SubtractWithOpts requests the execution on to the cluster
+func (g *CalculatorGrain) SubtractWithOpts(r *NumberRequest, opts *cluster.GrainCallOptions) (*CountResponse, error) {
+	return g.Subtract(context.Background(), r, cluster.WithCallOptions(opts))
 }
 
-// SubtractChan allows to use a channel to execute the method using default options
+// SubtractChan allows to use a channel to execute the method using the options of the kind
 func (g *CalculatorGrain) SubtractChan(r *NumberRequest) (<-chan *CountResponse, <-chan error) {
-	return g.SubtractChanWithOpts(r, cluster.DefaultGrainCallOptions())
+	return g.SubtractChanWithOpts(r, cluster.GrainCallOptionsForKind("Calculator"))
 }
 
 // SubtractChanWithOpts allows to use a channel to execute the method
@@ -191,59 +178,66 @@ func (g *CalculatorGrain) SubtractChanWithOpts(r *NumberRequest, opts *cluster.G
 	}()
 	return c, e
 }
-	
-// GetCurrent requests the execution on to the cluster using default options
-func (g *CalculatorGrain) GetCurrent(r *Noop) (*CountResponse, error) {
-	return g.GetCurrentWithOpts(r, cluster.DefaultGrainCallOptions())
+
+// SubtractReenter requests the execution on to the cluster from a grain using the options of the kind,
+// the calling grain processes other messages until cont is called with the result
+func (g *CalculatorGrain) SubtractReenter(ctx cluster.GrainContext, r *NumberRequest, cont func(*CountResponse, error)) {
+	g.SubtractReenterWithOpts(ctx, r, cluster.GrainCallOptionsForKind("Calculator"), cont)
 }
 
-// GetCurrentWithOpts requests the execution on to the cluster
-func (g *CalculatorGrain) GetCurrentWithOpts(r *Noop, opts *cluster.GrainCallOptions) (*CountResponse, error) {
-	fun := func() (*CountResponse, error) {
-			pid, statusCode := cluster.Get(g.ID, "Calculator")
-			if statusCode != remote.ResponseStatusCodeOK {
-				return nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode)
-			}
-			bytes, err := proto.Marshal(r)
-			if err != nil {
-				return nil, err
-			}
-			request := &cluster.GrainRequest{MethodIndex: 2, MessageData: bytes}
-			response, err := rootContext.RequestFuture(pid, request, opts.Timeout).Result()
+// SubtractReenterWithOpts requests the execution on to the cluster from a grain, the call is not retried
+func (g *CalculatorGrain) SubtractReenterWithOpts(ctx cluster.GrainContext, r *NumberRequest, opts *cluster.GrainCallOptions, cont func(*CountResponse, error)) {
+	pid, statusCode := cluster.Get(g.ID, "Calculator")
+	if statusCode != remote.ResponseStatusCodeOK && statusCode != remote.ResponseStatusCodePROCESSNAMEALREADYEXIST {
+		cont(nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode))
+		return
+	}
+	bytes, err := proto.Marshal(r)
+	if err != nil {
+		cont(nil, err)
+		return
+	}
+	request := &cluster.GrainRequest{MethodIndex: 1, MessageData: bytes}
+	ctx.AwaitFuture(ctx.RequestFuture(pid, request, opts.Timeout), func(response interface{}, err error) {
+		if err != nil {
+			cont(nil, err)
+			return
+		}
+		switch msg := response.(type) {
+		case *cluster.GrainResponse:
+			result := &CountResponse{}
+			err = proto.Unmarshal(msg.MessageData, result)
 			if err != nil {
-				return nil, err
-			}
-			switch msg := response.(type) {
-			case *cluster.GrainResponse:
-				result := &CountResponse{}
-				err = proto.Unmarshal(msg.MessageData, result)
-				if err != nil {
-					return nil, err
-				}
-				return result, nil
-			case *cluster.GrainErrorResponse:
-				return nil, errors.New(msg.Err)
-			default:
-				return nil, errors.New("unknown response")
-			}
+				cont(nil, err)
+				return
+			}
+			cont(result, nil)
+		case *cluster.GrainErrorResponse:
+			cont(nil, errors.New(msg.Err))
+		default:
+			cont(nil, errors.New("unknown response"))
 		}
+	})
+}
 	
-	var res *CountResponse
-	var err error
-	for i := 0; i < opts.RetryCount; i++ {
-		res, err = fun()
-		if err == nil || err.Error() != "future: timeout" {
-			return res, err
-		} else if opts.RetryAction != nil {
-				opts.RetryAction(i)
-		}
+// GetCurrent requests the execution on to the cluster until ctx is done, using the options of the kind changed by opts
+func (g *CalculatorGrain) GetCurrent(ctx context.Context, r *Noop, opts ...cluster.GrainCallOption) (*CountResponse, error) {
+	res := &CountResponse{}
+	err := cluster.CallGrain(ctx, g.ID, "Calculator", 2, r, res, cluster.ApplyGrainCallOptions("Calculator", opts...))
+	if err != nil {
+		return nil, err
 	}
-	return nil, err
+	return res, nil
+}
+
+// GetCurrentWithOpts requests the execution on to the cluster
+func (g *CalculatorGrain) GetCurrentWithOpts(r *Noop, opts *cluster.GrainCallOptions) (*CountResponse, error) {
+	return g.GetCurrent(context.Background(), r, cluster.WithCallOptions(opts))
 }
 
-// GetCurrentChan allows to use a channel to execute the method using default options
+// GetCurrentChan allows to use a channel to execute the method using the options of the kind
 func (g *CalculatorGrain) GetCurrentChan(r *Noop) (<-chan *CountResponse, <-chan error) {
-	return g.GetCurrentChanWithOpts(r, cluster.DefaultGrainCallOptions())
+	return g.GetCurrentChanWithOpts(r, cluster.GrainCallOptionsForKind("Calculator"))
 }
 
 // GetCurrentChanWithOpts allows to use a channel to execute the method
@@ -262,6 +256,47 @@ func (g *CalculatorGrain) GetCurrentChanWithOpts(r *Noop, opts *cluster.GrainCal
 	}()
 	return c, e
 }
+
+// GetCurrentReenter requests the execution on to the cluster from a grain using the options of the kind,
+// the calling grain processes other messages until cont is called with the result
+func (g *CalculatorGrain) GetCurrentReenter(ctx cluster.GrainContext, r *Noop, cont func(*CountResponse, error)) {
+	g.GetCurrentReenterWithOpts(ctx, r, cluster.GrainCallOptionsForKind("Calculator"), cont)
+}
+
+// GetCurrentReenterWithOpts requests the execution on to the cluster from a grain, the call is not retried
+func (g *CalculatorGrain) GetCurrentReenterWithOpts(ctx cluster.GrainContext, r *Noop, opts *cluster.GrainCallOptions, cont func(*CountResponse, error)) {
+	pid, statusCode := cluster.Get(g.ID, "Calculator")
+	if statusCode != remote.ResponseStatusCodeOK && statusCode != remote.ResponseStatusCodePROCESSNAMEALREADYEXIST {
+		cont(nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode))
+		return
+	}
+	bytes, err := proto.Marshal(r)
+	if err != nil {
+		cont(nil, err)
+		return
+	}
+	request := &cluster.GrainRequest{MethodIndex: 2, MessageData: bytes}
+	ctx.AwaitFuture(ctx.RequestFuture(pid, request, opts.Timeout), func(response interface{}, err error) {
+		if err != nil {
+			cont(nil, err)
+			return
+		}
+		switch msg := response.(type) {
+		case *cluster.GrainResponse:
+			result := &CountResponse{}
+			err = proto.Unmarshal(msg.MessageData, result)
+			if err != nil {
+				cont(nil, err)
+				return
+			}
+			cont(result, nil)
+		case *cluster.GrainErrorResponse:
+			cont(nil, errors.New(msg.Err))
+		default:
+			cont(nil, errors.New("unknown response"))
+		}
+	})
+}
 	
 
 // CalculatorActor represents the actor structure
@@ -270,6 +305,13 @@ type CalculatorActor struct {
 	Timeout *time.Duration
 }
 
+// PrePassivate gives the grain the chance to persist its state before it is passivated when it implements cluster.Passivatable
+func (a *CalculatorActor) PrePassivate(ctx actor.Context) {
+	if p, ok := a.inner.(cluster.Passivatable); ok {
+		p.PrePassivate(ctx)
+	}
+}
+
 // Receive ensures the lifecycle of the actor for the received message
 func (a *CalculatorActor) Receive(ctx actor.Context) {
 	switch msg := ctx.Message().(type) {
@@ -282,11 +324,21 @@ func (a *CalculatorActor) Receive(ctx actor.Context) {
 		}
 	case *actor.ReceiveTimeout:
 		a.inner.Terminate()
-		rootContext.PoisonFuture(ctx.Self()).Wait()
+		ctx.Self().Poison()
 
 	case actor.AutoReceiveMessage: // pass
 	case actor.SystemMessage: // pass
 
+	case *cluster.ReminderFired:
+		if r, ok := a.inner.(cluster.Remindable); ok {
+			r.ReceiveReminder(msg.Name, ctx)
+		}
+
+	case *cluster.Handoff:
+		if h, ok := a.inner.(cluster.Handoffable); ok {
+			h.Handoff(ctx)
+		}
+
 	case *cluster.GrainRequest:
 		switch msg.MethodIndex {
 			
@@ -294,61 +346,44 @@ func (a *CalculatorActor) Receive(ctx actor.Context) {
 			req := &NumberRequest{}
 			err := proto.Unmarshal(msg.MessageData, req)
 			if err != nil {
-				log.Fatalf("[GRAIN] proto.Unmarshal failed %v", err)
+				cluster.RespondGrain(ctx, nil, err)
+				return
 			}
 			r0, err := a.inner.Add(req, ctx)
-			if err == nil {
-				bytes, errMarshal := proto.Marshal(r0)
-				if errMarshal != nil {
-					log.Fatalf("[GRAIN] proto.Marshal failed %v", errMarshal)
-				}
-				resp := &cluster.GrainResponse{MessageData: bytes}
-				ctx.Respond(resp)
-			} else {
-				resp := &cluster.GrainErrorResponse{Err: err.Error()}
-				ctx.Respond(resp)
+			if err != cluster.ErrResponseDeferred {
+				cluster.RespondGrain(ctx, r0, err)
 			}
 			
 		case 1:
 			req := &NumberRequest{}
 			err := proto.Unmarshal(msg.MessageData, req)
 			if err != nil {
-				log.Fatalf("[GRAIN] proto.Unmarshal failed %v", err)
+				cluster.RespondGrain(ctx, nil, err)
+				return
 			}
 			r0, err := a.inner.Subtract(req, ctx)
-			if err == nil {
-				bytes, errMarshal := proto.Marshal(r0)
-				if errMarshal != nil {
-					log.Fatalf("[GRAIN] proto.Marshal failed %v", errMarshal)
-				}
-				resp := &cluster.GrainResponse{MessageData: bytes}
-				ctx.Respond(resp)
-			} else {
-				resp := &cluster.GrainErrorResponse{Err: err.Error()}
-				ctx.Respond(resp)
+			if err != cluster.ErrResponseDeferred {
+				cluster.RespondGrain(ctx, r0, err)
 			}
 			
 		case 2:
 			req := &Noop{}
 			err := proto.Unmarshal(msg.MessageData, req)
 			if err != nil {
-				log.Fatalf("[GRAIN] proto.Unmarshal failed %v", err)
+				cluster.RespondGrain(ctx, nil, err)
+				return
 			}
 			r0, err := a.inner.GetCurrent(req, ctx)
-			if err == nil {
-				bytes, errMarshal := proto.Marshal(r0)
-				if errMarshal != nil {
-					log.Fatalf("[GRAIN] proto.Marshal failed %v", errMarshal)
-				}
-				resp := &cluster.GrainResponse{MessageData: bytes}
-				ctx.Respond(resp)
-			} else {
-				resp := &cluster.GrainErrorResponse{Err: err.Error()}
-				ctx.Respond(resp)
+			if err != cluster.ErrResponseDeferred {
+				cluster.RespondGrain(ctx, r0, err)
 			}
 		
 		}
 	default:
+		if b, ok := a.inner.(cluster.BroadcastReceiver); ok {
+			b.ReceiveBroadcast(msg, ctx)
+			return
+		}
 		log.Printf("Unknown message %v", msg)
 	}
 }
@@ -379,63 +414,42 @@ type Tracker interface {
 		
 }
 
+// TrackerClient calls a Tracker grain, depend on it rather than on TrackerGrain to replace the grain by a mock in tests
+type TrackerClient interface {
+		
+	RegisterGrain(ctx context.Context, r *RegisterMessage, opts ...cluster.GrainCallOption) (*Noop, error)
+		
+	DeregisterGrain(ctx context.Context, r *RegisterMessage, opts ...cluster.GrainCallOption) (*Noop, error)
+		
+	BroadcastGetCounts(ctx context.Context, r *Noop, opts ...cluster.GrainCallOption) (*TotalsResponse, error)
+		
+}
+
+var _ TrackerClient = (*TrackerGrain)(nil)
+
 // TrackerGrain holds the base data for the TrackerGrain
 type TrackerGrain struct {
 	ID string
 }
 	
-// RegisterGrain requests the execution on to the cluster using default options
-func (g *TrackerGrain) RegisterGrain(r *RegisterMessage) (*Noop, error) {
-	return g.RegisterGrainWithOpts(r, cluster.DefaultGrainCallOptions())
+// RegisterGrain requests the execution on to the cluster until ctx is done, using the options of the kind changed by opts
+func (g *TrackerGrain) RegisterGrain(ctx context.Context, r *RegisterMessage, opts ...cluster.GrainCallOption) (*Noop, error) {
+	res := &Noop{}
+	err := cluster.CallGrain(ctx, g.ID, "Tracker", 0, r, res, cluster.ApplyGrainCallOptions("Tracker", opts...))
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 // RegisterGrainWithOpts requests the execution on to the cluster
 func (g *TrackerGrain) RegisterGrainWithOpts(r *RegisterMessage, opts *cluster.GrainCallOptions) (*Noop, error) {
-	fun := func() (*Noop, error) {
-			pid, statusCode := cluster.Get(g.ID, "Tracker")
-			if statusCode != remote.ResponseStatusCodeOK {
-				return nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode)
-			}
-			bytes, err := proto.Marshal(r)
-			if err != nil {
-				return nil, err
-			}
-			request := &cluster.GrainRequest{MethodIndex: 0, MessageData: bytes}
-			response, err := rootContext.RequestFuture(pid, request, opts.Timeout).Result()
-			if err != nil {
-				return nil, err
-			}
-			switch msg := response.(type) {
-			case *cluster.GrainResponse:
-				result := &Noop{}
-				err = proto.Unmarshal(msg.MessageData, result)
-				if err != nil {
-					return nil, err
-				}
-				return result, nil
-			case *cluster.GrainErrorResponse:
-				return nil, errors.New(msg.Err)
-			default:
-				return nil, errors.New("unknown response")
-			}
-		}
-	
-	var res *Noop
-	var err error
-	for i := 0; i < opts.RetryCount; i++ {
-		res, err = fun()
-		if err == nil || err.Error() != "future: timeout" {
-			return res, err
-		} else if opts.RetryAction != nil {
-				opts.RetryAction(i)
-		}
-	}
-	return nil, err
+	return g.RegisterGrain(context.Background(), r, cluster.WithCallOptions(opts))
 }
 
-// RegisterGrainChan allows to use a channel to execute the method using default options
+// RegisterGrainChan allows to use a channel to execute the method using the options of the kind
 func (g *TrackerGrain) RegisterGrainChan(r *RegisterMessage) (<-chan *Noop, <-chan error) {
-	return g.RegisterGrainChanWithOpts(r, cluster.DefaultGrainCallOptions())
+	return g.RegisterGrainChanWithOpts(r, cluster.GrainCallOptionsForKind("Tracker"))
 }
 
 // RegisterGrainChanWithOpts allows to use a channel to execute the method
@@ -454,59 +468,66 @@ func (g *TrackerGrain) RegisterGrainChanWithOpts(r *RegisterMessage, opts *clust
 	}()
 	return c, e
 }
-	
-// DeregisterGrain requests the execution on to the cluster using default options
-func (g *TrackerGrain) DeregisterGrain(r *RegisterMessage) (*Noop, error) {
-	return g.DeregisterGrainWithOpts(r, cluster.DefaultGrainCallOptions())
+
+// RegisterGrainReenter requests the execution on to the cluster from a grain using the options of the kind,
+// the calling grain processes other messages until cont is called with the result
+func (g *TrackerGrain) RegisterGrainReenter(ctx cluster.GrainContext, r *RegisterMessage, cont func(*Noop, error)) {
+	g.RegisterGrainReenterWithOpts(ctx, r, cluster.GrainCallOptionsForKind("Tracker"), cont)
 }
 
-// DeregisterGrainWithOpts requests the execution on to the cluster
-func (g *TrackerGrain) DeregisterGrainWithOpts(r *RegisterMessage, opts *cluster.GrainCallOptions) (*Noop, error) {
-	fun := func() (*Noop, error) {
-			pid, statusCode := cluster.Get(g.ID, "Tracker")
-			if statusCode != remote.ResponseStatusCodeOK {
-				return nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode)
-			}
-			bytes, err := proto.Marshal(r)
-			if err != nil {
-				return nil, err
-			}
-			request := &cluster.GrainRequest{MethodIndex: 1, MessageData: bytes}
-			response, err := rootContext.RequestFuture(pid, request, opts.Timeout).Result()
+// RegisterGrainReenterWithOpts requests the execution on to the cluster from a grain, the call is not retried
+func (g *TrackerGrain) RegisterGrainReenterWithOpts(ctx cluster.GrainContext, r *RegisterMessage, opts *cluster.GrainCallOptions, cont func(*Noop, error)) {
+	pid, statusCode := cluster.Get(g.ID, "Tracker")
+	if statusCode != remote.ResponseStatusCodeOK && statusCode != remote.ResponseStatusCodePROCESSNAMEALREADYEXIST {
+		cont(nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode))
+		return
+	}
+	bytes, err := proto.Marshal(r)
+	if err != nil {
+		cont(nil, err)
+		return
+	}
+	request := &cluster.GrainRequest{MethodIndex: 0, MessageData: bytes}
+	ctx.AwaitFuture(ctx.RequestFuture(pid, request, opts.Timeout), func(response interface{}, err error) {
+		if err != nil {
+			cont(nil, err)
+			return
+		}
+		switch msg := response.(type) {
+		case *cluster.GrainResponse:
+			result := &Noop{}
+			err = proto.Unmarshal(msg.MessageData, result)
 			if err != nil {
-				return nil, err
-			}
-			switch msg := response.(type) {
-			case *cluster.GrainResponse:
-				result := &Noop{}
-				err = proto.Unmarshal(msg.MessageData, result)
-				if err != nil {
-					return nil, err
-				}
-				return result, nil
-			case *cluster.GrainErrorResponse:
-				return nil, errors.New(msg.Err)
-			default:
-				return nil, errors.New("unknown response")
-			}
+				cont(nil, err)
+				return
+			}
+			cont(result, nil)
+		case *cluster.GrainErrorResponse:
+			cont(nil, errors.New(msg.Err))
+		default:
+			cont(nil, errors.New("unknown response"))
 		}
+	})
+}
 	
-	var res *Noop
-	var err error
-	for i := 0; i < opts.RetryCount; i++ {
-		res, err = fun()
-		if err == nil || err.Error() != "future: timeout" {
-			return res, err
-		} else if opts.RetryAction != nil {
-				opts.RetryAction(i)
-		}
+// DeregisterGrain requests the execution on to the cluster until ctx is done, using the options of the kind changed by opts
+func (g *TrackerGrain) DeregisterGrain(ctx context.Context, r *RegisterMessage, opts ...cluster.GrainCallOption) (*Noop, error) {
+	res := &Noop{}
+	err := cluster.CallGrain(ctx, g.ID, "Tracker", 1, r, res, cluster.ApplyGrainCallOptions("Tracker", opts...))
+	if err != nil {
+		return nil, err
 	}
-	return nil, err
+	return res, nil
+}
+
+// DeregisterGrainWithOpts requests the execution on to the cluster
+func (g *TrackerGrain) DeregisterGrainWithOpts(r *RegisterMessage, opts *cluster.GrainCallOptions) (*Noop, error) {
+	return g.DeregisterGrain(context.Background(), r, cluster.WithCallOptions(opts))
 }
 
-// DeregisterGrainChan allows to use a channel to execute the method using default options
+// DeregisterGrainChan allows to use a channel to execute the method using the options of the kind
 func (g *TrackerGrain) DeregisterGrainChan(r *RegisterMessage) (<-chan *Noop, <-chan error) {
-	return g.DeregisterGrainChanWithOpts(r, cluster.DefaultGrainCallOptions())
+	return g.DeregisterGrainChanWithOpts(r, cluster.GrainCallOptionsForKind("Tracker"))
 }
 
 // DeregisterGrainChanWithOpts allows to use a channel to execute the method
@@ -525,59 +546,66 @@ func (g *TrackerGrain) DeregisterGrainChanWithOpts(r *RegisterMessage, opts *clu
 	}()
 	return c, e
 }
-	
-// BroadcastGetCounts requests the execution on to the cluster using default options
-func (g *TrackerGrain) BroadcastGetCounts(r *Noop) (*TotalsResponse, error) {
-	return g.BroadcastGetCountsWithOpts(r, cluster.DefaultGrainCallOptions())
+
+// DeregisterGrainReenter requests the execution on to the cluster from a grain using the options of the kind,
+// the calling grain processes other messages until cont is called with the result
+func (g *TrackerGrain) DeregisterGrainReenter(ctx cluster.GrainContext, r *RegisterMessage, cont func(*Noop, error)) {
+	g.DeregisterGrainReenterWithOpts(ctx, r, cluster.GrainCallOptionsForKind("Tracker"), cont)
 }
 
-// BroadcastGetCountsWithOpts requests the execution on to the cluster
-func (g *TrackerGrain) BroadcastGetCountsWithOpts(r *Noop, opts *cluster.GrainCallOptions) (*TotalsResponse, error) {
-	fun := func() (*TotalsResponse, error) {
-			pid, statusCode := cluster.Get(g.ID, "Tracker")
-			if statusCode != remote.ResponseStatusCodeOK {
-				return nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode)
-			}
-			bytes, err := proto.Marshal(r)
-			if err != nil {
-				return nil, err
-			}
-			request := &cluster.GrainRequest{MethodIndex: 2, MessageData: bytes}
-			response, err := rootContext.RequestFuture(pid, request, opts.Timeout).Result()
+// DeregisterGrainReenterWithOpts requests the execution on to the cluster from a grain, the call is not retried
+func (g *TrackerGrain) DeregisterGrainReenterWithOpts(ctx cluster.GrainContext, r *RegisterMessage, opts *cluster.GrainCallOptions, cont func(*Noop, error)) {
+	pid, statusCode := cluster.Get(g.ID, "Tracker")
+	if statusCode != remote.ResponseStatusCodeOK && statusCode != remote.ResponseStatusCodePROCESSNAMEALREADYEXIST {
+		cont(nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode))
+		return
+	}
+	bytes, err := proto.Marshal(r)
+	if err != nil {
+		cont(nil, err)
+		return
+	}
+	request := &cluster.GrainRequest{MethodIndex: 1, MessageData: bytes}
+	ctx.AwaitFuture(ctx.RequestFuture(pid, request, opts.Timeout), func(response interface{}, err error) {
+		if err != nil {
+			cont(nil, err)
+			return
+		}
+		switch msg := response.(type) {
+		case *cluster.GrainResponse:
+			result := &Noop{}
+			err = proto.Unmarshal(msg.MessageData, result)
 			if err != nil {
-				return nil, err
-			}
-			switch msg := response.(type) {
-			case *cluster.GrainResponse:
-				result := &TotalsResponse{}
-				err = proto.Unmarshal(msg.MessageData, result)
-				if err != nil {
-					return nil, err
-				}
-				return result, nil
-			case *cluster.GrainErrorResponse:
-				return nil, errors.New(msg.Err)
-			default:
-				return nil, errors.New("unknown response")
-			}
+				cont(nil, err)
+				return
+			}
+			cont(result, nil)
+		case *cluster.GrainErrorResponse:
+			cont(nil, errors.New(msg.Err))
+		default:
+			cont(nil, errors.New("unknown response"))
 		}
+	})
+}
 	
-	var res *TotalsResponse
-	var err error
-	for i := 0; i < opts.RetryCount; i++ {
-		res, err = fun()
-		if err == nil || err.Error() != "future: timeout" {
-			return res, err
-		} else if opts.RetryAction != nil {
-				opts.RetryAction(i)
-		}
+// BroadcastGetCounts requests the execution on to the cluster until ctx is done, using the options of the kind changed by opts
+func (g *TrackerGrain) BroadcastGetCounts(ctx context.Context, r *Noop, opts ...cluster.GrainCallOption) (*TotalsResponse, error) {
+	res := &TotalsResponse{}
+	err := cluster.CallGrain(ctx, g.ID, "Tracker", 2, r, res, cluster.ApplyGrainCallOptions("Tracker", opts...))
+	if err != nil {
+		return nil, err
 	}
-	return nil, err
+	return res, nil
+}
+
+// BroadcastGetCountsWithOpts requests the execution on to the cluster
+func (g *TrackerGrain) BroadcastGetCountsWithOpts(r *Noop, opts *cluster.GrainCallOptions) (*TotalsResponse, error) {
+	return g.BroadcastGetCounts(context.Background(), r, cluster.WithCallOptions(opts))
 }
 
-// BroadcastGetCountsChan allows to use a channel to execute the method using default options
+// BroadcastGetCountsChan allows to use a channel to execute the method using the options of the kind
 func (g *TrackerGrain) BroadcastGetCountsChan(r *Noop) (<-chan *TotalsResponse, <-chan error) {
-	return g.BroadcastGetCountsChanWithOpts(r, cluster.DefaultGrainCallOptions())
+	return g.BroadcastGetCountsChanWithOpts(r, cluster.GrainCallOptionsForKind("Tracker"))
 }
 
 // BroadcastGetCountsChanWithOpts allows to use a channel to execute the method
@@ -596,6 +624,47 @@ func (g *TrackerGrain) BroadcastGetCountsChanWithOpts(r *Noop, opts *cluster.Gra
 	}()
 	return c, e
 }
+
+// BroadcastGetCountsReenter requests the execution on to the cluster from a grain using the options of the kind,
+// the calling grain processes other messages until cont is called with the result
+func (g *TrackerGrain) BroadcastGetCountsReenter(ctx cluster.GrainContext, r *Noop, cont func(*TotalsResponse, error)) {
+	g.BroadcastGetCountsReenterWithOpts(ctx, r, cluster.GrainCallOptionsForKind("Tracker"), cont)
+}
+
+// BroadcastGetCountsReenterWithOpts requests the execution on to the cluster from a grain, the call is not retried
+func (g *TrackerGrain) BroadcastGetCountsReenterWithOpts(ctx cluster.GrainContext, r *Noop, opts *cluster.GrainCallOptions, cont func(*TotalsResponse, error)) {
+	pid, statusCode := cluster.Get(g.ID, "Tracker")
+	if statusCode != remote.ResponseStatusCodeOK && statusCode != remote.ResponseStatusCodePROCESSNAMEALREADYEXIST {
+		cont(nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode))
+		return
+	}
+	bytes, err := proto.Marshal(r)
+	if err != nil {
+		cont(nil, err)
+		return
+	}
+	request := &cluster.GrainRequest{MethodIndex: 2, MessageData: bytes}
+	ctx.AwaitFuture(ctx.RequestFuture(pid, request, opts.Timeout), func(response interface{}, err error) {
+		if err != nil {
+			cont(nil, err)
+			return
+		}
+		switch msg := response.(type) {
+		case *cluster.GrainResponse:
+			result := &TotalsResponse{}
+			err = proto.Unmarshal(msg.MessageData, result)
+			if err != nil {
+				cont(nil, err)
+				return
+			}
+			cont(result, nil)
+		case *cluster.GrainErrorResponse:
+			cont(nil, errors.New(msg.Err))
+		default:
+			cont(nil, errors.New("unknown response"))
+		}
+	})
+}
 	
 
 // TrackerActor represents the actor structure
@@ -604,6 +673,13 @@ type TrackerActor struct {
 	Timeout *time.Duration
 }
 
+// PrePassivate gives the grain the chance to persist its state before it is passivated when it implements cluster.Passivatable
+func (a *TrackerActor) PrePassivate(ctx actor.Context) {
+	if p, ok := a.inner.(cluster.Passivatable); ok {
+		p.PrePassivate(ctx)
+	}
+}
+
 // Receive ensures the lifecycle of the actor for the received message
 func (a *TrackerActor) Receive(ctx actor.Context) {
 	switch msg := ctx.Message().(type) {
@@ -616,11 +692,21 @@ func (a *TrackerActor) Receive(ctx actor.Context) {
 		}
 	case *actor.ReceiveTimeout:
 		a.inner.Terminate()
-		rootContext.PoisonFuture(ctx.Self()).Wait()
+		ctx.Self().Poison()
 
 	case actor.AutoReceiveMessage: // pass
 	case actor.SystemMessage: // pass
 
+	case *cluster.ReminderFired:
+		if r, ok := a.inner.(cluster.Remindable); ok {
+			r.ReceiveReminder(msg.Name, ctx)
+		}
+
+	case *cluster.Handoff:
+		if h, ok := a.inner.(cluster.Handoffable); ok {
+			h.Handoff(ctx)
+		}
+
 	case *cluster.GrainRequest:
 		switch msg.MethodIndex {
 			
@@ -628,61 +714,44 @@ func (a *TrackerActor) Receive(ctx actor.Context) {
 			req := &RegisterMessage{}
 			err := proto.Unmarshal(msg.MessageData, req)
 			if err != nil {
-				log.Fatalf("[GRAIN] proto.Unmarshal failed %v", err)
+				cluster.RespondGrain(ctx, nil, err)
+				return
 			}
 			r0, err := a.inner.RegisterGrain(req, ctx)
-			if err == nil {
-				bytes, errMarshal := proto.Marshal(r0)
-				if errMarshal != nil {
-					log.Fatalf("[GRAIN] proto.Marshal failed %v", errMarshal)
-				}
-				resp := &cluster.GrainResponse{MessageData: bytes}
-				ctx.Respond(resp)
-			} else {
-				resp := &cluster.GrainErrorResponse{Err: err.Error()}
-				ctx.Respond(resp)
+			if err != cluster.ErrResponseDeferred {
+				cluster.RespondGrain(ctx, r0, err)
 			}
 			
 		case 1:
 			req := &RegisterMessage{}
 			err := proto.Unmarshal(msg.MessageData, req)
 			if err != nil {
-				log.Fatalf("[GRAIN] proto.Unmarshal failed %v", err)
+				cluster.RespondGrain(ctx, nil, err)
+				return
 			}
 			r0, err := a.inner.DeregisterGrain(req, ctx)
-			if err == nil {
-				bytes, errMarshal := proto.Marshal(r0)
-				if errMarshal != nil {
-					log.Fatalf("[GRAIN] proto.Marshal failed %v", errMarshal)
-				}
-				resp := &cluster.GrainResponse{MessageData: bytes}
-				ctx.Respond(resp)
-			} else {
-				resp := &cluster.GrainErrorResponse{Err: err.Error()}
-				ctx.Respond(resp)
+			if err != cluster.ErrResponseDeferred {
+				cluster.RespondGrain(ctx, r0, err)
 			}
 			
 		case 2:
 			req := &Noop{}
 			err := proto.Unmarshal(msg.MessageData, req)
 			if err != nil {
-				log.Fatalf("[GRAIN] proto.Unmarshal failed %v", err)
+				cluster.RespondGrain(ctx, nil, err)
+				return
 			}
 			r0, err := a.inner.BroadcastGetCounts(req, ctx)
-			if err == nil {
-				bytes, errMarshal := proto.Marshal(r0)
-				if errMarshal != nil {
-					log.Fatalf("[GRAIN] proto.Marshal failed %v", errMarshal)
-				}
-				resp := &cluster.GrainResponse{MessageData: bytes}
-				ctx.Respond(resp)
-			} else {
-				resp := &cluster.GrainErrorResponse{Err: err.Error()}
-				ctx.Respond(resp)
+			if err != cluster.ErrResponseDeferred {
+				cluster.RespondGrain(ctx, r0, err)
 			}
 		
 		}
 	default:
+		if b, ok := a.inner.(cluster.BroadcastReceiver); ok {
+			b.ReceiveBroadcast(msg, ctx)
+			return
+		}
 		log.Printf("Unknown message %v", msg)
 	}
 }
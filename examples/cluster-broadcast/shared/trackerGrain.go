@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"context"
 	"fmt"
 	"github.com/AsynkronIT/protoactor-go/cluster"
 )
@@ -33,7 +34,7 @@ func (t *TrackGrain) BroadcastGetCounts(n *Noop, ctx cluster.GrainContext) (*Tot
 	totals := map[string]int64{}
 	for grainAddress, _ := range t.grainsMap {
 		calcGrain := GetCalculatorGrain(grainAddress)
-		grainTotal, err := calcGrain.GetCurrent(&Noop{})
+		grainTotal, err := calcGrain.GetCurrent(context.Background(), &Noop{})
 		if err != nil {
 			fmt.Sprintf("Grain %s issued an error : %s", grainAddress, err)
 		}
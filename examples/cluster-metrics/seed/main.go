@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 
 	console "github.com/AsynkronIT/goconsole"
@@ -39,7 +40,7 @@ func main() {
 
 	hello := shared.GetHelloGrain("MyGrain")
 
-	res, err := hello.SayHello(&shared.HelloRequest{Name: "Roger"})
+	res, err := hello.SayHello(context.Background(), &shared.HelloRequest{Name: "Roger"})
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -44,7 +45,7 @@ func sync() {
 	log.Printf("Message from SayHello: %v", res.Message)
 	for i := 0; i < 10000; i++ {
 		x := shared.GetHelloGrain(fmt.Sprintf("hello%v", i))
-		x.SayHello(&shared.HelloRequest{Name: "GAM"})
+		x.SayHello(context.Background(), &shared.HelloRequest{Name: "GAM"})
 	}
 	log.Println("Done")
 }
@@ -2,6 +2,7 @@
 package shared
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -45,63 +46,42 @@ type Hello interface {
 		
 }
 
+// HelloClient calls a Hello grain, depend on it rather than on HelloGrain to replace the grain by a mock in tests
+type HelloClient interface {
+		
+	SayHello(ctx context.Context, r *HelloRequest, opts ...cluster.GrainCallOption) (*HelloResponse, error)
+		
+	Add(ctx context.Context, r *AddRequest, opts ...cluster.GrainCallOption) (*AddResponse, error)
+		
+	VoidFunc(ctx context.Context, r *AddRequest, opts ...cluster.GrainCallOption) (*Unit, error)
+		
+}
+
+var _ HelloClient = (*HelloGrain)(nil)
+
 // HelloGrain holds the base data for the HelloGrain
 type HelloGrain struct {
 	ID string
 }
 	
-// SayHello requests the execution on to the cluster using default options
-func (g *HelloGrain) SayHello(r *HelloRequest) (*HelloResponse, error) {
-	return g.SayHelloWithOpts(r, cluster.DefaultGrainCallOptions())
+// SayHello requests the execution on to the cluster until ctx is done, using the options of the kind changed by opts
+func (g *HelloGrain) SayHello(ctx context.Context, r *HelloRequest, opts ...cluster.GrainCallOption) (*HelloResponse, error) {
+	res := &HelloResponse{}
+	err := cluster.CallGrain(ctx, g.ID, "Hello", 0, r, res, cluster.ApplyGrainCallOptions("Hello", opts...))
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 // SayHelloWithOpts requests the execution on to the cluster
 func (g *HelloGrain) SayHelloWithOpts(r *HelloRequest, opts *cluster.GrainCallOptions) (*HelloResponse, error) {
-	fun := func() (*HelloResponse, error) {
-			pid, statusCode := cluster.Get(g.ID, "Hello")
-			if statusCode != remote.ResponseStatusCodeOK {
-				return nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode)
-			}
-			bytes, err := proto.Marshal(r)
-			if err != nil {
-				return nil, err
-			}
-			request := &cluster.GrainRequest{MethodIndex: 0, MessageData: bytes}
-			response, err := rootContext.RequestFuture(pid, request, opts.Timeout).Result()
-			if err != nil {
-				return nil, err
-			}
-			switch msg := response.(type) {
-			case *cluster.GrainResponse:
-				result := &HelloResponse{}
-				err = proto.Unmarshal(msg.MessageData, result)
-				if err != nil {
-					return nil, err
-				}
-				return result, nil
-			case *cluster.GrainErrorResponse:
-				return nil, errors.New(msg.Err)
-			default:
-				return nil, errors.New("unknown response")
-			}
-		}
-	
-	var res *HelloResponse
-	var err error
-	for i := 0; i < opts.RetryCount; i++ {
-		res, err = fun()
-		if err == nil || err.Error() != "future: timeout" {
-			return res, err
-		} else if opts.RetryAction != nil {
-				opts.RetryAction(i)
-		}
-	}
-	return nil, err
+	return g.SayHello(context.Background(), r, cluster.WithCallOptions(opts))
 }
 
-// SayHelloChan allows to use a channel to execute the method using default options
+// SayHelloChan allows to use a channel to execute the method using the options of the kind
 func (g *HelloGrain) SayHelloChan(r *HelloRequest) (<-chan *HelloResponse, <-chan error) {
-	return g.SayHelloChanWithOpts(r, cluster.DefaultGrainCallOptions())
+	return g.SayHelloChanWithOpts(r, cluster.GrainCallOptionsForKind("Hello"))
 }
 
 // SayHelloChanWithOpts allows to use a channel to execute the method
@@ -120,59 +100,66 @@ func (g *HelloGrain) SayHelloChanWithOpts(r *HelloRequest, opts *cluster.GrainCa
 	}()
 	return c, e
 }
-	
-// Add requests the execution on to the cluster using default options
-func (g *HelloGrain) Add(r *AddRequest) (*AddResponse, error) {
-	return g.AddWithOpts(r, cluster.DefaultGrainCallOptions())
+
+// SayHelloReenter requests the execution on to the cluster from a grain using the options of the kind,
+// the calling grain processes other messages until cont is called with the result
+func (g *HelloGrain) SayHelloReenter(ctx cluster.GrainContext, r *HelloRequest, cont func(*HelloResponse, error)) {
+	g.SayHelloReenterWithOpts(ctx, r, cluster.GrainCallOptionsForKind("Hello"), cont)
 }
 
-// AddWithOpts requests the execution on to the cluster
-func (g *HelloGrain) AddWithOpts(r *AddRequest, opts *cluster.GrainCallOptions) (*AddResponse, error) {
-	fun := func() (*AddResponse, error) {
-			pid, statusCode := cluster.Get(g.ID, "Hello")
-			if statusCode != remote.ResponseStatusCodeOK {
-				return nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode)
-			}
-			bytes, err := proto.Marshal(r)
-			if err != nil {
-				return nil, err
-			}
-			request := &cluster.GrainRequest{MethodIndex: 1, MessageData: bytes}
-			response, err := rootContext.RequestFuture(pid, request, opts.Timeout).Result()
+// SayHelloReenterWithOpts requests the execution on to the cluster from a grain, the call is not retried
+func (g *HelloGrain) SayHelloReenterWithOpts(ctx cluster.GrainContext, r *HelloRequest, opts *cluster.GrainCallOptions, cont func(*HelloResponse, error)) {
+	pid, statusCode := cluster.Get(g.ID, "Hello")
+	if statusCode != remote.ResponseStatusCodeOK && statusCode != remote.ResponseStatusCodePROCESSNAMEALREADYEXIST {
+		cont(nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode))
+		return
+	}
+	bytes, err := proto.Marshal(r)
+	if err != nil {
+		cont(nil, err)
+		return
+	}
+	request := &cluster.GrainRequest{MethodIndex: 0, MessageData: bytes}
+	ctx.AwaitFuture(ctx.RequestFuture(pid, request, opts.Timeout), func(response interface{}, err error) {
+		if err != nil {
+			cont(nil, err)
+			return
+		}
+		switch msg := response.(type) {
+		case *cluster.GrainResponse:
+			result := &HelloResponse{}
+			err = proto.Unmarshal(msg.MessageData, result)
 			if err != nil {
-				return nil, err
-			}
-			switch msg := response.(type) {
-			case *cluster.GrainResponse:
-				result := &AddResponse{}
-				err = proto.Unmarshal(msg.MessageData, result)
-				if err != nil {
-					return nil, err
-				}
-				return result, nil
-			case *cluster.GrainErrorResponse:
-				return nil, errors.New(msg.Err)
-			default:
-				return nil, errors.New("unknown response")
+				cont(nil, err)
+				return
 			}
+			cont(result, nil)
+		case *cluster.GrainErrorResponse:
+			cont(nil, errors.New(msg.Err))
+		default:
+			cont(nil, errors.New("unknown response"))
 		}
+	})
+}
 	
-	var res *AddResponse
-	var err error
-	for i := 0; i < opts.RetryCount; i++ {
-		res, err = fun()
-		if err == nil || err.Error() != "future: timeout" {
-			return res, err
-		} else if opts.RetryAction != nil {
-				opts.RetryAction(i)
-		}
+// Add requests the execution on to the cluster until ctx is done, using the options of the kind changed by opts
+func (g *HelloGrain) Add(ctx context.Context, r *AddRequest, opts ...cluster.GrainCallOption) (*AddResponse, error) {
+	res := &AddResponse{}
+	err := cluster.CallGrain(ctx, g.ID, "Hello", 1, r, res, cluster.ApplyGrainCallOptions("Hello", opts...))
+	if err != nil {
+		return nil, err
 	}
-	return nil, err
+	return res, nil
 }
 
-// AddChan allows to use a channel to execute the method using default options
+// AddWithOpts requests the execution on to the cluster
+func (g *HelloGrain) AddWithOpts(r *AddRequest, opts *cluster.GrainCallOptions) (*AddResponse, error) {
+	return g.Add(context.Background(), r, cluster.WithCallOptions(opts))
+}
+
+// AddChan allows to use a channel to execute the method using the options of the kind
 func (g *HelloGrain) AddChan(r *AddRequest) (<-chan *AddResponse, <-chan error) {
-	return g.AddChanWithOpts(r, cluster.DefaultGrainCallOptions())
+	return g.AddChanWithOpts(r, cluster.GrainCallOptionsForKind("Hello"))
 }
 
 // AddChanWithOpts allows to use a channel to execute the method
@@ -191,59 +178,66 @@ func (g *HelloGrain) AddChanWithOpts(r *AddRequest, opts *cluster.GrainCallOptio
 	}()
 	return c, e
 }
-	
-// VoidFunc requests the execution on to the cluster using default options
-func (g *HelloGrain) VoidFunc(r *AddRequest) (*Unit, error) {
-	return g.VoidFuncWithOpts(r, cluster.DefaultGrainCallOptions())
+
+// AddReenter requests the execution on to the cluster from a grain using the options of the kind,
+// the calling grain processes other messages until cont is called with the result
+func (g *HelloGrain) AddReenter(ctx cluster.GrainContext, r *AddRequest, cont func(*AddResponse, error)) {
+	g.AddReenterWithOpts(ctx, r, cluster.GrainCallOptionsForKind("Hello"), cont)
 }
 
-// VoidFuncWithOpts requests the execution on to the cluster
-func (g *HelloGrain) VoidFuncWithOpts(r *AddRequest, opts *cluster.GrainCallOptions) (*Unit, error) {
-	fun := func() (*Unit, error) {
-			pid, statusCode := cluster.Get(g.ID, "Hello")
-			if statusCode != remote.ResponseStatusCodeOK {
-				return nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode)
-			}
-			bytes, err := proto.Marshal(r)
-			if err != nil {
-				return nil, err
-			}
-			request := &cluster.GrainRequest{MethodIndex: 2, MessageData: bytes}
-			response, err := rootContext.RequestFuture(pid, request, opts.Timeout).Result()
+// AddReenterWithOpts requests the execution on to the cluster from a grain, the call is not retried
+func (g *HelloGrain) AddReenterWithOpts(ctx cluster.GrainContext, r *AddRequest, opts *cluster.GrainCallOptions, cont func(*AddResponse, error)) {
+	pid, statusCode := cluster.Get(g.ID, "Hello")
+	if statusCode != remote.ResponseStatusCodeOK && statusCode != remote.ResponseStatusCodePROCESSNAMEALREADYEXIST {
+		cont(nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode))
+		return
+	}
+	bytes, err := proto.Marshal(r)
+	if err != nil {
+		cont(nil, err)
+		return
+	}
+	request := &cluster.GrainRequest{MethodIndex: 1, MessageData: bytes}
+	ctx.AwaitFuture(ctx.RequestFuture(pid, request, opts.Timeout), func(response interface{}, err error) {
+		if err != nil {
+			cont(nil, err)
+			return
+		}
+		switch msg := response.(type) {
+		case *cluster.GrainResponse:
+			result := &AddResponse{}
+			err = proto.Unmarshal(msg.MessageData, result)
 			if err != nil {
-				return nil, err
-			}
-			switch msg := response.(type) {
-			case *cluster.GrainResponse:
-				result := &Unit{}
-				err = proto.Unmarshal(msg.MessageData, result)
-				if err != nil {
-					return nil, err
-				}
-				return result, nil
-			case *cluster.GrainErrorResponse:
-				return nil, errors.New(msg.Err)
-			default:
-				return nil, errors.New("unknown response")
+				cont(nil, err)
+				return
 			}
+			cont(result, nil)
+		case *cluster.GrainErrorResponse:
+			cont(nil, errors.New(msg.Err))
+		default:
+			cont(nil, errors.New("unknown response"))
 		}
+	})
+}
 	
-	var res *Unit
-	var err error
-	for i := 0; i < opts.RetryCount; i++ {
-		res, err = fun()
-		if err == nil || err.Error() != "future: timeout" {
-			return res, err
-		} else if opts.RetryAction != nil {
-				opts.RetryAction(i)
-		}
+// VoidFunc requests the execution on to the cluster until ctx is done, using the options of the kind changed by opts
+func (g *HelloGrain) VoidFunc(ctx context.Context, r *AddRequest, opts ...cluster.GrainCallOption) (*Unit, error) {
+	res := &Unit{}
+	err := cluster.CallGrain(ctx, g.ID, "Hello", 2, r, res, cluster.ApplyGrainCallOptions("Hello", opts...))
+	if err != nil {
+		return nil, err
 	}
-	return nil, err
+	return res, nil
 }
 
-// VoidFuncChan allows to use a channel to execute the method using default options
+// VoidFuncWithOpts requests the execution on to the cluster
+func (g *HelloGrain) VoidFuncWithOpts(r *AddRequest, opts *cluster.GrainCallOptions) (*Unit, error) {
+	return g.VoidFunc(context.Background(), r, cluster.WithCallOptions(opts))
+}
+
+// VoidFuncChan allows to use a channel to execute the method using the options of the kind
 func (g *HelloGrain) VoidFuncChan(r *AddRequest) (<-chan *Unit, <-chan error) {
-	return g.VoidFuncChanWithOpts(r, cluster.DefaultGrainCallOptions())
+	return g.VoidFuncChanWithOpts(r, cluster.GrainCallOptionsForKind("Hello"))
 }
 
 // VoidFuncChanWithOpts allows to use a channel to execute the method
@@ -262,6 +256,47 @@ func (g *HelloGrain) VoidFuncChanWithOpts(r *AddRequest, opts *cluster.GrainCall
 	}()
 	return c, e
 }
+
+// VoidFuncReenter requests the execution on to the cluster from a grain using the options of the kind,
+// the calling grain processes other messages until cont is called with the result
+func (g *HelloGrain) VoidFuncReenter(ctx cluster.GrainContext, r *AddRequest, cont func(*Unit, error)) {
+	g.VoidFuncReenterWithOpts(ctx, r, cluster.GrainCallOptionsForKind("Hello"), cont)
+}
+
+// VoidFuncReenterWithOpts requests the execution on to the cluster from a grain, the call is not retried
+func (g *HelloGrain) VoidFuncReenterWithOpts(ctx cluster.GrainContext, r *AddRequest, opts *cluster.GrainCallOptions, cont func(*Unit, error)) {
+	pid, statusCode := cluster.Get(g.ID, "Hello")
+	if statusCode != remote.ResponseStatusCodeOK && statusCode != remote.ResponseStatusCodePROCESSNAMEALREADYEXIST {
+		cont(nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode))
+		return
+	}
+	bytes, err := proto.Marshal(r)
+	if err != nil {
+		cont(nil, err)
+		return
+	}
+	request := &cluster.GrainRequest{MethodIndex: 2, MessageData: bytes}
+	ctx.AwaitFuture(ctx.RequestFuture(pid, request, opts.Timeout), func(response interface{}, err error) {
+		if err != nil {
+			cont(nil, err)
+			return
+		}
+		switch msg := response.(type) {
+		case *cluster.GrainResponse:
+			result := &Unit{}
+			err = proto.Unmarshal(msg.MessageData, result)
+			if err != nil {
+				cont(nil, err)
+				return
+			}
+			cont(result, nil)
+		case *cluster.GrainErrorResponse:
+			cont(nil, errors.New(msg.Err))
+		default:
+			cont(nil, errors.New("unknown response"))
+		}
+	})
+}
 	
 
 // HelloActor represents the actor structure
@@ -270,6 +305,13 @@ type HelloActor struct {
 	Timeout *time.Duration
 }
 
+// PrePassivate gives the grain the chance to persist its state before it is passivated when it implements cluster.Passivatable
+func (a *HelloActor) PrePassivate(ctx actor.Context) {
+	if p, ok := a.inner.(cluster.Passivatable); ok {
+		p.PrePassivate(ctx)
+	}
+}
+
 // Receive ensures the lifecycle of the actor for the received message
 func (a *HelloActor) Receive(ctx actor.Context) {
 	switch msg := ctx.Message().(type) {
@@ -282,11 +324,21 @@ func (a *HelloActor) Receive(ctx actor.Context) {
 		}
 	case *actor.ReceiveTimeout:
 		a.inner.Terminate()
-		rootContext.PoisonFuture(ctx.Self()).Wait()
+		ctx.Self().Poison()
 
 	case actor.AutoReceiveMessage: // pass
 	case actor.SystemMessage: // pass
 
+	case *cluster.ReminderFired:
+		if r, ok := a.inner.(cluster.Remindable); ok {
+			r.ReceiveReminder(msg.Name, ctx)
+		}
+
+	case *cluster.Handoff:
+		if h, ok := a.inner.(cluster.Handoffable); ok {
+			h.Handoff(ctx)
+		}
+
 	case *cluster.GrainRequest:
 		switch msg.MethodIndex {
 			
@@ -294,61 +346,44 @@ func (a *HelloActor) Receive(ctx actor.Context) {
 			req := &HelloRequest{}
 			err := proto.Unmarshal(msg.MessageData, req)
 			if err != nil {
-				log.Fatalf("[GRAIN] proto.Unmarshal failed %v", err)
+				cluster.RespondGrain(ctx, nil, err)
+				return
 			}
 			r0, err := a.inner.SayHello(req, ctx)
-			if err == nil {
-				bytes, errMarshal := proto.Marshal(r0)
-				if errMarshal != nil {
-					log.Fatalf("[GRAIN] proto.Marshal failed %v", errMarshal)
-				}
-				resp := &cluster.GrainResponse{MessageData: bytes}
-				ctx.Respond(resp)
-			} else {
-				resp := &cluster.GrainErrorResponse{Err: err.Error()}
-				ctx.Respond(resp)
+			if err != cluster.ErrResponseDeferred {
+				cluster.RespondGrain(ctx, r0, err)
 			}
 			
 		case 1:
 			req := &AddRequest{}
 			err := proto.Unmarshal(msg.MessageData, req)
 			if err != nil {
-				log.Fatalf("[GRAIN] proto.Unmarshal failed %v", err)
+				cluster.RespondGrain(ctx, nil, err)
+				return
 			}
 			r0, err := a.inner.Add(req, ctx)
-			if err == nil {
-				bytes, errMarshal := proto.Marshal(r0)
-				if errMarshal != nil {
-					log.Fatalf("[GRAIN] proto.Marshal failed %v", errMarshal)
-				}
-				resp := &cluster.GrainResponse{MessageData: bytes}
-				ctx.Respond(resp)
-			} else {
-				resp := &cluster.GrainErrorResponse{Err: err.Error()}
-				ctx.Respond(resp)
+			if err != cluster.ErrResponseDeferred {
+				cluster.RespondGrain(ctx, r0, err)
 			}
 			
 		case 2:
 			req := &AddRequest{}
 			err := proto.Unmarshal(msg.MessageData, req)
 			if err != nil {
-				log.Fatalf("[GRAIN] proto.Unmarshal failed %v", err)
+				cluster.RespondGrain(ctx, nil, err)
+				return
 			}
 			r0, err := a.inner.VoidFunc(req, ctx)
-			if err == nil {
-				bytes, errMarshal := proto.Marshal(r0)
-				if errMarshal != nil {
-					log.Fatalf("[GRAIN] proto.Marshal failed %v", errMarshal)
-				}
-				resp := &cluster.GrainResponse{MessageData: bytes}
-				ctx.Respond(resp)
-			} else {
-				resp := &cluster.GrainErrorResponse{Err: err.Error()}
-				ctx.Respond(resp)
+			if err != cluster.ErrResponseDeferred {
+				cluster.RespondGrain(ctx, r0, err)
 			}
 		
 		}
 	default:
+		if b, ok := a.inner.(cluster.BroadcastReceiver); ok {
+			b.ReceiveBroadcast(msg, ctx)
+			return
+		}
 		log.Printf("Unknown message %v", msg)
 	}
 }
@@ -0,0 +1,259 @@
+// Package gateway maps HTTP requests to messages sent to actors, so external systems can reach actors without
+// a custom server.
+//
+// The path addresses the actor by PID or by cluster identity, the body is the JSON of a message whose type is named
+// by the Message-Type header. The type has to be a protobuf message or registered with remote.RegisterMessageType
+//
+//	POST /send/pid/{address}/{id}              sends the message, responds 202 Accepted
+//	POST /request/pid/{address}/{id}           requests the actor, responds the JSON of the response
+//	POST /send/cluster/{kind}/{identity}       sends the message to the grain
+//	POST /request/cluster/{kind}/{identity}    requests the grain
+//
+// The Request-Timeout header overrides the timeout of a request, e.g. "500ms". The HTTP headers prefixed by
+// Actor-Header- are passed as message headers without the prefix. The errors responded with Context.RespondErr
+// respond 500 Internal Server Error with the message of the error.
+//
+// Only the grains are reachable unless the gateway allows PIDs, as the system actors such as the activator would be
+// reachable by PID otherwise. The kinds of the reachable grains can be restricted too
+package gateway
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/cluster"
+	"github.com/AsynkronIT/protoactor-go/remote"
+)
+
+const (
+	// MessageTypeHeader names the type of the message in the request and of the response
+	MessageTypeHeader = "Message-Type"
+	// TimeoutHeader overrides the timeout of a request
+	TimeoutHeader = "Request-Timeout"
+	// ActorHeaderPrefix prefixes the HTTP headers passed as message headers
+	ActorHeaderPrefix = "Actor-Header-"
+)
+
+// DefaultTimeout is the timeout of the requests without Request-Timeout header
+const DefaultTimeout = 5 * time.Second
+
+// DefaultMaxBodySize is the maximum size of the request bodies
+const DefaultMaxBodySize = 1 << 20
+
+var rootContext = actor.EmptyRootContext
+
+// clusterGet resolves cluster identities, tests replace it
+var clusterGet = cluster.Get
+
+// Gateway is an http.Handler sending the messages of the HTTP requests to actors
+type Gateway struct {
+	// Timeout is the timeout of the requests without Request-Timeout header, DefaultTimeout unless changed
+	Timeout time.Duration
+	// MaxTimeout caps the timeout requested by the Request-Timeout header, no cap when zero
+	MaxTimeout time.Duration
+	// MaxBodySize caps the size of the request bodies, DefaultMaxBodySize unless changed
+	MaxBodySize int64
+	// AllowPID returns whether the actor is reachable by PID, no actor is when nil
+	AllowPID func(pid *actor.PID) bool
+	// Kinds are the kinds of the grains reachable by cluster identity, the grains of all kinds are when empty
+	Kinds []string
+}
+
+// New returns a gateway, serve it with http.ListenAndServe or mount it with http.StripPrefix
+func New() *Gateway {
+	return &Gateway{Timeout: DefaultTimeout, MaxBodySize: DefaultMaxBodySize}
+}
+
+// AllowPIDs returns an AllowPID function allowing the local actors with the given ids
+func AllowPIDs(ids ...string) func(pid *actor.PID) bool {
+	allowed := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		allowed[id] = true
+	}
+	return func(pid *actor.PID) bool {
+		return pid.Address == actor.ProcessRegistry.Address && allowed[pid.Id]
+	}
+}
+
+// httpError is an error with the HTTP status to respond
+type httpError struct {
+	status  int
+	message string
+}
+
+func (e *httpError) Error() string {
+	return e.message
+}
+
+func errorf(status int, format string, args ...interface{}) *httpError {
+	return &httpError{status: status, message: fmt.Sprintf(format, args...)}
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := g.serve(w, r); err != nil {
+		http.Error(w, err.message, err.status)
+	}
+}
+
+func (g *Gateway) serve(w http.ResponseWriter, r *http.Request) *httpError {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		return errorf(http.StatusMethodNotAllowed, "method %v not allowed", r.Method)
+	}
+
+	verb, target, err := parsePath(r.URL.Path)
+	if err != nil {
+		return err
+	}
+	if err := g.authorize(target); err != nil {
+		return err
+	}
+	pid, err := target.resolve()
+	if err != nil {
+		return err
+	}
+	envelope, err := readMessage(http.MaxBytesReader(w, r.Body, g.MaxBodySize), r.Header)
+	if err != nil {
+		return err
+	}
+
+	if verb == "send" {
+		rootContext.Send(pid, envelope)
+		w.WriteHeader(http.StatusAccepted)
+		return nil
+	}
+
+	timeout, err := g.timeout(r)
+	if err != nil {
+		return err
+	}
+	future := actor.NewFuture(timeout)
+	envelope.Sender = future.PID()
+	rootContext.Send(pid, envelope)
+	e := future.Wait()
+	if e == actor.ErrTimeout {
+		return errorf(http.StatusGatewayTimeout, "%v did not respond within %v", pid, timeout)
+	}
+	if e != nil {
+		return errorf(http.StatusBadGateway, "%v", e)
+	}
+	res, e := actor.ResultAs[interface{}](future)
+	if e != nil {
+		return errorf(http.StatusInternalServerError, "%v", e)
+	}
+	return writeMessage(w, res)
+}
+
+// target is the actor addressed by the path, either a PID or a cluster identity
+type target struct {
+	pid      *actor.PID
+	kind     string
+	identity string
+}
+
+func parsePath(path string) (string, *target, *httpError) {
+	parts := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 4)
+	if len(parts) != 4 || (parts[0] != "send" && parts[0] != "request") || parts[2] == "" || parts[3] == "" {
+		return "", nil, errorf(http.StatusNotFound, "expected /send or /request followed by /pid/{address}/{id} or /cluster/{kind}/{identity}")
+	}
+	switch parts[1] {
+	case "pid":
+		return parts[0], &target{pid: actor.NewPID(parts[2], parts[3])}, nil
+	case "cluster":
+		return parts[0], &target{kind: parts[2], identity: parts[3]}, nil
+	default:
+		return "", nil, errorf(http.StatusNotFound, "unknown target %v, expected pid or cluster", parts[1])
+	}
+}
+
+// authorize checks the target is reachable through the gateway
+func (g *Gateway) authorize(t *target) *httpError {
+	if t.pid != nil {
+		if g.AllowPID == nil || !g.AllowPID(t.pid) {
+			return errorf(http.StatusForbidden, "%v is not reachable", t.pid)
+		}
+		return nil
+	}
+	if len(g.Kinds) == 0 {
+		return nil
+	}
+	for _, kind := range g.Kinds {
+		if kind == t.kind {
+			return nil
+		}
+	}
+	return errorf(http.StatusForbidden, "kind %v is not reachable", t.kind)
+}
+
+func (t *target) resolve() (*actor.PID, *httpError) {
+	if t.pid != nil {
+		return t.pid, nil
+	}
+	pid, status := clusterGet(t.identity, t.kind)
+	if status != remote.ResponseStatusCodeOK && status != remote.ResponseStatusCodePROCESSNAMEALREADYEXIST {
+		return nil, errorf(http.StatusServiceUnavailable, "%v of kind %v is not available: %v", t.identity, t.kind, status)
+	}
+	return pid, nil
+}
+
+func readMessage(body io.Reader, header http.Header) (*actor.MessageEnvelope, *httpError) {
+	typeName := header.Get(MessageTypeHeader)
+	if typeName == "" {
+		return nil, errorf(http.StatusBadRequest, "missing %v header", MessageTypeHeader)
+	}
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		// MaxBytesReader fails the reads of the bodies larger than the maximum size
+		return nil, errorf(http.StatusRequestEntityTooLarge, "%v", err)
+	}
+	message, err := remote.Deserialize(data, typeName, remote.JsonSerializerID)
+	if err != nil {
+		return nil, errorf(http.StatusBadRequest, "invalid %v: %v", typeName, err)
+	}
+	// the JSON serializer passes the messages of unknown types as JsonMessage
+	if _, ok := message.(*remote.JsonMessage); ok {
+		return nil, errorf(http.StatusBadRequest, "unknown message type %v", typeName)
+	}
+
+	envelope := &actor.MessageEnvelope{Message: message}
+	for key, values := range header {
+		if strings.HasPrefix(key, ActorHeaderPrefix) && len(values) > 0 {
+			if envelope.Header == nil {
+				envelope.Header = make(map[string]string)
+			}
+			envelope.Header[strings.TrimPrefix(key, ActorHeaderPrefix)] = values[0]
+		}
+	}
+	return envelope, nil
+}
+
+func (g *Gateway) timeout(r *http.Request) (time.Duration, *httpError) {
+	header := r.Header.Get(TimeoutHeader)
+	if header == "" {
+		return g.Timeout, nil
+	}
+	timeout, err := time.ParseDuration(header)
+	if err != nil || timeout <= 0 {
+		return 0, errorf(http.StatusBadRequest, "invalid %v %v", TimeoutHeader, header)
+	}
+	if g.MaxTimeout > 0 && timeout > g.MaxTimeout {
+		timeout = g.MaxTimeout
+	}
+	return timeout, nil
+}
+
+func writeMessage(w http.ResponseWriter, message interface{}) *httpError {
+	data, typeName, err := remote.Serialize(message, remote.JsonSerializerID)
+	if err != nil {
+		return errorf(http.StatusInternalServerError, "cannot serialize the response %T: %v", message, err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set(MessageTypeHeader, typeName)
+	w.Write(data)
+	return nil
+}
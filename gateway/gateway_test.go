@@ -0,0 +1,171 @@
+package gateway
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/cluster"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	"github.com/stretchr/testify/assert"
+)
+
+type greeting struct {
+	Name string `json:"name"`
+}
+
+func init() {
+	remote.RegisterMessageTypeWithName("gateway.greeting", &greeting{})
+}
+
+// spawnGreeter spawns an actor responding greetings and passing the headers of the messages it receives to headers
+func spawnGreeter(headers chan map[string]string) *actor.PID {
+	return rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		msg, ok := ctx.Message().(*greeting)
+		if !ok {
+			return
+		}
+		if header := ctx.MessageHeader(); header != nil {
+			headers <- header.ToMap()
+		}
+		if msg.Name == "nobody" {
+			ctx.RespondErr(errors.New("nobody to greet"))
+			return
+		}
+		if msg.Name != "silent" && ctx.Sender() != nil {
+			ctx.Respond(&greeting{Name: "hello " + msg.Name})
+		}
+	}))
+}
+
+// allowing returns a gateway allowing the actor to be reached by PID
+func allowing(pid *actor.PID) *Gateway {
+	g := New()
+	g.AllowPID = AllowPIDs(pid.Id)
+	return g
+}
+
+func post(g *Gateway, path string, body string, header map[string]string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	for key, value := range header {
+		r.Header.Set(key, value)
+	}
+	w := httptest.NewRecorder()
+	g.ServeHTTP(w, r)
+	return w
+}
+
+func TestGateway_request(t *testing.T) {
+	pid := spawnGreeter(make(chan map[string]string, 1))
+	defer rootContext.Stop(pid)
+
+	w := post(allowing(pid), "/request/pid/"+pid.String(), `{"name":"world"}`, map[string]string{MessageTypeHeader: "gateway.greeting"})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "gateway.greeting", w.Header().Get(MessageTypeHeader))
+	body, _ := ioutil.ReadAll(w.Body)
+	assert.JSONEq(t, `{"name":"hello world"}`, string(body))
+
+	w = post(allowing(pid), "/request/pid/"+pid.String(), `{"name":"silent"}`, map[string]string{
+		MessageTypeHeader: "gateway.greeting",
+		TimeoutHeader:     "10ms",
+	})
+	assert.Equal(t, http.StatusGatewayTimeout, w.Code)
+}
+
+func TestGateway_request_error(t *testing.T) {
+	pid := spawnGreeter(make(chan map[string]string, 1))
+	defer rootContext.Stop(pid)
+
+	w := post(allowing(pid), "/request/pid/"+pid.String(), `{"name":"nobody"}`, map[string]string{MessageTypeHeader: "gateway.greeting"})
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	body, _ := ioutil.ReadAll(w.Body)
+	assert.Equal(t, "nobody to greet\n", string(body))
+}
+
+func TestGateway_send_with_headers(t *testing.T) {
+	headers := make(chan map[string]string, 1)
+	pid := spawnGreeter(headers)
+	defer rootContext.Stop(pid)
+
+	w := post(allowing(pid), "/send/pid/"+pid.String(), `{"name":"world"}`, map[string]string{
+		MessageTypeHeader:              "gateway.greeting",
+		ActorHeaderPrefix + "Trace-Id": "42",
+	})
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.Equal(t, map[string]string{"Trace-Id": "42"}, <-headers)
+}
+
+func TestGateway_cluster(t *testing.T) {
+	pid := spawnGreeter(make(chan map[string]string, 10))
+	defer rootContext.Stop(pid)
+	defer func() { clusterGet = cluster.Get }()
+	clusterGet = func(name string, kind string) (*actor.PID, remote.ResponseStatusCode) {
+		if kind == "greeter" && name == "world" {
+			return pid, remote.ResponseStatusCodeOK
+		}
+		if kind == "greeter" && name == "again" {
+			return pid, remote.ResponseStatusCodePROCESSNAMEALREADYEXIST
+		}
+		return nil, remote.ResponseStatusCodeUNKNOWNKIND
+	}
+
+	w := post(New(), "/request/cluster/greeter/world", `{"name":"world"}`, map[string]string{MessageTypeHeader: "gateway.greeting"})
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = post(New(), "/request/cluster/greeter/again", `{"name":"world"}`, map[string]string{MessageTypeHeader: "gateway.greeting"})
+	assert.Equal(t, http.StatusOK, w.Code)
+	w = post(New(), "/request/cluster/unknown/world", `{"name":"world"}`, map[string]string{MessageTypeHeader: "gateway.greeting"})
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
+
+func TestGateway_reachable_targets(t *testing.T) {
+	pid := spawnGreeter(make(chan map[string]string, 10))
+	defer rootContext.Stop(pid)
+	defer func() { clusterGet = cluster.Get }()
+	clusterGet = func(name string, kind string) (*actor.PID, remote.ResponseStatusCode) {
+		return pid, remote.ResponseStatusCodeOK
+	}
+	header := map[string]string{MessageTypeHeader: "gateway.greeting"}
+
+	// the actors are not reachable by PID unless allowed, such as the activator
+	g := New()
+	assert.Equal(t, http.StatusForbidden, post(g, "/request/pid/"+pid.String(), `{}`, header).Code)
+	g.AllowPID = AllowPIDs(pid.Id)
+	assert.Equal(t, http.StatusOK, post(g, "/request/pid/"+pid.String(), `{}`, header).Code)
+	activator := actor.NewPID(actor.ProcessRegistry.Address, "activator")
+	assert.Equal(t, http.StatusForbidden, post(g, "/send/pid/"+activator.String(), `{}`, header).Code)
+
+	g.Kinds = []string{"greeter"}
+	assert.Equal(t, http.StatusOK, post(g, "/request/cluster/greeter/world", `{}`, header).Code)
+	assert.Equal(t, http.StatusForbidden, post(g, "/request/cluster/other/world", `{}`, header).Code)
+}
+
+func TestGateway_max_body_size(t *testing.T) {
+	pid := spawnGreeter(make(chan map[string]string, 10))
+	defer rootContext.Stop(pid)
+	g := allowing(pid)
+	g.MaxBodySize = 16
+	header := map[string]string{MessageTypeHeader: "gateway.greeting"}
+
+	assert.Equal(t, http.StatusOK, post(g, "/request/pid/"+pid.String(), `{"name":"world"}`, header).Code)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, post(g, "/request/pid/"+pid.String(), `{"name":"the whole world"}`, header).Code)
+}
+
+func TestGateway_invalid_requests(t *testing.T) {
+	g := New()
+	g.AllowPID = func(pid *actor.PID) bool { return true }
+	header := map[string]string{MessageTypeHeader: "gateway.greeting"}
+	assert.Equal(t, http.StatusNotFound, post(g, "/request/nonhost/foo", `{}`, header).Code)
+	assert.Equal(t, http.StatusBadRequest, post(g, "/send/pid/nonhost/foo", `{}`, nil).Code)
+	assert.Equal(t, http.StatusBadRequest, post(g, "/send/pid/nonhost/foo", `{}`, map[string]string{MessageTypeHeader: "unknown"}).Code)
+	assert.Equal(t, http.StatusBadRequest, post(g, "/send/pid/nonhost/foo", `{`, header).Code)
+
+	r := httptest.NewRequest(http.MethodGet, "/send/pid/nonhost/foo", nil)
+	w := httptest.NewRecorder()
+	g.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
@@ -0,0 +1,120 @@
+// Package amqp is an ingress source consuming an AMQP queue
+//
+//	ch, _ := conn.Channel()
+//	source, _ := amqp.NewSource(ch, "orders")
+//	consumer := ingress.Start(source, ingress.PID(pid), ingress.WithAckOnProcessed(5*time.Second))
+package amqp
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/AsynkronIT/protoactor-go/ingress"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	amqpgo "github.com/streadway/amqp"
+	"golang.org/x/net/context"
+)
+
+// ErrDeliveriesClosed is returned by Receive when the channel stopped delivering
+var ErrDeliveriesClosed = errors.New("amqp: deliveries closed")
+
+// contentTypes maps the content types of the deliveries to the serializers
+var contentTypes = map[string]int32{
+	"application/json":       remote.JsonSerializerID,
+	"application/protobuf":   remote.ProtoSerializerID,
+	"application/x-protobuf": remote.ProtoSerializerID,
+	"application/msgpack":    remote.MsgPackSerializerID,
+	"application/x-msgpack":  remote.MsgPackSerializerID,
+}
+
+// Source consumes the deliveries of a queue.
+// The type property of a delivery, or its Message-Type header, names its type. The Serializer-Id header or the
+// content type select its serializer. The other headers are passed as message headers, the routing key is the key
+// of the message.
+//
+// Acknowledging a message acks the delivery, a message which is not processed is rejected and requeued.
+// A message which cannot be deserialized is rejected without requeue, so it is dead-lettered when the queue has
+// a dead letter exchange
+type Source struct {
+	channel      *amqpgo.Channel
+	deliveries   <-chan amqpgo.Delivery
+	serializerID int32
+}
+
+// Option configures a Source
+type Option func(s *Source)
+
+// WithSerializerID sets the serializer of the deliveries without Serializer-Id header or known content type,
+// the default is JSON
+func WithSerializerID(serializerID int32) Option {
+	return func(s *Source) {
+		s.serializerID = serializerID
+	}
+}
+
+// NewSource starts consuming the queue on channel, which the source closes when the consumer stops
+func NewSource(channel *amqpgo.Channel, queue string, options ...Option) (*Source, error) {
+	deliveries, err := channel.Consume(queue, "", false, false, false, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &Source{channel: channel, deliveries: deliveries, serializerID: remote.JsonSerializerID}
+	for _, option := range options {
+		option(s)
+	}
+	return s, nil
+}
+
+// Receive waits for the next delivery
+func (s *Source) Receive(ctx context.Context) (*ingress.Message, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case delivery, ok := <-s.deliveries:
+		if !ok {
+			return nil, ErrDeliveriesClosed
+		}
+		msg := s.toMessage(delivery)
+		msg.Ack = func() error {
+			return delivery.Ack(false)
+		}
+		msg.Nack = func(requeue bool) error {
+			return delivery.Nack(false, requeue)
+		}
+		return msg, nil
+	}
+}
+
+// Close closes the channel, the unacknowledged deliveries are requeued by the broker
+func (s *Source) Close() error {
+	return s.channel.Close()
+}
+
+func (s *Source) toMessage(delivery amqpgo.Delivery) *ingress.Message {
+	msg := &ingress.Message{
+		TypeName:     delivery.Type,
+		SerializerID: s.serializerID,
+		Data:         delivery.Body,
+		Key:          delivery.RoutingKey,
+	}
+	if id, ok := contentTypes[delivery.ContentType]; ok {
+		msg.SerializerID = id
+	}
+	for key, value := range delivery.Headers {
+		switch key {
+		case ingress.MessageTypeHeader:
+			msg.TypeName = fmt.Sprint(value)
+		case ingress.SerializerIDHeader:
+			if id, err := strconv.Atoi(fmt.Sprint(value)); err == nil {
+				msg.SerializerID = int32(id)
+			}
+		default:
+			if msg.Header == nil {
+				msg.Header = make(map[string]string)
+			}
+			msg.Header[key] = fmt.Sprint(value)
+		}
+	}
+	return msg
+}
@@ -0,0 +1,26 @@
+package amqp
+
+import (
+	"testing"
+
+	"github.com/AsynkronIT/protoactor-go/ingress"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	amqpgo "github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSource_toMessage(t *testing.T) {
+	s := &Source{serializerID: remote.JsonSerializerID}
+
+	msg := s.toMessage(amqpgo.Delivery{Type: "orders.Order", ContentType: "application/x-protobuf", RoutingKey: "key", Body: []byte{1}})
+	assert.Equal(t, &ingress.Message{TypeName: "orders.Order", SerializerID: remote.ProtoSerializerID, Data: []byte{1}, Key: "key"}, msg)
+
+	msg = s.toMessage(amqpgo.Delivery{Type: "orders.Order", Headers: amqpgo.Table{
+		ingress.MessageTypeHeader:  "orders.Cancel",
+		ingress.SerializerIDHeader: int32(2),
+		"trace":                    "42",
+	}})
+	assert.Equal(t, "orders.Cancel", msg.TypeName)
+	assert.Equal(t, remote.MsgPackSerializerID, msg.SerializerID)
+	assert.Equal(t, map[string]string{"trace": "42"}, msg.Header)
+}
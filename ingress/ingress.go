@@ -0,0 +1,249 @@
+// Package ingress feeds the messages consumed from a broker, such as Kafka or AMQP, into actors.
+// The messages are deserialized with the remote serializers and sent to a PID or a cluster identity.
+//
+// The sources of the brokers are in the subpackages, see ingress/kafka and ingress/amqp
+package ingress
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/cluster"
+	"github.com/AsynkronIT/protoactor-go/log"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	"golang.org/x/net/context"
+)
+
+// Headers naming the type and the serializer of a message, for the sources whose brokers have message headers
+const (
+	MessageTypeHeader  = "Message-Type"
+	SerializerIDHeader = "Serializer-Id"
+)
+
+var (
+	rootContext = actor.EmptyRootContext
+	// clusterGet resolves cluster identities, tests replace it
+	clusterGet = cluster.Get
+)
+
+// Message is a message consumed from a broker
+type Message struct {
+	// TypeName and SerializerID select the remote serializer deserializing Data
+	TypeName     string
+	SerializerID int32
+	Data         []byte
+	// Header is passed as the message header
+	Header map[string]string
+	// Key is the key of the message in the broker, the identity of the grain for ClusterIdentityFromKey
+	Key string
+	// Ack acknowledges the message to the broker
+	Ack func() error
+	// Nack tells the broker the message was not processed, the broker delivers it again when requeue is true.
+	// It is nil for the brokers which cannot reject a single message, the consumer then retries delivering the message
+	// until it is processed, and acknowledges the messages which cannot be deserialized
+	Nack func(requeue bool) error
+}
+
+// Source consumes the messages of a broker
+type Source interface {
+	// Receive blocks until a message is consumed or ctx is done
+	Receive(ctx context.Context) (*Message, error)
+	// Close stops consuming
+	Close() error
+}
+
+// Target resolves the actor receiving a message
+type Target func(msg *Message) (*actor.PID, error)
+
+// PID sends all the messages to pid
+func PID(pid *actor.PID) Target {
+	return func(_ *Message) (*actor.PID, error) {
+		return pid, nil
+	}
+}
+
+// ClusterIdentity sends all the messages to the grain of the given kind and identity
+func ClusterIdentity(kind, identity string) Target {
+	return func(_ *Message) (*actor.PID, error) {
+		return getGrain(identity, kind)
+	}
+}
+
+// ClusterIdentityFromKey sends the messages to the grain of the given kind whose identity is the key of the message
+func ClusterIdentityFromKey(kind string) Target {
+	return func(msg *Message) (*actor.PID, error) {
+		return getGrain(msg.Key, kind)
+	}
+}
+
+func getGrain(identity, kind string) (*actor.PID, error) {
+	pid, status := clusterGet(identity, kind)
+	if status != remote.ResponseStatusCodeOK && status != remote.ResponseStatusCodePROCESSNAMEALREADYEXIST {
+		return nil, fmt.Errorf("ingress: %v of kind %v is not available: %v", identity, kind, status)
+	}
+	return pid, nil
+}
+
+// AckMode selects when the consumed messages are acknowledged
+type AckMode int
+
+const (
+	// AckOnEnqueue acknowledges the messages once they are sent to the actor, messages may be lost if the actor fails
+	AckOnEnqueue AckMode = iota
+	// AckOnProcessed requests the actor and acknowledges the message when the actor responds.
+	// A response that is an error, including the errors responded with Context.RespondErr, or no response within
+	// the timeout does not acknowledge the message, it is requeued or retried
+	AckOnProcessed
+)
+
+// Option configures a Consumer
+type Option func(c *Consumer)
+
+// WithAckOnProcessed acknowledges the messages when the actor responds within timeout, see AckOnProcessed
+func WithAckOnProcessed(timeout time.Duration) Option {
+	return func(c *Consumer) {
+		c.ackMode = AckOnProcessed
+		c.timeout = timeout
+	}
+}
+
+// WithRetryInterval sets the interval at which the messages of the sources which cannot requeue them are retried,
+// the default is a second
+func WithRetryInterval(interval time.Duration) Option {
+	return func(c *Consumer) {
+		c.retryInterval = interval
+	}
+}
+
+// Consumer delivers the messages of a source to actors, one message at a time in the order of the source.
+//
+// A message which cannot be deserialized is rejected without requeue, as it would fail again. A message which is
+// not delivered, e.g. because its grain is not available, or not processed is requeued, or retried by the consumer
+// for the sources which cannot requeue it
+type Consumer struct {
+	source        Source
+	target        Target
+	ackMode       AckMode
+	timeout       time.Duration
+	retryInterval time.Duration
+	cancel        context.CancelFunc
+	done          chan struct{}
+}
+
+// Start consumes the messages of source and delivers them to target until the consumer is stopped.
+// The messages are acknowledged on enqueue unless WithAckOnProcessed is given
+func Start(source Source, target Target, options ...Option) *Consumer {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &Consumer{
+		source:        source,
+		target:        target,
+		retryInterval: time.Second,
+		cancel:        cancel,
+		done:          make(chan struct{}),
+	}
+	for _, option := range options {
+		option(c)
+	}
+	go c.run(ctx)
+	return c
+}
+
+// Stop stops consuming and closes the source, it waits for the message being delivered
+func (c *Consumer) Stop() error {
+	c.cancel()
+	<-c.done
+	return c.source.Close()
+}
+
+func (c *Consumer) run(ctx context.Context) {
+	defer close(c.done)
+	for {
+		msg, err := c.source.Receive(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			plog.Error("Failed to consume", log.Error(err))
+			// do not spin on a broken source
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+		c.handle(ctx, msg)
+	}
+}
+
+// handle delivers the message and settles it
+func (c *Consumer) handle(ctx context.Context, msg *Message) {
+	message, err := remote.Deserialize(msg.Data, msg.TypeName, msg.SerializerID)
+	if err != nil {
+		plog.Error("Failed to deserialize, rejecting message", log.String("type", msg.TypeName), log.Error(err))
+		if msg.Nack != nil {
+			settle(func() error { return msg.Nack(false) })
+		} else {
+			settle(msg.Ack)
+		}
+		return
+	}
+
+	for {
+		err := c.deliver(msg, message)
+		if err == nil {
+			settle(msg.Ack)
+			return
+		}
+		plog.Error("Failed to deliver", log.String("type", msg.TypeName), log.Error(err))
+		if msg.Nack != nil {
+			settle(func() error { return msg.Nack(true) })
+			return
+		}
+		// the source cannot requeue the message, it is retried so the later messages are not acknowledged before it
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(c.retryInterval):
+		}
+	}
+}
+
+// deliver sends the message to its target, it returns an error when the message is not delivered or processed
+func (c *Consumer) deliver(msg *Message, message interface{}) error {
+	pid, err := c.target(msg)
+	if err != nil {
+		return err
+	}
+
+	envelope := &actor.MessageEnvelope{Message: message}
+	if len(msg.Header) > 0 {
+		envelope.Header = msg.Header
+	}
+	if c.ackMode == AckOnEnqueue {
+		rootContext.Send(pid, envelope)
+		return nil
+	}
+
+	future := actor.NewFuture(c.timeout)
+	envelope.Sender = future.PID()
+	rootContext.Send(pid, envelope)
+	res, err := actor.ResultAs[interface{}](future)
+	if err != nil {
+		return err
+	}
+	if err, ok := res.(error); ok {
+		return err
+	}
+	return nil
+}
+
+func settle(fn func() error) {
+	if fn == nil {
+		return
+	}
+	if err := fn(); err != nil {
+		plog.Error("Failed to settle message", log.Error(err))
+	}
+}
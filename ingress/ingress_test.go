@@ -0,0 +1,195 @@
+package ingress
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/cluster"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+type order struct {
+	ID string `json:"id"`
+}
+
+func init() {
+	remote.RegisterMessageTypeWithName("ingress.order", &order{})
+}
+
+// testSource delivers the messages sent to its channel
+type testSource struct {
+	messages chan *Message
+}
+
+func newTestSource() *testSource {
+	return &testSource{messages: make(chan *Message, 10)}
+}
+
+func (s *testSource) Receive(ctx context.Context) (*Message, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case msg := <-s.messages:
+		return msg, nil
+	}
+}
+
+func (s *testSource) Close() error {
+	return nil
+}
+
+// publish sends an order to the source and returns a channel receiving "ack", "nack" when the message is requeued
+// or "reject"
+func (s *testSource) publish(data string, header map[string]string) chan string {
+	settled := make(chan string, 1)
+	msg := s.message(data, header, settled)
+	msg.Nack = func(requeue bool) error {
+		if requeue {
+			settled <- "nack"
+		} else {
+			settled <- "reject"
+		}
+		return nil
+	}
+	s.messages <- msg
+	return settled
+}
+
+// publishWithoutNack sends an order as the sources which cannot requeue the messages
+func (s *testSource) publishWithoutNack(data string) chan string {
+	settled := make(chan string, 1)
+	s.messages <- s.message(data, nil, settled)
+	return settled
+}
+
+func (s *testSource) message(data string, header map[string]string, settled chan string) *Message {
+	return &Message{
+		TypeName:     "ingress.order",
+		SerializerID: remote.JsonSerializerID,
+		Data:         []byte(data),
+		Header:       header,
+		Key:          "key",
+		Ack:          func() error { settled <- "ack"; return nil },
+	}
+}
+
+func settled(t *testing.T, c chan string) string {
+	select {
+	case res := <-c:
+		return res
+	case <-time.After(time.Second):
+		t.Fatal("message was not settled")
+		return ""
+	}
+}
+
+// spawnOrders spawns an actor passing the orders it receives to received, it responds an error for the order "fail",
+// responds an error with RespondErr for the order "reject" and does not respond the order "slow"
+func spawnOrders(received chan *actor.MessageEnvelope) *actor.PID {
+	return rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		msg, ok := ctx.Message().(*order)
+		if !ok {
+			return
+		}
+		envelope := &actor.MessageEnvelope{Message: msg}
+		if header := ctx.MessageHeader(); header != nil {
+			envelope.Header = header.ToMap()
+		}
+		received <- envelope
+		switch msg.ID {
+		case "fail":
+			ctx.Respond(errors.New("failed"))
+		case "reject":
+			ctx.RespondErr(errors.New("rejected"))
+		case "slow":
+		default:
+			ctx.Respond(msg)
+		}
+	}))
+}
+
+func TestConsumer_ack_on_enqueue(t *testing.T) {
+	received := make(chan *actor.MessageEnvelope, 10)
+	pid := spawnOrders(received)
+	defer rootContext.Stop(pid)
+	source := newTestSource()
+	consumer := Start(source, PID(pid))
+	defer consumer.Stop()
+
+	assert.Equal(t, "ack", settled(t, source.publish(`{"id":"1"}`, map[string]string{"trace": "42"})))
+	envelope := <-received
+	assert.Equal(t, &order{ID: "1"}, envelope.Message)
+	assert.Equal(t, "42", envelope.GetHeader("trace"))
+
+	assert.Equal(t, "reject", settled(t, source.publish(`{`, nil)))
+}
+
+func TestConsumer_ack_on_processed(t *testing.T) {
+	received := make(chan *actor.MessageEnvelope, 10)
+	pid := spawnOrders(received)
+	defer rootContext.Stop(pid)
+	source := newTestSource()
+	consumer := Start(source, PID(pid), WithAckOnProcessed(50*time.Millisecond))
+	defer consumer.Stop()
+
+	assert.Equal(t, "ack", settled(t, source.publish(`{"id":"1"}`, nil)))
+	assert.Equal(t, "nack", settled(t, source.publish(`{"id":"fail"}`, nil)))
+	assert.Equal(t, "nack", settled(t, source.publish(`{"id":"reject"}`, nil)))
+	assert.Equal(t, "nack", settled(t, source.publish(`{"id":"slow"}`, nil)))
+}
+
+func TestConsumer_retries_without_nack(t *testing.T) {
+	received := make(chan *actor.MessageEnvelope, 10)
+	pid := spawnOrders(received)
+	defer rootContext.Stop(pid)
+	failures := 2
+	defer func() { clusterGet = cluster.Get }()
+	clusterGet = func(name string, kind string) (*actor.PID, remote.ResponseStatusCode) {
+		if failures > 0 {
+			failures--
+			return nil, remote.ResponseStatusCodeUNAVAILABLE
+		}
+		return pid, remote.ResponseStatusCodePROCESSNAMEALREADYEXIST
+	}
+
+	source := newTestSource()
+	consumer := Start(source, ClusterIdentityFromKey("order"), WithAckOnProcessed(50*time.Millisecond), WithRetryInterval(10*time.Millisecond))
+	defer consumer.Stop()
+
+	// the message is retried until it is processed, the next message is not consumed before
+	first := source.publishWithoutNack(`{"id":"1"}`)
+	second := source.publishWithoutNack(`{"id":"2"}`)
+	assert.Equal(t, "ack", settled(t, first))
+	assert.Equal(t, "ack", settled(t, second))
+	assert.Equal(t, &order{ID: "1"}, (<-received).Message)
+	assert.Equal(t, &order{ID: "2"}, (<-received).Message)
+
+	// the messages which cannot be deserialized are skipped
+	assert.Equal(t, "ack", settled(t, source.publishWithoutNack(`{`)))
+}
+
+func TestConsumer_cluster_identity_from_key(t *testing.T) {
+	received := make(chan *actor.MessageEnvelope, 10)
+	pid := spawnOrders(received)
+	defer rootContext.Stop(pid)
+	defer func() { clusterGet = cluster.Get }()
+	clusterGet = func(name string, kind string) (*actor.PID, remote.ResponseStatusCode) {
+		if kind == "order" && name == "key" {
+			return pid, remote.ResponseStatusCodeOK
+		}
+		return nil, remote.ResponseStatusCodeUNKNOWNKIND
+	}
+
+	source := newTestSource()
+	consumer := Start(source, ClusterIdentityFromKey("order"))
+	assert.Equal(t, "ack", settled(t, source.publish(`{"id":"1"}`, nil)))
+	consumer.Stop()
+
+	consumer = Start(source, ClusterIdentityFromKey("unknown"))
+	assert.Equal(t, "nack", settled(t, source.publish(`{"id":"1"}`, nil)))
+	consumer.Stop()
+}
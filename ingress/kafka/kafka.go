@@ -0,0 +1,96 @@
+// Package kafka is an ingress source consuming Kafka topics with a consumer group
+//
+//	reader := kafkago.NewReader(kafkago.ReaderConfig{Brokers: brokers, GroupID: "orders", Topic: "orders"})
+//	consumer := ingress.Start(kafka.NewSource(reader), ingress.ClusterIdentityFromKey("order"))
+package kafka
+
+import (
+	"strconv"
+
+	"github.com/AsynkronIT/protoactor-go/ingress"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	kafkago "github.com/segmentio/kafka-go"
+	"golang.org/x/net/context"
+)
+
+// Source consumes the messages of a kafka-go reader.
+// The Message-Type and Serializer-Id headers of a record name its type and serializer, the other headers are
+// passed as message headers. The key of the record is the key of the message.
+//
+// Acknowledging a message commits its offset, the reader needs a GroupID. Kafka cannot reject a single record,
+// so the consumer retries a message which is not processed until it is, without consuming the next records,
+// and commits the records which cannot be deserialized
+type Source struct {
+	reader       *kafkago.Reader
+	typeName     string
+	serializerID int32
+}
+
+// Option configures a Source
+type Option func(s *Source)
+
+// WithTypeName sets the type name of the records without Message-Type header
+func WithTypeName(typeName string) Option {
+	return func(s *Source) {
+		s.typeName = typeName
+	}
+}
+
+// WithSerializerID sets the serializer of the records without Serializer-Id header, the default is JSON
+func WithSerializerID(serializerID int32) Option {
+	return func(s *Source) {
+		s.serializerID = serializerID
+	}
+}
+
+// NewSource returns a source consuming the messages of reader, which the source closes when the consumer stops
+func NewSource(reader *kafkago.Reader, options ...Option) *Source {
+	s := &Source{reader: reader, serializerID: remote.JsonSerializerID}
+	for _, option := range options {
+		option(s)
+	}
+	return s
+}
+
+// Receive fetches the next record
+func (s *Source) Receive(ctx context.Context) (*ingress.Message, error) {
+	record, err := s.reader.FetchMessage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg := s.toMessage(record)
+	msg.Ack = func() error {
+		return s.reader.CommitMessages(context.Background(), record)
+	}
+	return msg, nil
+}
+
+// Close closes the reader
+func (s *Source) Close() error {
+	return s.reader.Close()
+}
+
+func (s *Source) toMessage(record kafkago.Message) *ingress.Message {
+	msg := &ingress.Message{
+		TypeName:     s.typeName,
+		SerializerID: s.serializerID,
+		Data:         record.Value,
+		Key:          string(record.Key),
+	}
+	for _, header := range record.Headers {
+		switch header.Key {
+		case ingress.MessageTypeHeader:
+			msg.TypeName = string(header.Value)
+		case ingress.SerializerIDHeader:
+			if id, err := strconv.Atoi(string(header.Value)); err == nil {
+				msg.SerializerID = int32(id)
+			}
+		default:
+			if msg.Header == nil {
+				msg.Header = make(map[string]string)
+			}
+			msg.Header[header.Key] = string(header.Value)
+		}
+	}
+	return msg
+}
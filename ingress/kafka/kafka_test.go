@@ -0,0 +1,26 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/AsynkronIT/protoactor-go/ingress"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	kafkago "github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSource_toMessage(t *testing.T) {
+	s := NewSource(nil, WithTypeName("orders.Order"))
+
+	msg := s.toMessage(kafkago.Message{Key: []byte("key"), Value: []byte("{}")})
+	assert.Equal(t, &ingress.Message{TypeName: "orders.Order", SerializerID: remote.JsonSerializerID, Data: []byte("{}"), Key: "key"}, msg)
+
+	msg = s.toMessage(kafkago.Message{Value: []byte("{}"), Headers: []kafkago.Header{
+		{Key: ingress.MessageTypeHeader, Value: []byte("orders.Cancel")},
+		{Key: ingress.SerializerIDHeader, Value: []byte("2")},
+		{Key: "trace", Value: []byte("42")},
+	}})
+	assert.Equal(t, "orders.Cancel", msg.TypeName)
+	assert.Equal(t, remote.MsgPackSerializerID, msg.SerializerID)
+	assert.Equal(t, map[string]string{"trace": "42"}, msg.Header)
+}
@@ -0,0 +1,113 @@
+// Package chaos holds the network failures simulated by the remotetest package, which remote and cluster apply.
+// It costs a single atomic load per message while no failure is simulated
+package chaos
+
+import (
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	enabled     int32
+	mu          sync.RWMutex
+	partitioned = make(map[string]bool)
+	delays      = make(map[string]time.Duration)
+	lossRates   = make(map[string]float64)
+	listeners   []func()
+)
+
+// Partition drops the messages to and from the address
+func Partition(address string) {
+	update(func() {
+		partitioned[address] = true
+	})
+	notify()
+}
+
+// Heal ends the partition from the address
+func Heal(address string) {
+	update(func() {
+		delete(partitioned, address)
+	})
+	notify()
+}
+
+// SetDelay delays the messages to the address
+func SetDelay(address string, delay time.Duration) {
+	update(func() {
+		delays[address] = delay
+	})
+}
+
+// SetLossRate drops the given fraction, between 0 and 1, of the messages to the address
+func SetLossRate(address string, rate float64) {
+	update(func() {
+		lossRates[address] = rate
+	})
+}
+
+// Reset ends all simulated failures
+func Reset() {
+	update(func() {
+		partitioned = make(map[string]bool)
+		delays = make(map[string]time.Duration)
+		lossRates = make(map[string]float64)
+	})
+	notify()
+}
+
+// IsPartitioned returns true while a partition from the address is simulated
+func IsPartitioned(address string) bool {
+	if atomic.LoadInt32(&enabled) == 0 {
+		return false
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	return partitioned[address]
+}
+
+// Intercept returns true if a message to the address is dropped, otherwise the delay to deliver it after
+func Intercept(address string) (bool, time.Duration) {
+	if atomic.LoadInt32(&enabled) == 0 {
+		return false, 0
+	}
+	mu.RLock()
+	isPartitioned := partitioned[address]
+	delay := delays[address]
+	lossRate := lossRates[address]
+	mu.RUnlock()
+
+	if isPartitioned || lossRate > 0 && rand.Float64() < lossRate {
+		return true, 0
+	}
+	return false, delay
+}
+
+// OnPartitionChanged registers fn to be invoked whenever a partition is simulated or healed
+func OnPartitionChanged(fn func()) {
+	mu.Lock()
+	defer mu.Unlock()
+	listeners = append(listeners, fn)
+}
+
+func update(fn func()) {
+	mu.Lock()
+	defer mu.Unlock()
+	fn()
+	if len(partitioned) > 0 || len(delays) > 0 || len(lossRates) > 0 {
+		atomic.StoreInt32(&enabled, 1)
+	} else {
+		atomic.StoreInt32(&enabled, 0)
+	}
+}
+
+func notify() {
+	mu.RLock()
+	fns := listeners
+	mu.RUnlock()
+	for _, fn := range fns {
+		fn()
+	}
+}
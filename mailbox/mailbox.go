@@ -68,6 +68,11 @@ func (m *defaultMailbox) PostSystemMessage(message interface{}) {
 	m.schedule()
 }
 
+// UserMessageCount returns the number of user messages waiting to be processed
+func (m *defaultMailbox) UserMessageCount() int {
+	return int(atomic.LoadInt32(&m.userMessages))
+}
+
 func (m *defaultMailbox) RegisterHandlers(invoker MessageInvoker, dispatcher Dispatcher) {
 	m.invoker = invoker
 	m.dispatcher = dispatcher
@@ -4,6 +4,7 @@ const code = `{{ if .Services }}
 package {{.PackageName}}
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -43,63 +44,38 @@ type {{ $service.Name }} interface {
 	{{ end }}	
 }
 
+// {{ $service.Name }}Client calls a {{ $service.Name }} grain, depend on it rather than on {{ $service.Name }}Grain to replace the grain by a mock in tests
+type {{ $service.Name }}Client interface {
+	{{ range $method := $service.Methods}}	
+	{{ $method.Name }}(ctx context.Context, r *{{ $method.Input.Name }}, opts ...cluster.GrainCallOption) (*{{ $method.Output.Name }}, error)
+	{{ end }}	
+}
+
+var _ {{ $service.Name }}Client = (*{{ $service.Name }}Grain)(nil)
+
 // {{ $service.Name }}Grain holds the base data for the {{ $service.Name }}Grain
 type {{ $service.Name }}Grain struct {
 	ID string
 }
 {{ range $method := $service.Methods}}	
-// {{ $method.Name }} requests the execution on to the cluster using default options
-func (g *{{ $service.Name }}Grain) {{ $method.Name }}(r *{{ $method.Input.Name }}) (*{{ $method.Output.Name }}, error) {
-	return g.{{ $method.Name }}WithOpts(r, cluster.DefaultGrainCallOptions())
+// {{ $method.Name }} requests the execution on to the cluster until ctx is done, using the options of the kind changed by opts
+func (g *{{ $service.Name }}Grain) {{ $method.Name }}(ctx context.Context, r *{{ $method.Input.Name }}, opts ...cluster.GrainCallOption) (*{{ $method.Output.Name }}, error) {
+	res := &{{ $method.Output.Name }}{}
+	err := cluster.CallGrain(ctx, g.ID, "{{ $service.Name }}", {{ $method.Index }}, r, res, cluster.ApplyGrainCallOptions("{{ $service.Name }}", opts...))
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 // {{ $method.Name }}WithOpts requests the execution on to the cluster
 func (g *{{ $service.Name }}Grain) {{ $method.Name }}WithOpts(r *{{ $method.Input.Name }}, opts *cluster.GrainCallOptions) (*{{ $method.Output.Name }}, error) {
-	fun := func() (*{{ $method.Output.Name }}, error) {
-			pid, statusCode := cluster.Get(g.ID, "{{ $service.Name }}")
-			if statusCode != remote.ResponseStatusCodeOK && statusCode != remote.ResponseStatusCodePROCESSNAMEALREADYEXIST {
-				return nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode)
-			}
-			bytes, err := proto.Marshal(r)
-			if err != nil {
-				return nil, err
-			}
-			request := &cluster.GrainRequest{MethodIndex: {{ $method.Index }}, MessageData: bytes}
-			response, err := rootContext.RequestFuture(pid, request, opts.Timeout).Result()
-			if err != nil {
-				return nil, err
-			}
-			switch msg := response.(type) {
-			case *cluster.GrainResponse:
-				result := &{{ $method.Output.Name }}{}
-				err = proto.Unmarshal(msg.MessageData, result)
-				if err != nil {
-					return nil, err
-				}
-				return result, nil
-			case *cluster.GrainErrorResponse:
-				return nil, errors.New(msg.Err)
-			default:
-				return nil, errors.New("unknown response")
-			}
-		}
-	
-	var res *{{ $method.Output.Name }}
-	var err error
-	for i := 0; i < opts.RetryCount; i++ {
-		res, err = fun()
-		if err == nil || err.Error() != "future: timeout" {
-			return res, err
-		} else if opts.RetryAction != nil {
-				opts.RetryAction(i)
-		}
-	}
-	return nil, err
+	return g.{{ $method.Name }}(context.Background(), r, cluster.WithCallOptions(opts))
 }
 
-// {{ $method.Name }}Chan allows to use a channel to execute the method using default options
+// {{ $method.Name }}Chan allows to use a channel to execute the method using the options of the kind
 func (g *{{ $service.Name }}Grain) {{ $method.Name }}Chan(r *{{ $method.Input.Name }}) (<-chan *{{ $method.Output.Name }}, <-chan error) {
-	return g.{{ $method.Name }}ChanWithOpts(r, cluster.DefaultGrainCallOptions())
+	return g.{{ $method.Name }}ChanWithOpts(r, cluster.GrainCallOptionsForKind("{{ $service.Name }}"))
 }
 
 // {{ $method.Name }}ChanWithOpts allows to use a channel to execute the method
@@ -118,6 +94,47 @@ func (g *{{ $service.Name }}Grain) {{ $method.Name }}ChanWithOpts(r *{{ $method.
 	}()
 	return c, e
 }
+
+// {{ $method.Name }}Reenter requests the execution on to the cluster from a grain using the options of the kind,
+// the calling grain processes other messages until cont is called with the result
+func (g *{{ $service.Name }}Grain) {{ $method.Name }}Reenter(ctx cluster.GrainContext, r *{{ $method.Input.Name }}, cont func(*{{ $method.Output.Name }}, error)) {
+	g.{{ $method.Name }}ReenterWithOpts(ctx, r, cluster.GrainCallOptionsForKind("{{ $service.Name }}"), cont)
+}
+
+// {{ $method.Name }}ReenterWithOpts requests the execution on to the cluster from a grain, the call is not retried
+func (g *{{ $service.Name }}Grain) {{ $method.Name }}ReenterWithOpts(ctx cluster.GrainContext, r *{{ $method.Input.Name }}, opts *cluster.GrainCallOptions, cont func(*{{ $method.Output.Name }}, error)) {
+	pid, statusCode := cluster.Get(g.ID, "{{ $service.Name }}")
+	if statusCode != remote.ResponseStatusCodeOK && statusCode != remote.ResponseStatusCodePROCESSNAMEALREADYEXIST {
+		cont(nil, fmt.Errorf("get PID failed with StatusCode: %v", statusCode))
+		return
+	}
+	bytes, err := proto.Marshal(r)
+	if err != nil {
+		cont(nil, err)
+		return
+	}
+	request := &cluster.GrainRequest{MethodIndex: {{ $method.Index }}, MessageData: bytes}
+	ctx.AwaitFuture(ctx.RequestFuture(pid, request, opts.Timeout), func(response interface{}, err error) {
+		if err != nil {
+			cont(nil, err)
+			return
+		}
+		switch msg := response.(type) {
+		case *cluster.GrainResponse:
+			result := &{{ $method.Output.Name }}{}
+			err = proto.Unmarshal(msg.MessageData, result)
+			if err != nil {
+				cont(nil, err)
+				return
+			}
+			cont(result, nil)
+		case *cluster.GrainErrorResponse:
+			cont(nil, errors.New(msg.Err))
+		default:
+			cont(nil, errors.New("unknown response"))
+		}
+	})
+}
 {{ end }}	
 
 // {{ $service.Name }}Actor represents the actor structure
@@ -126,6 +143,13 @@ type {{ $service.Name }}Actor struct {
 	Timeout *time.Duration
 }
 
+// PrePassivate gives the grain the chance to persist its state before it is passivated when it implements cluster.Passivatable
+func (a *{{ $service.Name }}Actor) PrePassivate(ctx actor.Context) {
+	if p, ok := a.inner.(cluster.Passivatable); ok {
+		p.PrePassivate(ctx)
+	}
+}
+
 // Receive ensures the lifecycle of the actor for the received message
 func (a *{{ $service.Name }}Actor) Receive(ctx actor.Context) {
 	switch msg := ctx.Message().(type) {
@@ -143,6 +167,16 @@ func (a *{{ $service.Name }}Actor) Receive(ctx actor.Context) {
 	case actor.AutoReceiveMessage: // pass
 	case actor.SystemMessage: // pass
 
+	case *cluster.ReminderFired:
+		if r, ok := a.inner.(cluster.Remindable); ok {
+			r.ReceiveReminder(msg.Name, ctx)
+		}
+
+	case *cluster.Handoff:
+		if h, ok := a.inner.(cluster.Handoffable); ok {
+			h.Handoff(ctx)
+		}
+
 	case *cluster.GrainRequest:
 		switch msg.MethodIndex {
 		{{ range $method := $service.Methods}}	
@@ -150,23 +184,20 @@ func (a *{{ $service.Name }}Actor) Receive(ctx actor.Context) {
 			req := &{{ $method.Input.Name }}{}
 			err := proto.Unmarshal(msg.MessageData, req)
 			if err != nil {
-				log.Fatalf("[GRAIN] proto.Unmarshal failed %v", err)
+				cluster.RespondGrain(ctx, nil, err)
+				return
 			}
 			r0, err := a.inner.{{ $method.Name }}(req, ctx)
-			if err == nil {
-				bytes, errMarshal := proto.Marshal(r0)
-				if errMarshal != nil {
-					log.Fatalf("[GRAIN] proto.Marshal failed %v", errMarshal)
-				}
-				resp := &cluster.GrainResponse{MessageData: bytes}
-				ctx.Respond(resp)
-			} else {
-				resp := &cluster.GrainErrorResponse{Err: err.Error()}
-				ctx.Respond(resp)
+			if err != cluster.ErrResponseDeferred {
+				cluster.RespondGrain(ctx, r0, err)
 			}
 		{{ end }}
 		}
 	default:
+		if b, ok := a.inner.(cluster.BroadcastReceiver); ok {
+			b.ReceiveBroadcast(msg, ctx)
+			return
+		}
 		log.Printf("Unknown message %v", msg)
 	}
 }
@@ -1,11 +1,15 @@
 package remote
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
 	"github.com/AsynkronIT/protoactor-go/log"
 )
 
@@ -28,6 +32,15 @@ func Register(kind string, props *actor.Props) {
 	nameLookup[kind] = *props
 }
 
+// GetKnownKind returns the props registered for the given kind
+func GetKnownKind(kind string) (*actor.Props, bool) {
+	props, ok := nameLookup[kind]
+	if !ok {
+		return nil, false
+	}
+	return &props, true
+}
+
 // GetKnownKinds returns a slice of known actor "kinds"
 func GetKnownKinds() []string {
 	keys := make([]string, 0, len(nameLookup))
@@ -37,7 +50,63 @@ func GetKnownKinds() []string {
 	return keys
 }
 
+// activationPrefix prefixes the names of the actors spawned by the activator
+const activationPrefix = "Remote$"
+
+// ActivatedName returns the name the actor with the id was spawned with by the activator
+func ActivatedName(id string) string {
+	return strings.TrimPrefix(id, activationPrefix)
+}
+
 type activator struct {
+	activations map[string]*actor.PID
+	// kinds is the kind of every activation
+	kinds    map[string]string
+	draining bool
+}
+
+type drainActivator struct{}
+
+type drainActivatorResponse struct {
+	activations []*actor.PID
+}
+
+// DrainActivator makes the local activator refuse new activations, answering them with ResponseStatusCodeUNAVAILABLE
+// so they are made on other members, and returns the actors it has activated which are still alive
+func DrainActivator(timeout time.Duration) ([]*actor.PID, error) {
+	res, err := rootContext.RequestFuture(activatorPid, &drainActivator{}, timeout).Result()
+	if err != nil {
+		return nil, err
+	}
+	return res.(*drainActivatorResponse).activations, nil
+}
+
+type activationCounts struct{}
+
+type activationCountsResponse struct {
+	counts map[string]int
+}
+
+type activationsOfKind struct {
+	kind string
+}
+
+// ActivationsOfKind returns the alive actors of the kind the local activator has activated
+func ActivationsOfKind(kind string, timeout time.Duration) ([]*actor.PID, error) {
+	res, err := rootContext.RequestFuture(activatorPid, &activationsOfKind{kind: kind}, timeout).Result()
+	if err != nil {
+		return nil, err
+	}
+	return res.([]*actor.PID), nil
+}
+
+// ActivationCounts returns the number of alive actors the local activator has activated, per kind
+func ActivationCounts(timeout time.Duration) (map[string]int, error) {
+	res, err := rootContext.RequestFuture(activatorPid, &activationCounts{}, timeout).Result()
+	if err != nil {
+		return nil, err
+	}
+	return res.(*activationCountsResponse).counts, nil
 }
 
 // ErrActivatorUnavailable : this error will not panic the Activator.
@@ -76,6 +145,8 @@ func Spawn(address, kind string, timeout time.Duration) (*ActorPidResponse, erro
 }
 
 // SpawnNamed spawns a named remote actor of a given type at a given address
+//
+// The status of the activation is returned in the response, use SpawnNamedContext to get typed errors instead
 func SpawnNamed(address, name, kind string, timeout time.Duration) (*ActorPidResponse, error) {
 	res, err := SpawnFuture(address, name, kind, timeout).Result()
 	if err != nil {
@@ -89,26 +160,178 @@ func SpawnNamed(address, name, kind string, timeout time.Duration) (*ActorPidRes
 	}
 }
 
+var (
+	// ErrUnknownKind is the cause of a SpawnError when the kind is not registered on the remote node
+	ErrUnknownKind = errors.New("unknown kind")
+	// ErrNodeUnreachable is the cause of a SpawnError when the remote node is quarantined or the connection is lost
+	ErrNodeUnreachable = errors.New("node unreachable")
+	// ErrSpawnFailed is the cause of a SpawnError when the remote actor failed to spawn
+	ErrSpawnFailed = errors.New("spawn failed")
+)
+
+// SpawnError is returned from SpawnNamedContext when a remote actor could not be spawned.
+//
+// Err is one of ErrUnknownKind, actor.ErrNameExists, ErrNodeUnreachable, actor.ErrTimeout, ErrActivatorUnavailable,
+// ErrSpawnFailed or the error of a canceled context, use errors.Is to test for them
+type SpawnError struct {
+	Address string
+	Name    string
+	Kind    string
+	Err     error
+}
+
+func (e *SpawnError) Error() string {
+	return fmt.Sprintf("remote: failed to spawn '%v' of kind '%v' at %v: %v", e.Name, e.Kind, e.Address, e.Err)
+}
+
+func (e *SpawnError) Unwrap() error {
+	return e.Err
+}
+
+// SpawnContext spawns a remote actor of a given type at a given address, see SpawnNamedContext
+func SpawnContext(ctx context.Context, address, kind string) (*actor.PID, error) {
+	return SpawnNamedContext(ctx, address, "", kind)
+}
+
+// SpawnNamedContext spawns a named remote actor of a given type at a given address.
+// The deadline of ctx limits how long to wait for the remote node, without a deadline it waits until ctx is done.
+//
+// When the name already exists, the PID of the existing actor is returned along with a SpawnError wrapping actor.ErrNameExists
+func SpawnNamedContext(ctx context.Context, address, name, kind string) (*actor.PID, error) {
+	spawnError := func(err error) error {
+		return &SpawnError{Address: address, Name: name, Kind: kind, Err: err}
+	}
+
+	if endpointManager != nil && endpointManager.quarantine.contains(address) {
+		return nil, spawnError(ErrNodeUnreachable)
+	}
+
+	timeout := time.Duration(-1)
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+		if timeout <= 0 {
+			return nil, spawnError(actor.ErrTimeout)
+		}
+	}
+
+	// the connection to the node is lost while waiting for the response
+	unreachable := make(chan struct{})
+	var once sync.Once
+	sub := eventstream.Subscribe(func(evt interface{}) {
+		if e, ok := evt.(*EndpointTerminatedEvent); ok && e.Address == address {
+			once.Do(func() { close(unreachable) })
+		}
+	})
+	defer eventstream.Unsubscribe(sub)
+
+	f := SpawnFuture(address, name, kind, timeout)
+	var res interface{}
+	var err error
+	done := make(chan struct{})
+	go func() {
+		res, err = f.Result()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-unreachable:
+		rootContext.Stop(f.PID())
+		return nil, spawnError(ErrNodeUnreachable)
+	case <-ctx.Done():
+		rootContext.Stop(f.PID())
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, spawnError(actor.ErrTimeout)
+		}
+		return nil, spawnError(ctx.Err())
+	}
+
+	if err != nil {
+		return nil, spawnError(err)
+	}
+	response, ok := res.(*ActorPidResponse)
+	if !ok {
+		return nil, spawnError(fmt.Errorf("unknown response %T", res))
+	}
+
+	switch ResponseStatusCode(response.StatusCode) {
+	case ResponseStatusCodeOK:
+		return response.Pid, nil
+	case ResponseStatusCodePROCESSNAMEALREADYEXIST:
+		return response.Pid, spawnError(actor.ErrNameExists)
+	case ResponseStatusCodeUNKNOWNKIND:
+		return nil, spawnError(ErrUnknownKind)
+	case ResponseStatusCodeUNAVAILABLE:
+		return nil, spawnError(ErrActivatorUnavailable)
+	case ResponseStatusCodeTIMEOUT:
+		return nil, spawnError(actor.ErrTimeout)
+	default:
+		return nil, spawnError(ErrSpawnFailed)
+	}
+}
+
 func newActivatorActor() actor.Producer {
 	return func() actor.Actor {
-		return &activator{}
+		return &activator{
+			activations: make(map[string]*actor.PID),
+			kinds:       make(map[string]string),
+		}
 	}
 }
 
-func (*activator) Receive(context actor.Context) {
+func (state *activator) Receive(context actor.Context) {
 	switch msg := context.Message().(type) {
 	case *actor.Started:
 		plog.Debug("Started Activator")
+	case *HeartbeatRequest:
+		context.Respond(&HeartbeatResponse{})
+	case *drainActivator:
+		state.draining = true
+		activations := make([]*actor.PID, 0, len(state.activations))
+		for _, pid := range state.activations {
+			activations = append(activations, pid)
+		}
+		context.Respond(&drainActivatorResponse{activations: activations})
+	case *activationCounts:
+		counts := make(map[string]int)
+		for _, kind := range state.kinds {
+			counts[kind]++
+		}
+		context.Respond(&activationCountsResponse{counts: counts})
+	case *activationsOfKind:
+		activations := make([]*actor.PID, 0)
+		for id, kind := range state.kinds {
+			if kind == msg.kind {
+				activations = append(activations, state.activations[id])
+			}
+		}
+		context.Respond(activations)
+	case *actor.Terminated:
+		if kind, ok := state.kinds[msg.Who.Id]; ok {
+			eventstream.Publish(&ActivationTerminatedEvent{
+				PID:  msg.Who,
+				Name: ActivatedName(msg.Who.Id),
+				Kind: kind,
+			})
+		}
+		delete(state.activations, msg.Who.Id)
+		delete(state.kinds, msg.Who.Id)
 	case *ActorPidRequest:
+		if state.draining {
+			context.Respond(ActorPidRespUnavailable)
+			return
+		}
+
 		props, exist := nameLookup[msg.Kind]
 
-		// if props not exist, return error and panic
+		// if props not exist, return error
 		if !exist {
 			response := &ActorPidResponse{
-				StatusCode: ResponseStatusCodeERROR.ToInt32(),
+				StatusCode: ResponseStatusCodeUNKNOWNKIND.ToInt32(),
 			}
 			context.Respond(response)
-			panic(fmt.Errorf("no Props found for kind %s", msg.Kind))
+			plog.Error("Activator has no Props for kind", log.String("kind", msg.Kind))
+			return
 		}
 
 		name := msg.Name
@@ -118,9 +341,12 @@ func (*activator) Receive(context actor.Context) {
 			name = actor.ProcessRegistry.NextId()
 		}
 
-		pid, err := rootContext.SpawnNamed(&props, "Remote$"+name)
+		pid, err := rootContext.SpawnNamed(&props, activationPrefix+name)
 
 		if err == nil {
+			state.activations[pid.Id] = pid
+			state.kinds[pid.Id] = msg.Kind
+			context.Watch(pid)
 			response := &ActorPidResponse{Pid: pid}
 			context.Respond(response)
 		} else if err == actor.ErrNameExists {
@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -103,6 +105,127 @@ func (suite *ActivatorTestSuite) TestSpawnFuture() {
 	suite.True(resolverCalled, "AddressResolver should be called when message is sent over network.")
 }
 
+func (suite *ActivatorTestSuite) TestSpawnNamedContext() {
+	name := "name"
+	kind := "kind"
+	address := "192.0.2.0:1234"
+
+	tests := []struct {
+		TestName   string
+		StatusCode ResponseStatusCode
+		Err        error
+	}{
+		{TestName: "ok", StatusCode: ResponseStatusCodeOK, Err: nil},
+		{TestName: "name exists", StatusCode: ResponseStatusCodePROCESSNAMEALREADYEXIST, Err: actor.ErrNameExists},
+		{TestName: "unknown kind", StatusCode: ResponseStatusCodeUNKNOWNKIND, Err: ErrUnknownKind},
+		{TestName: "unavailable", StatusCode: ResponseStatusCodeUNAVAILABLE, Err: ErrActivatorUnavailable},
+		{TestName: "error", StatusCode: ResponseStatusCodeERROR, Err: ErrSpawnFailed},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.TestName, func() {
+			activator, activatorProcess := spawnMockProcess("activator")
+			defer removeMockProcess(activator)
+
+			actor.ProcessRegistry.RemoteHandlers = []actor.AddressResolver{
+				func(pid *actor.PID) (i actor.Process, b bool) {
+					return activatorProcess, true
+				},
+			}
+
+			activatorProcess.On("SendUserMessage", mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) {
+					envelope := args.Get(1).(*actor.MessageEnvelope)
+					rootContext.Send(envelope.Sender, &ActorPidResponse{
+						Pid:        &actor.PID{Address: address, Id: name},
+						StatusCode: tt.StatusCode.ToInt32(),
+					})
+				}).
+				Once()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+			defer cancel()
+			pid, err := SpawnNamedContext(ctx, address, name, kind)
+
+			if tt.Err == nil {
+				suite.Nil(err)
+				suite.Equal(name, pid.Id)
+				return
+			}
+
+			suite.True(errors.Is(err, tt.Err), "unexpected error %v", err)
+			var spawnErr *SpawnError
+			if suite.True(errors.As(err, &spawnErr)) {
+				suite.Equal(address, spawnErr.Address)
+				suite.Equal(name, spawnErr.Name)
+				suite.Equal(kind, spawnErr.Kind)
+			}
+			if tt.Err == actor.ErrNameExists {
+				suite.NotNil(pid, "existing PID should be returned")
+			} else {
+				suite.Nil(pid)
+			}
+		})
+	}
+
+	suite.Run("timeout", func() {
+		activator, activatorProcess := spawnMockProcess("activator")
+		defer removeMockProcess(activator)
+
+		actor.ProcessRegistry.RemoteHandlers = []actor.AddressResolver{
+			func(pid *actor.PID) (i actor.Process, b bool) {
+				return activatorProcess, true
+			},
+		}
+		activatorProcess.On("SendUserMessage", mock.Anything, mock.Anything).Once()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		pid, err := SpawnNamedContext(ctx, address, name, kind)
+		suite.Nil(pid)
+		suite.True(errors.Is(err, actor.ErrTimeout), "unexpected error %v", err)
+	})
+
+	suite.Run("canceled", func() {
+		activator, activatorProcess := spawnMockProcess("activator")
+		defer removeMockProcess(activator)
+
+		actor.ProcessRegistry.RemoteHandlers = []actor.AddressResolver{
+			func(pid *actor.PID) (i actor.Process, b bool) {
+				return activatorProcess, true
+			},
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		activatorProcess.On("SendUserMessage", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) { cancel() }).
+			Once()
+
+		pid, err := SpawnNamedContext(ctx, address, name, kind)
+		suite.Nil(pid)
+		suite.True(errors.Is(err, context.Canceled), "unexpected error %v", err)
+	})
+
+	suite.Run("unreachable", func() {
+		activator, activatorProcess := spawnMockProcess("activator")
+		defer removeMockProcess(activator)
+
+		actor.ProcessRegistry.RemoteHandlers = []actor.AddressResolver{
+			func(pid *actor.PID) (i actor.Process, b bool) {
+				return activatorProcess, true
+			},
+		}
+		activatorProcess.On("SendUserMessage", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				eventstream.Publish(&EndpointTerminatedEvent{Address: address})
+			}).
+			Once()
+
+		pid, err := SpawnNamedContext(context.Background(), address, name, kind)
+		suite.Nil(pid)
+		suite.True(errors.Is(err, ErrNodeUnreachable), "unexpected error %v", err)
+	})
+}
+
 func (suite *ActivatorTestSuite) TestSpawn() {
 	kind := "kind"
 	address := "192.0.2.0:1234"
@@ -298,13 +421,13 @@ func (suite *ActivatorTestSuite) Test_activatorReceive_UnknownKind() {
 		Run(func(args mock.Arguments) {
 			suite.IsType(&ActorPidResponse{}, args.Get(0))
 			response := args.Get(0).(*ActorPidResponse)
-			suite.Equal(ResponseStatusCodeERROR.ToInt32(), response.StatusCode)
+			suite.Equal(ResponseStatusCodeUNKNOWNKIND.ToInt32(), response.StatusCode)
 			suite.Nil(response.Pid)
 		}).
 		Once()
 
-	activator := &activator{}
-	suite.Panics(func() { activator.Receive(context) })
+	activator := newActivatorActor()().(*activator)
+	suite.NotPanics(func() { activator.Receive(context) })
 
 	context.AssertExpectations(suite.T())
 }
@@ -386,6 +509,10 @@ func (suite *ActivatorTestSuite) Test_activatorReceive_Spawn() {
 
 			context := &mockContext{}
 			context.On("Message").Return(request).Once() // A request for an actor
+			if tt.HasKind && tt.Err == nil {
+				// the activated actor is watched until it stops
+				context.On("Watch", mock.AnythingOfType("*actor.PID")).Once()
+			}
 			context.
 				On("Respond", mock.AnythingOfType("*remote.ActorPidResponse")).
 				Run(func(args mock.Arguments) {
@@ -405,8 +532,8 @@ func (suite *ActivatorTestSuite) Test_activatorReceive_Spawn() {
 						}
 					}
 					if !tt.HasKind {
-						// When no corresponding kind is registered, then the response should contain an error.
-						suite.Equal(ResponseStatusCodeERROR.ToInt32(), response.StatusCode)
+						// When no corresponding kind is registered, then the response should tell so.
+						suite.Equal(ResponseStatusCodeUNKNOWNKIND.ToInt32(), response.StatusCode)
 					}
 					if tt.PidFunc != nil {
 						suite.NotNil(response.Pid)
@@ -419,10 +546,9 @@ func (suite *ActivatorTestSuite) Test_activatorReceive_Spawn() {
 
 			e, ok := tt.Err.(*ActivatorError)
 			if (ok && !e.DoNotPanic) ||
-				tt.Err == uncontrollableErr ||
-				!tt.HasKind {
+				tt.Err == uncontrollableErr {
 
-				activator := &activator{}
+				activator := newActivatorActor()().(*activator)
 				suite.Panics(func() {
 					activator.Receive(context)
 				})
@@ -430,7 +556,7 @@ func (suite *ActivatorTestSuite) Test_activatorReceive_Spawn() {
 				return
 			}
 
-			activator := &activator{}
+			activator := newActivatorActor()().(*activator)
 			activator.Receive(context)
 			context.AssertExpectations(suite.T())
 		})
@@ -442,7 +568,7 @@ func (suite *ActivatorTestSuite) Test_activatorReceive_Spawn() {
 			&actor.Stopped{},
 			&actor.Restart{},
 			&actor.Failure{},
-			&actor.Terminated{},
+			&actor.Terminated{Who: actor.NewLocalPID("unknown")},
 			&actor.Watch{},
 			&actor.Unwatch{},
 			&actor.PoisonPill{},
@@ -457,7 +583,7 @@ func (suite *ActivatorTestSuite) Test_activatorReceive_Spawn() {
 				context := &mockContext{}
 				context.On("Message").Return(msg).Once()
 
-				activator := &activator{}
+				activator := newActivatorActor()().(*activator)
 				activator.Receive(context)
 
 				// Message is ignored and hence Respond is not called
@@ -468,6 +594,62 @@ func (suite *ActivatorTestSuite) Test_activatorReceive_Spawn() {
 	})
 }
 
+func (suite *ActivatorTestSuite) Test_activatorReceive_Drain() {
+	kind := "targetKind"
+	nameLookup[kind] = *actor.PropsFromFunc(func(c actor.Context) {})
+	activator := newActivatorActor()().(*activator)
+
+	pid := actor.NewLocalPID("Remote$activated")
+	activator.activations[pid.Id] = pid
+	activator.kinds[pid.Id] = kind
+
+	context := &mockContext{}
+	context.On("Message").Return(&drainActivator{}).Once()
+	context.On("Respond", &drainActivatorResponse{activations: []*actor.PID{pid}}).Once()
+	activator.Receive(context)
+
+	// new activations are refused once drained
+	context.On("Message").Return(&ActorPidRequest{Name: "other", Kind: kind}).Once()
+	context.On("Respond", ActorPidRespUnavailable).Once()
+	activator.Receive(context)
+
+	// stopped actors are forgotten and published
+	var terminated []interface{}
+	sub := eventstream.Subscribe(func(evt interface{}) {
+		if e, ok := evt.(*ActivationTerminatedEvent); ok {
+			terminated = append(terminated, e)
+		}
+	})
+	defer eventstream.Unsubscribe(sub)
+	context.On("Message").Return(&actor.Terminated{Who: pid}).Once()
+	activator.Receive(context)
+	suite.Empty(activator.activations)
+	suite.Empty(activator.kinds)
+	suite.Equal([]interface{}{&ActivationTerminatedEvent{PID: pid, Name: "activated", Kind: kind}}, terminated)
+
+	context.AssertExpectations(suite.T())
+}
+
+func (suite *ActivatorTestSuite) Test_activatorReceive_ActivationCounts() {
+	activator := newActivatorActor()().(*activator)
+	for i, kind := range []string{"a", "b", "a"} {
+		pid := actor.NewLocalPID("Remote$activated" + strconv.Itoa(i))
+		activator.activations[pid.Id] = pid
+		activator.kinds[pid.Id] = kind
+	}
+
+	context := &mockContext{}
+	context.On("Message").Return(&activationCounts{}).Once()
+	context.On("Respond", &activationCountsResponse{counts: map[string]int{"a": 2, "b": 1}}).Once()
+	activator.Receive(context)
+
+	context.On("Message").Return(&activationsOfKind{kind: "b"}).Once()
+	context.On("Respond", []*actor.PID{actor.NewLocalPID("Remote$activated1")}).Once()
+	activator.Receive(context)
+
+	context.AssertExpectations(suite.T())
+}
+
 func TestActivatorError_Error(t *testing.T) {
 	var code int32 = 123
 	err := &ActivatorError{Code: code}
@@ -0,0 +1,118 @@
+package remote
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ErrUnauthenticated is returned by the built-in authenticators when a peer does not present valid credentials
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// PeerInfo describes the connection of a remote node
+type PeerInfo struct {
+	// Address is the network address the peer connects from, not the address of its remoting server
+	Address string
+	// Metadata is the metadata sent by the peer when connecting, see WithCredentials
+	Metadata metadata.MD
+}
+
+// addressMetadataKey is the metadata key a node sends the address of its remoting server under when connecting
+const addressMetadataKey = "proto-address"
+
+// nodeAddress returns the address of the remoting server of the peer, or the address it connects from
+// if the peer does not send it
+func (peer *PeerInfo) nodeAddress() string {
+	if address := peer.Metadata.Get(addressMetadataKey); len(address) > 0 {
+		return address[0]
+	}
+	return peer.Address
+}
+
+// Authenticator is invoked for every incoming connection, returning an error rejects the peer
+type Authenticator func(peer *PeerInfo) error
+
+// EnvelopeAuthorizer is invoked for every message received from an authenticated peer, returning an error drops the message.
+// The header is the message header set by the sender, it is nil if there is none
+type EnvelopeAuthorizer func(peer *PeerInfo, target *actor.PID, message interface{}, header map[string]string) error
+
+// CredentialsProvider returns the metadata sent to the node at address when connecting to it
+type CredentialsProvider func(address string) (map[string]string, error)
+
+// WithAuthenticator rejects incoming connections the authenticator returns an error for
+func WithAuthenticator(authenticator Authenticator) RemotingOption {
+	return func(config *remoteConfig) {
+		config.authenticator = authenticator
+	}
+}
+
+// WithEnvelopeAuthorizer drops incoming messages the authorizer returns an error for
+func WithEnvelopeAuthorizer(authorizer EnvelopeAuthorizer) RemotingOption {
+	return func(config *remoteConfig) {
+		config.envelopeAuthorizer = authorizer
+	}
+}
+
+// WithCredentials sends the metadata returned by the provider when connecting to other nodes
+func WithCredentials(provider CredentialsProvider) RemotingOption {
+	return func(config *remoteConfig) {
+		config.credentials = provider
+	}
+}
+
+const authorizationKey = "authorization"
+
+// WithBearerToken sends the token as bearer authorization when connecting to other nodes, see BearerTokenAuthenticator
+func WithBearerToken(token string) RemotingOption {
+	return WithCredentials(func(string) (map[string]string, error) {
+		return map[string]string{authorizationKey: "Bearer " + token}, nil
+	})
+}
+
+// BearerTokenAuthenticator accepts peers presenting one of the given tokens as bearer authorization
+func BearerTokenAuthenticator(tokens ...string) Authenticator {
+	valid := make(map[string]struct{}, len(tokens))
+	for _, token := range tokens {
+		valid[token] = struct{}{}
+	}
+	return func(peer *PeerInfo) error {
+		for _, value := range peer.Metadata.Get(authorizationKey) {
+			if !strings.HasPrefix(value, "Bearer ") {
+				continue
+			}
+			if _, ok := valid[strings.TrimPrefix(value, "Bearer ")]; ok {
+				return nil
+			}
+		}
+		return ErrUnauthenticated
+	}
+}
+
+func peerInfoFromContext(ctx context.Context) *PeerInfo {
+	info := &PeerInfo{}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		info.Address = p.Addr.String()
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		info.Metadata = md
+	}
+	return info
+}
+
+// outgoingContext returns the context carrying the address of this node and the credentials for the endpoint connection to address
+func (config *remoteConfig) outgoingContext(address string) (context.Context, error) {
+	md := metadata.MD{}
+	if config.credentials != nil {
+		credentials, err := config.credentials(address)
+		if err != nil {
+			return nil, err
+		}
+		md = metadata.New(credentials)
+	}
+	md.Set(addressMetadataKey, actor.ProcessRegistry.Address)
+	return metadata.NewOutgoingContext(context.Background(), md), nil
+}
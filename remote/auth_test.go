@@ -0,0 +1,69 @@
+package remote
+
+import (
+	"net"
+	"testing"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestBearerTokenAuthenticator(t *testing.T) {
+	authenticator := BearerTokenAuthenticator("secret", "other")
+
+	assert.NoError(t, authenticator(&PeerInfo{Metadata: metadata.Pairs("authorization", "Bearer secret")}))
+	assert.NoError(t, authenticator(&PeerInfo{Metadata: metadata.Pairs("authorization", "Bearer other")}))
+	assert.Equal(t, ErrUnauthenticated, authenticator(&PeerInfo{Metadata: metadata.Pairs("authorization", "Bearer wrong")}))
+	assert.Equal(t, ErrUnauthenticated, authenticator(&PeerInfo{Metadata: metadata.Pairs("authorization", "secret")}))
+	assert.Equal(t, ErrUnauthenticated, authenticator(&PeerInfo{}))
+}
+
+func TestOutgoingContext_Credentials(t *testing.T) {
+	config := defaultRemoteConfig()
+	WithBearerToken("secret")(config)
+
+	ctx, err := config.outgoingContext("localhost:8090")
+	assert.NoError(t, err)
+	md, ok := metadata.FromOutgoingContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"Bearer secret"}, md.Get("authorization"))
+}
+
+func TestOutgoingContext_Address(t *testing.T) {
+	defer func(address string) { actor.ProcessRegistry.Address = address }(actor.ProcessRegistry.Address)
+	actor.ProcessRegistry.Address = "192.0.2.2:8090"
+
+	ctx, err := defaultRemoteConfig().outgoingContext("localhost:8090")
+	assert.NoError(t, err)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	peer := &PeerInfo{Address: "192.0.2.2:51234", Metadata: md}
+	assert.Equal(t, "192.0.2.2:8090", peer.nodeAddress())
+	assert.Equal(t, "192.0.2.2:51234", (&PeerInfo{Address: "192.0.2.2:51234"}).nodeAddress())
+}
+
+func TestEndpointReader_ConnectAuthenticates(t *testing.T) {
+	config := defaultRemoteConfig()
+	WithAuthenticator(BearerTokenAuthenticator("secret"))(config)
+	reader := &endpointReader{authenticator: config.authenticator}
+
+	addr := &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 8090}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+
+	_, err := reader.Connect(ctx, &ConnectRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer secret"))
+	resp, err := reader.Connect(ctx, &ConnectRequest{})
+	assert.NoError(t, err)
+	if assert.NotNil(t, resp) {
+		assert.True(t, resp.Heartbeat, "the node should tell it answers heartbeats")
+	}
+
+	info := peerInfoFromContext(ctx)
+	assert.Equal(t, addr.String(), info.Address)
+}
@@ -0,0 +1,134 @@
+package remote
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/internal/chaos"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+)
+
+// newChaosTestEndpointManager returns an endpoint manager whose connection to the address writes to the returned channel
+func newChaosTestEndpointManager(address string) (*endpointManagerValue, chan interface{}) {
+	received := make(chan interface{}, 10)
+	writer := rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(*remoteDeliver); ok {
+			received <- msg.message
+		}
+	}))
+	em := &endpointManagerValue{
+		connections: &sync.Map{},
+		quarantine:  newQuarantine(0),
+		config:      defaultRemoteConfig(),
+	}
+	ep := &endpoint{writer: writer}
+	em.connections.Store(address, &endpointLazy{valueFunc: func() *endpoint { return ep }})
+	return em, received
+}
+
+func TestChaos_partition_drops_messages(t *testing.T) {
+	defer chaos.Reset()
+	address := "192.0.2.1:1234"
+	em, received := newChaosTestEndpointManager(address)
+	target := actor.NewPID(address, "foo")
+
+	chaos.Partition(address)
+	assert.True(t, chaos.IsPartitioned(address))
+	em.remoteDeliver(&remoteDeliver{target: target, message: "dropped", serializerID: -1})
+
+	chaos.Heal(address)
+	assert.False(t, chaos.IsPartitioned(address))
+	em.remoteDeliver(&remoteDeliver{target: target, message: "delivered", serializerID: -1})
+
+	select {
+	case msg := <-received:
+		assert.Equal(t, "delivered", msg)
+	case <-time.After(time.Second):
+		assert.Fail(t, "message should be delivered after healing the partition")
+	}
+}
+
+func TestChaos_delay_and_message_loss(t *testing.T) {
+	defer chaos.Reset()
+	address := "192.0.2.1:1234"
+	em, received := newChaosTestEndpointManager(address)
+	target := actor.NewPID(address, "foo")
+
+	chaos.SetDelay(address, 50*time.Millisecond)
+	start := time.Now()
+	em.remoteDeliver(&remoteDeliver{target: target, message: "delayed", serializerID: -1})
+	select {
+	case msg := <-received:
+		assert.Equal(t, "delayed", msg)
+		assert.True(t, time.Since(start) >= 50*time.Millisecond)
+	case <-time.After(time.Second):
+		assert.Fail(t, "delayed message should be delivered")
+	}
+
+	chaos.Reset()
+	chaos.SetLossRate(address, 1)
+	em.remoteDeliver(&remoteDeliver{target: target, message: "lost", serializerID: -1})
+	chaos.Reset()
+	em.remoteDeliver(&remoteDeliver{target: target, message: "delivered", serializerID: -1})
+	select {
+	case msg := <-received:
+		assert.Equal(t, "delivered", msg)
+	case <-time.After(time.Second):
+		assert.Fail(t, "message should be delivered after resetting chaos")
+	}
+}
+
+func TestChaos_partition_drops_messages_from_peer(t *testing.T) {
+	defer chaos.Reset()
+	address := "192.0.2.1:1234"
+	received := make(chan interface{}, 10)
+	pid := rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(*actor.PID); ok {
+			received <- msg
+		}
+	}))
+	defer rootContext.Stop(pid)
+
+	data, typeName, err := Serialize(pid, 0)
+	assert.NoError(t, err)
+	batch := &MessageBatch{
+		TargetNames: []string{pid.Id},
+		TypeNames:   []string{typeName},
+		Envelopes:   []*MessageEnvelope{{MessageData: data}},
+	}
+	receive := func() {
+		sent := false
+		reader := &endpointReader{}
+		// the peer connects from another port than the one of its remoting server and sends no sender
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(addressMetadataKey, address))
+		_ = reader.ReceiveBatches(ctx, func() (*MessageBatch, error) {
+			if sent {
+				return nil, errors.New("closed")
+			}
+			sent = true
+			return batch, nil
+		})
+	}
+
+	chaos.Partition(address)
+	receive()
+	select {
+	case <-received:
+		assert.Fail(t, "message from a partitioned peer should be dropped")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	chaos.Heal(address)
+	receive()
+	select {
+	case msg := <-received:
+		assert.Equal(t, pid, msg)
+	case <-time.After(time.Second):
+		assert.Fail(t, "message should be received after healing the partition")
+	}
+}
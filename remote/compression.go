@@ -0,0 +1,58 @@
+package remote
+
+import (
+	"io"
+	"io/ioutil"
+	"sync"
+
+	"github.com/golang/snappy"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+const (
+	// CompressionGzip is the name of the gzip compressor, to be used with WithCompression
+	CompressionGzip = gzip.Name
+	// CompressionSnappy is the name of the snappy compressor, to be used with WithCompression
+	CompressionSnappy = "snappy"
+)
+
+func init() {
+	encoding.RegisterCompressor(newSnappyCompressor())
+}
+
+type snappyCompressor struct {
+	writers sync.Pool
+}
+
+func newSnappyCompressor() encoding.Compressor {
+	c := &snappyCompressor{}
+	c.writers.New = func() interface{} {
+		return snappy.NewBufferedWriter(ioutil.Discard)
+	}
+	return c
+}
+
+func (c *snappyCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	sw := c.writers.Get().(*snappy.Writer)
+	sw.Reset(w)
+	return &snappyWriter{Writer: sw, pool: &c.writers}, nil
+}
+
+func (c *snappyCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	return snappy.NewReader(r), nil
+}
+
+func (c *snappyCompressor) Name() string {
+	return CompressionSnappy
+}
+
+type snappyWriter struct {
+	*snappy.Writer
+	pool *sync.Pool
+}
+
+func (w *snappyWriter) Close() error {
+	defer w.pool.Put(w.Writer)
+	return w.Writer.Close()
+}
@@ -0,0 +1,38 @@
+package remote
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/encoding"
+)
+
+func TestSnappyCompressor_round_trip(t *testing.T) {
+	c := encoding.GetCompressor(CompressionSnappy)
+	if !assert.NotNil(t, c, "snappy compressor should be registered") {
+		return
+	}
+
+	data := bytes.Repeat([]byte("proto.actor "), 10000)
+	for i := 0; i < 2; i++ { // second round uses a pooled writer
+		var buf bytes.Buffer
+		w, err := c.Compress(&buf)
+		assert.NoError(t, err)
+		_, err = w.Write(data)
+		assert.NoError(t, err)
+		assert.NoError(t, w.Close())
+		assert.True(t, buf.Len() < len(data))
+
+		r, err := c.Decompress(&buf)
+		assert.NoError(t, err)
+		res, err := ioutil.ReadAll(r)
+		assert.NoError(t, err)
+		assert.Equal(t, data, res)
+	}
+}
+
+func TestGzipCompressor_registered(t *testing.T) {
+	assert.NotNil(t, encoding.GetCompressor(CompressionGzip))
+}
@@ -1,6 +1,11 @@
 package remote
 
-import "google.golang.org/grpc"
+import (
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
 
 // RemotingOption configures how the remote infrastructure is started
 type RemotingOption func(*remoteConfig)
@@ -13,15 +18,38 @@ func defaultRemoteConfig() *remoteConfig {
 		endpointManagerBatchSize: 1000,
 		endpointWriterQueueSize:  1000000,
 		endpointManagerQueueSize: 1000000,
+		endpointInitialBackoff:   2 * time.Second,
+		endpointMaxBackoff:       2 * time.Second,
+		chunkBufferSize:          defaultChunkBufferSize,
+		chunkBufferExpiry:        defaultChunkBufferExpiry,
 	}
 }
 
+// WithEndpointWriterBatchSize sets the maximum number of messages the endpoint writer sends in a single batch, the default is 1000.
+// The writer sends what is queued without waiting for a full batch, unless a linger time is set
 func WithEndpointWriterBatchSize(batchSize int) RemotingOption {
 	return func(config *remoteConfig) {
 		config.endpointWriterBatchSize = batchSize
 	}
 }
 
+// WithEndpointWriterLinger makes the endpoint writer wait up to linger for a full batch to be queued before sending
+// a partial one. This trades latency for throughput under moderate load, the default is not to wait
+func WithEndpointWriterLinger(linger time.Duration) RemotingOption {
+	return func(config *remoteConfig) {
+		config.endpointWriterLinger = linger
+	}
+}
+
+// WithEndpointWriterAdaptiveBatching makes the endpoint writer adapt the batch size to the load.
+// The batch size starts at minBatchSize and grows up to the size set by WithEndpointWriterBatchSize while messages queue up,
+// so a lingering writer waits for small batches when the load is low
+func WithEndpointWriterAdaptiveBatching(minBatchSize int) RemotingOption {
+	return func(config *remoteConfig) {
+		config.endpointWriterMinBatchSize = minBatchSize
+	}
+}
+
 func WithEndpointWriterQueueSize(queueSize int) RemotingOption {
 	return func(config *remoteConfig) {
 		config.endpointWriterQueueSize = queueSize
@@ -58,19 +86,191 @@ func WithCallOptions(options ...grpc.CallOption) RemotingOption {
 	}
 }
 
+// WithAdvertisedAddress sets the address embedded in the PIDs of this node, which other nodes use to reach it,
+// independently of the address remoting is bound to. This is needed when binding to e.g. 0.0.0.0 or when running behind NAT.
+// If the port is omitted or 0, the bound port is advertised
 func WithAdvertisedAddress(address string) RemotingOption {
 	return func(config *remoteConfig) {
 		config.advertisedAddress = address
 	}
 }
 
+// WithEndpointBackoff configures the delay before an endpoint writer reconnects after failing to connect or send.
+// The delay starts at initialBackoff and doubles with every consecutive failure, up to maxBackoff.
+// Failures count as consecutive unless the endpoint has been working for twice maxBackoff
+func WithEndpointBackoff(initialBackoff, maxBackoff time.Duration) RemotingOption {
+	return func(config *remoteConfig) {
+		config.endpointInitialBackoff = initialBackoff
+		config.endpointMaxBackoff = maxBackoff
+	}
+}
+
+// WithEndpointMaxRetries quarantines an endpoint after maxRetries consecutive failures to connect or send.
+// Messages to a quarantined endpoint go to dead letters until the quarantine is lifted, see Unquarantine.
+// A value of 0, the default, retries forever
+func WithEndpointMaxRetries(maxRetries int) RemotingOption {
+	return func(config *remoteConfig) {
+		config.endpointMaxRetries = maxRetries
+	}
+}
+
+// WithEndpointQuarantineDuration lifts quarantines automatically after the given duration.
+// A value of 0, the default, keeps endpoints quarantined until Unquarantine is called
+func WithEndpointQuarantineDuration(duration time.Duration) RemotingOption {
+	return func(config *remoteConfig) {
+		config.endpointQuarantineDuration = duration
+	}
+}
+
+// WithEndpointStatistics registers statistics receiving measurements from the remote endpoints, see NewEndpointMetrics
+func WithEndpointStatistics(statistics ...EndpointStatistics) RemotingOption {
+	return func(config *remoteConfig) {
+		config.endpointStatistics = append(config.endpointStatistics, statistics...)
+	}
+}
+
+// WithKeepAlive enables gRPC keepalive pings on the endpoint connections.
+// A ping is sent after interval without activity and the connection is closed if the ping
+// is not acknowledged within timeout. The server accepts pings sent at this interval.
+func WithKeepAlive(interval, timeout time.Duration) RemotingOption {
+	return func(config *remoteConfig) {
+		config.keepAliveInterval = interval
+		config.keepAliveTimeout = timeout
+	}
+}
+
+// WithMaxSendMessageSize sets the maximum size in bytes of a message batch sent over an endpoint.
+// The gRPC default is math.MaxInt32.
+func WithMaxSendMessageSize(size int) RemotingOption {
+	return func(config *remoteConfig) {
+		config.maxSendMessageSize = size
+	}
+}
+
+// WithMaxRecvMessageSize sets the maximum size in bytes of a message batch received over an endpoint.
+// The gRPC default is 4MB.
+func WithMaxRecvMessageSize(size int) RemotingOption {
+	return func(config *remoteConfig) {
+		config.maxRecvMessageSize = size
+	}
+}
+
+// WithCompression compresses the endpoint streams using the named compressor,
+// see CompressionGzip and CompressionSnappy.
+// Both sides need to be able to decompress the data, which is the case for all built-in compressors.
+func WithCompression(name string) RemotingOption {
+	return func(config *remoteConfig) {
+		config.compression = name
+	}
+}
+
+// WithMessageChunkSize splits serialized messages larger than size bytes into chunks of at most size bytes,
+// which are reassembled by the receiving node. Each batch carries one chunk of a message, so the messages to other
+// actors are not held up by a large message, the messages to the same actor are sent after it.
+// This keeps large messages below the gRPC message size limit. Chunking is disabled when size is 0, the default
+func WithMessageChunkSize(size int) RemotingOption {
+	return func(config *remoteConfig) {
+		config.messageChunkSize = size
+	}
+}
+
+// WithChunkBufferLimits limits the reassembly of the chunked messages received on an endpoint stream.
+// The chunks buffered take at most size bytes, the message whose chunk exceeds it is dropped. The messages which
+// receive no chunk within expiry are dropped. The defaults are 64 MiB and a minute, zero disables a limit
+func WithChunkBufferLimits(size int, expiry time.Duration) RemotingOption {
+	return func(config *remoteConfig) {
+		config.chunkBufferSize = size
+		config.chunkBufferExpiry = expiry
+	}
+}
+
 type remoteConfig struct {
-	advertisedAddress        string
-	serverOptions            []grpc.ServerOption
-	callOptions              []grpc.CallOption
-	dialOptions              []grpc.DialOption
-	endpointWriterBatchSize  int
-	endpointWriterQueueSize  int
-	endpointManagerBatchSize int
-	endpointManagerQueueSize int
+	advertisedAddress          string
+	serverOptions              []grpc.ServerOption
+	callOptions                []grpc.CallOption
+	dialOptions                []grpc.DialOption
+	endpointWriterBatchSize    int
+	endpointWriterQueueSize    int
+	endpointWriterMinBatchSize int
+	endpointWriterLinger       time.Duration
+	endpointManagerBatchSize   int
+	endpointManagerQueueSize   int
+	endpointInitialBackoff     time.Duration
+	endpointMaxBackoff         time.Duration
+	endpointMaxRetries         int
+	endpointQuarantineDuration time.Duration
+	endpointStatistics         []EndpointStatistics
+	keepAliveInterval          time.Duration
+	keepAliveTimeout           time.Duration
+	maxSendMessageSize         int
+	maxRecvMessageSize         int
+	compression                string
+	messageChunkSize           int
+	chunkBufferSize            int
+	chunkBufferExpiry          time.Duration
+	authenticator              Authenticator
+	envelopeAuthorizer         EnvelopeAuthorizer
+	credentials                CredentialsProvider
+	transport                  Transport
+	heartbeatInterval          time.Duration
+	heartbeatThreshold         float64
+}
+
+func (config *remoteConfig) endpointWriterBatching() endpointWriterBatching {
+	return endpointWriterBatching{
+		maxBatchSize: config.endpointWriterBatchSize,
+		minBatchSize: config.endpointWriterMinBatchSize,
+		linger:       config.endpointWriterLinger,
+	}
+}
+
+// serverOptionsWithTuning returns the user supplied server options followed by the ones derived from the tuning options
+func (config *remoteConfig) serverOptionsWithTuning() []grpc.ServerOption {
+	options := append([]grpc.ServerOption{}, config.serverOptions...)
+	if config.keepAliveInterval > 0 {
+		options = append(options,
+			grpc.KeepaliveParams(keepalive.ServerParameters{
+				Time:    config.keepAliveInterval,
+				Timeout: config.keepAliveTimeout,
+			}),
+			grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+				MinTime:             config.keepAliveInterval,
+				PermitWithoutStream: true,
+			}))
+	}
+	if config.maxSendMessageSize > 0 {
+		options = append(options, grpc.MaxSendMsgSize(config.maxSendMessageSize))
+	}
+	if config.maxRecvMessageSize > 0 {
+		options = append(options, grpc.MaxRecvMsgSize(config.maxRecvMessageSize))
+	}
+	return options
+}
+
+// dialOptionsWithTuning returns the user supplied dial options followed by the ones derived from the tuning options
+func (config *remoteConfig) dialOptionsWithTuning() []grpc.DialOption {
+	options := append([]grpc.DialOption{}, config.dialOptions...)
+	if config.keepAliveInterval > 0 {
+		options = append(options, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                config.keepAliveInterval,
+			Timeout:             config.keepAliveTimeout,
+			PermitWithoutStream: true,
+		}))
+	}
+	return options
+}
+
+// callOptionsWithTuning returns the user supplied call options followed by the ones derived from the tuning options
+func (config *remoteConfig) callOptionsWithTuning() []grpc.CallOption {
+	options := append([]grpc.CallOption{}, config.callOptions...)
+	if config.maxSendMessageSize > 0 {
+		options = append(options, grpc.MaxCallSendMsgSize(config.maxSendMessageSize))
+	}
+	if config.maxRecvMessageSize > 0 {
+		options = append(options, grpc.MaxCallRecvMsgSize(config.maxRecvMessageSize))
+	}
+	if config.compression != "" {
+		options = append(options, grpc.UseCompressor(config.compression))
+	}
+	return options
 }
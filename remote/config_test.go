@@ -0,0 +1,40 @@
+package remote
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoteConfig_DefaultsAddNoTuningOptions(t *testing.T) {
+	config := defaultRemoteConfig()
+
+	assert.Len(t, config.serverOptionsWithTuning(), 0)
+	assert.Len(t, config.dialOptionsWithTuning(), 1)
+	assert.Len(t, config.callOptionsWithTuning(), 0)
+}
+
+func TestRemoteConfig_TuningOptions(t *testing.T) {
+	config := defaultRemoteConfig()
+	for _, option := range []RemotingOption{
+		WithKeepAlive(10*time.Second, 3*time.Second),
+		WithMaxSendMessageSize(16 * 1024 * 1024),
+		WithMaxRecvMessageSize(32 * 1024 * 1024),
+		WithCompression(CompressionSnappy),
+	} {
+		option(config)
+	}
+
+	assert.Equal(t, 10*time.Second, config.keepAliveInterval)
+	assert.Equal(t, 3*time.Second, config.keepAliveTimeout)
+	assert.Equal(t, 16*1024*1024, config.maxSendMessageSize)
+	assert.Equal(t, 32*1024*1024, config.maxRecvMessageSize)
+
+	// keepalive params, enforcement policy, max send and max receive size
+	assert.Len(t, config.serverOptionsWithTuning(), 4)
+	// insecure and keepalive params
+	assert.Len(t, config.dialOptionsWithTuning(), 2)
+	// max send size, max receive size and compressor
+	assert.Len(t, config.callOptionsWithTuning(), 3)
+}
@@ -0,0 +1,148 @@
+package remote
+
+import (
+	"math"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+	"github.com/AsynkronIT/protoactor-go/log"
+	"github.com/AsynkronIT/protoactor-go/scheduler"
+)
+
+// WithHeartbeat sends a heartbeat to every connected node at the given interval and terminates the endpoint
+// when the phi accrual failure detector suspects the node, so remote watchers receive Terminated
+// without waiting for the connection to time out. A higher threshold detects failures later, but has fewer false positives,
+// 8 is a common choice. Nodes running an older version, which do not tell they answer heartbeats when connecting,
+// are not sent heartbeats and are not suspected
+func WithHeartbeat(interval time.Duration, threshold float64) RemotingOption {
+	return func(config *remoteConfig) {
+		config.heartbeatInterval = interval
+		config.heartbeatThreshold = threshold
+	}
+}
+
+// heartbeatMaxSamples is the number of heartbeat intervals the failure detector bases its estimate on
+const heartbeatMaxSamples = 200
+
+// phiAccrualDetector is a phi accrual failure detector as described by Hayashibara et al.
+// phi expresses the suspicion that a node failed, phi = 1 means a 10% chance of a false positive, phi = 2 1% and so on
+type phiAccrualDetector struct {
+	intervals []float64
+	next      int
+	minStdDev float64
+	last      time.Time
+	seed      float64
+}
+
+func newPhiAccrualDetector(expectedInterval time.Duration) *phiAccrualDetector {
+	return &phiAccrualDetector{
+		intervals: make([]float64, 0, heartbeatMaxSamples),
+		minStdDev: float64(expectedInterval) / 4,
+		seed:      float64(expectedInterval),
+	}
+}
+
+// heartbeat records the arrival of a heartbeat
+func (d *phiAccrualDetector) heartbeat(now time.Time) {
+	if d.last.IsZero() {
+		// seed the estimate with the expected interval
+		d.add(d.seed)
+	} else {
+		d.add(float64(now.Sub(d.last)))
+	}
+	d.last = now
+}
+
+func (d *phiAccrualDetector) add(interval float64) {
+	if len(d.intervals) < cap(d.intervals) {
+		d.intervals = append(d.intervals, interval)
+		return
+	}
+	d.intervals[d.next] = interval
+	d.next = (d.next + 1) % len(d.intervals)
+}
+
+// phi returns the suspicion level at the given time, it is 0 until the first heartbeat arrived
+func (d *phiAccrualDetector) phi(now time.Time) float64 {
+	if d.last.IsZero() {
+		return 0
+	}
+
+	var sum float64
+	for _, interval := range d.intervals {
+		sum += interval
+	}
+	mean := sum / float64(len(d.intervals))
+	var variance float64
+	for _, interval := range d.intervals {
+		variance += (interval - mean) * (interval - mean)
+	}
+	stdDev := math.Max(math.Sqrt(variance/float64(len(d.intervals))), d.minStdDev)
+
+	// logistic approximation of the cumulative normal distribution
+	elapsed := float64(now.Sub(d.last))
+	y := (elapsed - mean) / stdDev
+	e := math.Exp(-y * (1.5976 + 0.070566*y*y))
+	if elapsed > mean {
+		return -math.Log10(e / (1 + e))
+	}
+	return -math.Log10(1 - 1/(1+e))
+}
+
+type heartbeatTick struct{}
+
+type heartbeatActor struct {
+	interval  time.Duration
+	threshold float64
+	detectors map[string]*phiAccrualDetector
+	cancel    scheduler.CancelFunc
+}
+
+func newHeartbeatActor(interval time.Duration, threshold float64) actor.Producer {
+	return func() actor.Actor {
+		return &heartbeatActor{
+			interval:  interval,
+			threshold: threshold,
+			detectors: make(map[string]*phiAccrualDetector),
+		}
+	}
+}
+
+func (state *heartbeatActor) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *actor.Started:
+		state.cancel = scheduler.NewTimerScheduler(scheduler.WithContext(ctx)).
+			SendRepeatedly(state.interval, state.interval, ctx.Self(), &heartbeatTick{})
+	case *actor.Stopping:
+		state.cancel()
+	case *EndpointConnectedEvent:
+		// older nodes fail to deserialize the heartbeats and close the connection
+		if !msg.Heartbeat {
+			return
+		}
+		if _, ok := state.detectors[msg.Address]; !ok {
+			state.detectors[msg.Address] = newPhiAccrualDetector(state.interval)
+		}
+	case *EndpointTerminatedEvent:
+		delete(state.detectors, msg.Address)
+	case *HeartbeatResponse:
+		if ctx.Sender() == nil {
+			return
+		}
+		if detector, ok := state.detectors[ctx.Sender().Address]; ok {
+			detector.heartbeat(time.Now())
+		}
+	case *heartbeatTick:
+		now := time.Now()
+		for address, detector := range state.detectors {
+			if phi := detector.phi(now); phi > state.threshold {
+				plog.Info("Heartbeat failure detector suspects endpoint", log.String("address", address), log.Float64("phi", phi))
+				delete(state.detectors, address)
+				eventstream.Publish(&EndpointTerminatedEvent{Address: address})
+				continue
+			}
+			ctx.Request(ActivatorForAddress(address), &HeartbeatRequest{})
+		}
+	}
+}
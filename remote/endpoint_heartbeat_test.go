@@ -0,0 +1,95 @@
+package remote
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPhiAccrualDetector(t *testing.T) {
+	d := newPhiAccrualDetector(time.Second)
+	now := time.Now()
+	assert.Equal(t, 0.0, d.phi(now.Add(time.Hour)), "phi should be 0 before the first heartbeat")
+
+	for i := 0; i < 10; i++ {
+		now = now.Add(time.Second)
+		d.heartbeat(now)
+	}
+
+	assert.True(t, d.phi(now.Add(500*time.Millisecond)) < 1)
+	assert.True(t, d.phi(now.Add(time.Second)) < 1)
+	assert.True(t, d.phi(now.Add(2*time.Second)) > 1)
+	assert.True(t, d.phi(now.Add(5*time.Second)) > 8)
+	assert.True(t, d.phi(now.Add(2*time.Second)) < d.phi(now.Add(3*time.Second)), "phi should grow with the time since the last heartbeat")
+}
+
+func TestPhiAccrualDetector_Window(t *testing.T) {
+	d := newPhiAccrualDetector(time.Second)
+	now := time.Now()
+	for i := 0; i < heartbeatMaxSamples*2; i++ {
+		now = now.Add(time.Second)
+		d.heartbeat(now)
+	}
+	assert.Len(t, d.intervals, heartbeatMaxSamples)
+}
+
+func TestHeartbeatActor_TerminatesSilentEndpoint(t *testing.T) {
+	address := "192.0.2.1:8080"
+	terminated := make(chan *EndpointTerminatedEvent, 1)
+	sub := eventstream.Subscribe(func(evt interface{}) {
+		if e, ok := evt.(*EndpointTerminatedEvent); ok && e.Address == address {
+			terminated <- e
+		}
+	})
+	defer eventstream.Unsubscribe(sub)
+
+	props := actor.PropsFromProducer(newHeartbeatActor(10*time.Millisecond, 8))
+	pid := rootContext.Spawn(props)
+	defer rootContext.Stop(pid)
+
+	rootContext.Send(pid, &EndpointConnectedEvent{Address: address, Heartbeat: true})
+	select {
+	case <-terminated:
+		t.Fatal("endpoint which never answered a heartbeat should not be terminated")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// a single response, then the node goes silent
+	rootContext.RequestWithCustomSender(pid, &HeartbeatResponse{}, ActivatorForAddress(address))
+	select {
+	case <-terminated:
+	case <-time.After(5 * time.Second):
+		t.Fatal("silent endpoint should be terminated")
+	}
+}
+
+func TestHeartbeatActor_SkipsOlderNodes(t *testing.T) {
+	older, newer := "192.0.2.2:8080", "192.0.2.3:8080"
+	requested := make(chan string, 100)
+	sub := eventstream.Subscribe(func(evt interface{}) {
+		if e, ok := evt.(*actor.DeadLetterEvent); ok {
+			if _, ok := e.Message.(*HeartbeatRequest); ok {
+				requested <- e.PID.Address
+			}
+		}
+	})
+
+	props := actor.PropsFromProducer(newHeartbeatActor(10*time.Millisecond, 8))
+	pid := rootContext.Spawn(props)
+
+	rootContext.Send(pid, &EndpointConnectedEvent{Address: older})
+	rootContext.Send(pid, &EndpointConnectedEvent{Address: newer, Heartbeat: true})
+	time.Sleep(100 * time.Millisecond)
+	rootContext.StopFuture(pid).Wait()
+	eventstream.Unsubscribe(sub)
+	close(requested)
+
+	addresses := make(map[string]bool)
+	for address := range requested {
+		addresses[address] = true
+	}
+	assert.Equal(t, map[string]bool{newer: true}, addresses, "heartbeats should only be sent to nodes answering them")
+}
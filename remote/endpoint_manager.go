@@ -3,11 +3,14 @@ package remote
 import (
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/AsynkronIT/protoactor-go/mailbox"
 
 	"github.com/AsynkronIT/protoactor-go/actor"
 	"github.com/AsynkronIT/protoactor-go/eventstream"
+	"github.com/AsynkronIT/protoactor-go/internal/chaos"
+	"github.com/AsynkronIT/protoactor-go/log"
 )
 
 var endpointManager *endpointManagerValue
@@ -27,6 +30,8 @@ type endpointManagerValue struct {
 	config             *remoteConfig
 	endpointSupervisor *actor.PID
 	endpointSub        *eventstream.Subscription
+	quarantine         *quarantine
+	heartbeat          *actor.PID
 }
 
 func startEndpointManager(config *remoteConfig) {
@@ -42,6 +47,12 @@ func startEndpointManager(config *remoteConfig) {
 		connections:        &sync.Map{},
 		config:             config,
 		endpointSupervisor: endpointSupervisor,
+		quarantine:         newQuarantine(config.endpointQuarantineDuration),
+	}
+
+	if config.heartbeatInterval > 0 {
+		props := actor.PropsFromProducer(newHeartbeatActor(config.heartbeatInterval, config.heartbeatThreshold))
+		endpointManager.heartbeat, _ = rootContext.SpawnNamed(props, "Heartbeat")
 	}
 
 	endpointManager.endpointSub = eventstream.
@@ -57,6 +68,10 @@ func startEndpointManager(config *remoteConfig) {
 
 func stopEndpointManager() {
 	eventstream.Unsubscribe(endpointManager.endpointSub)
+	if endpointManager.heartbeat != nil {
+		rootContext.StopFuture(endpointManager.heartbeat).Wait()
+		endpointManager.heartbeat = nil
+	}
 	rootContext.StopFuture(endpointManager.endpointSupervisor).Wait()
 	endpointManager.endpointSub = nil
 	endpointManager.connections = nil
@@ -64,10 +79,15 @@ func stopEndpointManager() {
 }
 
 func (em *endpointManagerValue) endpointEvent(evn interface{}) {
+	if em.heartbeat != nil {
+		rootContext.Send(em.heartbeat, evn)
+	}
 	switch msg := evn.(type) {
 	case *EndpointTerminatedEvent:
+		em.stateChanged(msg.Address, EndpointTerminated)
 		em.removeEndpoint(msg)
 	case *EndpointConnectedEvent:
+		em.stateChanged(msg.Address, EndpointConnected)
 		endpoint := em.ensureConnected(msg.Address)
 		rootContext.Send(endpoint.watcher, msg)
 	}
@@ -81,6 +101,10 @@ func (em *endpointManagerValue) remoteTerminate(msg *remoteTerminate) {
 
 func (em *endpointManagerValue) remoteWatch(msg *remoteWatch) {
 	address := msg.Watchee.Address
+	if em.quarantine.contains(address) || chaos.IsPartitioned(address) {
+		em.terminateWatch(msg)
+		return
+	}
 	endpoint := em.ensureConnected(address)
 	rootContext.Send(endpoint.watcher, msg)
 }
@@ -93,10 +117,34 @@ func (em *endpointManagerValue) remoteUnwatch(msg *remoteUnwatch) {
 
 func (em *endpointManagerValue) remoteDeliver(msg *remoteDeliver) {
 	address := msg.target.Address
+	if em.quarantine.contains(address) {
+		em.deliverToDeadLetter(msg)
+		return
+	}
+	if dropped, delay := chaos.Intercept(address); dropped {
+		return
+	} else if delay > 0 {
+		time.AfterFunc(delay, func() { em.deliver(msg) })
+		return
+	}
+	em.deliver(msg)
+}
+
+func (em *endpointManagerValue) deliver(msg *remoteDeliver) {
+	address := msg.target.Address
+	for _, stats := range em.config.endpointStatistics {
+		stats.MessageQueued(address)
+	}
 	endpoint := em.ensureConnected(address)
 	rootContext.Send(endpoint.writer, msg)
 }
 
+func (em *endpointManagerValue) stateChanged(address string, state EndpointState) {
+	for _, stats := range em.config.endpointStatistics {
+		stats.EndpointStateChanged(address, state)
+	}
+}
+
 func (em *endpointManagerValue) ensureConnected(address string) *endpoint {
 	e, ok := em.connections.Load(address)
 	if !ok {
@@ -132,13 +180,21 @@ func (em *endpointManagerValue) removeEndpoint(msg *EndpointTerminatedEvent) {
 	}
 }
 
-type endpointSupervisor struct{}
+type endpointSupervisor struct {
+	addresses map[string]string // endpoint writer ID to address
+}
 
 func newEndpointSupervisor() actor.Actor {
-	return &endpointSupervisor{}
+	return &endpointSupervisor{
+		addresses: make(map[string]string),
+	}
 }
 
 func (state *endpointSupervisor) Receive(ctx actor.Context) {
+	if msg, ok := ctx.Message().(*actor.Terminated); ok {
+		delete(state.addresses, msg.Who.Id)
+		return
+	}
 	if address, ok := ctx.Message().(string); ok {
 		e := &endpoint{
 			writer:  state.spawnEndpointWriter(address, ctx),
@@ -149,14 +205,49 @@ func (state *endpointSupervisor) Receive(ctx actor.Context) {
 }
 
 func (state *endpointSupervisor) HandleFailure(supervisor actor.Supervisor, child *actor.PID, rs *actor.RestartStatistics, reason interface{}, message interface{}) {
-	supervisor.RestartChildren(child)
+	address, isWriter := state.addresses[child.Id]
+	if !isWriter {
+		supervisor.RestartChildren(child)
+		return
+	}
+
+	config := endpointManager.config
+	if rs.NumberOfFailures(2*config.endpointMaxBackoff) == 0 {
+		rs.Reset()
+	}
+	rs.Fail()
+
+	if config.endpointMaxRetries > 0 && rs.FailureCount() > config.endpointMaxRetries {
+		plog.Error("EndpointWriter exceeded max retries, quarantining endpoint", log.String("address", address), log.Int("retries", config.endpointMaxRetries))
+		delete(state.addresses, child.Id)
+		supervisor.StopChildren(child)
+		endpointManager.quarantineEndpoint(address)
+		return
+	}
+
+	time.AfterFunc(endpointBackoff(rs.FailureCount(), config.endpointInitialBackoff, config.endpointMaxBackoff), func() {
+		supervisor.RestartChildren(child)
+	})
+}
+
+// endpointBackoff returns the delay before the given attempt, doubling from initial up to max
+func endpointBackoff(failureCount int, initial, max time.Duration) time.Duration {
+	backoff := initial
+	for i := 1; i < failureCount && backoff < max; i++ {
+		backoff *= 2
+	}
+	if backoff > max {
+		backoff = max
+	}
+	return backoff
 }
 
 func (state *endpointSupervisor) spawnEndpointWriter(address string, ctx actor.Context) *actor.PID {
 	props := actor.
 		PropsFromProducer(endpointWriterProducer(address, endpointManager.config)).
-		WithMailbox(endpointWriterMailboxProducer(endpointManager.config.endpointWriterBatchSize, endpointManager.config.endpointWriterQueueSize))
+		WithMailbox(endpointWriterMailboxProducer(endpointManager.config.endpointWriterBatching(), endpointManager.config.endpointWriterQueueSize))
 	pid := ctx.Spawn(props)
+	state.addresses[pid.Id] = address
 	return pid
 }
 
@@ -0,0 +1,119 @@
+package remote
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+)
+
+// EndpointQuarantinedEvent is published when an endpoint is quarantined after exceeding its reconnect retries
+type EndpointQuarantinedEvent struct {
+	Address string
+}
+
+// QuarantinedEndpoint describes a remote address that messages are currently not delivered to
+type QuarantinedEndpoint struct {
+	Address string
+	Since   time.Time
+}
+
+type quarantine struct {
+	mutex     sync.RWMutex
+	endpoints map[string]*QuarantinedEndpoint
+	duration  time.Duration
+}
+
+func newQuarantine(duration time.Duration) *quarantine {
+	return &quarantine{
+		endpoints: make(map[string]*QuarantinedEndpoint),
+		duration:  duration,
+	}
+}
+
+func (q *quarantine) add(address string) {
+	q.mutex.Lock()
+	q.endpoints[address] = &QuarantinedEndpoint{Address: address, Since: time.Now()}
+	q.mutex.Unlock()
+}
+
+func (q *quarantine) remove(address string) bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	_, ok := q.endpoints[address]
+	delete(q.endpoints, address)
+	return ok
+}
+
+func (q *quarantine) contains(address string) bool {
+	q.mutex.RLock()
+	e, ok := q.endpoints[address]
+	q.mutex.RUnlock()
+	if ok && q.expired(e) {
+		q.remove(address)
+		return false
+	}
+	return ok
+}
+
+func (q *quarantine) expired(e *QuarantinedEndpoint) bool {
+	return q.duration > 0 && time.Since(e.Since) >= q.duration
+}
+
+func (q *quarantine) list() []*QuarantinedEndpoint {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+	res := make([]*QuarantinedEndpoint, 0, len(q.endpoints))
+	for _, e := range q.endpoints {
+		if !q.expired(e) {
+			c := *e
+			res = append(res, &c)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Address < res[j].Address })
+	return res
+}
+
+// QuarantinedEndpoints returns the remote addresses that are currently quarantined
+func QuarantinedEndpoints() []*QuarantinedEndpoint {
+	if endpointManager == nil {
+		return nil
+	}
+	return endpointManager.quarantine.list()
+}
+
+// Unquarantine lifts the quarantine of the given address, so that messages are delivered to it again.
+// It returns false if the address was not quarantined
+func Unquarantine(address string) bool {
+	if endpointManager == nil {
+		return false
+	}
+	return endpointManager.quarantine.remove(address)
+}
+
+// quarantineEndpoint stops delivering messages to the address and terminates the current endpoint
+func (em *endpointManagerValue) quarantineEndpoint(address string) {
+	em.quarantine.add(address)
+	eventstream.Publish(&EndpointQuarantinedEvent{Address: address})
+	eventstream.Publish(&EndpointTerminatedEvent{Address: address})
+	em.stateChanged(address, EndpointQuarantined)
+}
+
+func (em *endpointManagerValue) deliverToDeadLetter(msg *remoteDeliver) {
+	eventstream.Publish(&actor.DeadLetterEvent{
+		PID:     msg.target,
+		Message: msg.message,
+		Sender:  msg.sender,
+	})
+}
+
+func (em *endpointManagerValue) terminateWatch(msg *remoteWatch) {
+	if ref, ok := actor.ProcessRegistry.GetLocal(msg.Watcher.Id); ok {
+		ref.SendSystemMessage(msg.Watcher, &actor.Terminated{
+			Who:               msg.Watchee,
+			AddressTerminated: true,
+		})
+	}
+}
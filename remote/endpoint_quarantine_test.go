@@ -0,0 +1,100 @@
+package remote
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpointBackoff(t *testing.T) {
+	initial, max := 100*time.Millisecond, time.Second
+
+	assert.Equal(t, 100*time.Millisecond, endpointBackoff(1, initial, max))
+	assert.Equal(t, 200*time.Millisecond, endpointBackoff(2, initial, max))
+	assert.Equal(t, 800*time.Millisecond, endpointBackoff(4, initial, max))
+	assert.Equal(t, time.Second, endpointBackoff(5, initial, max))
+	assert.Equal(t, time.Second, endpointBackoff(100, initial, max))
+}
+
+func TestQuarantine_list_and_remove(t *testing.T) {
+	q := newQuarantine(0)
+	q.add("b:1")
+	q.add("a:1")
+
+	assert.True(t, q.contains("a:1"))
+	list := q.list()
+	if assert.Len(t, list, 2) {
+		assert.Equal(t, "a:1", list[0].Address)
+		assert.Equal(t, "b:1", list[1].Address)
+	}
+
+	assert.True(t, q.remove("a:1"))
+	assert.False(t, q.remove("a:1"))
+	assert.False(t, q.contains("a:1"))
+}
+
+func TestQuarantine_expires(t *testing.T) {
+	q := newQuarantine(10 * time.Millisecond)
+	q.add("a:1")
+	assert.True(t, q.contains("a:1"))
+
+	time.Sleep(20 * time.Millisecond)
+	assert.Empty(t, q.list())
+	assert.False(t, q.contains("a:1"))
+}
+
+func TestEndpointManager_quarantined_delivers_to_dead_letter(t *testing.T) {
+	em := &endpointManagerValue{
+		connections: &sync.Map{},
+		quarantine:  newQuarantine(0),
+	}
+	em.quarantine.add("192.0.2.1:1234")
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	target := actor.NewPID("192.0.2.1:1234", "foo")
+	sub := eventstream.Subscribe(func(evt interface{}) {
+		if dl, ok := evt.(*actor.DeadLetterEvent); ok && dl.PID == target {
+			assert.Equal(t, "hello", dl.Message)
+			wg.Done()
+		}
+	})
+	defer eventstream.Unsubscribe(sub)
+
+	em.remoteDeliver(&remoteDeliver{target: target, message: "hello", serializerID: -1})
+	wg.Wait()
+
+	_, connected := em.connections.Load("192.0.2.1:1234")
+	assert.False(t, connected, "Quarantined endpoint should not be connected")
+}
+
+func TestEndpointManager_quarantined_terminates_watch(t *testing.T) {
+	em := &endpointManagerValue{
+		connections: &sync.Map{},
+		quarantine:  newQuarantine(0),
+	}
+	em.quarantine.add("192.0.2.1:1234")
+
+	watchee := actor.NewPID("192.0.2.1:1234", "foo")
+	terminated := make(chan *actor.Terminated, 1)
+	watcher := rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		if msg, ok := ctx.Message().(*actor.Terminated); ok {
+			terminated <- msg
+		}
+	}))
+	defer rootContext.Stop(watcher)
+
+	em.remoteWatch(&remoteWatch{Watcher: watcher, Watchee: watchee})
+
+	select {
+	case msg := <-terminated:
+		assert.Equal(t, watchee, msg.Who)
+		assert.True(t, msg.AddressTerminated)
+	case <-time.After(time.Second):
+		assert.Fail(t, "watcher should receive Terminated")
+	}
+}
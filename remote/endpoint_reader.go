@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/internal/chaos"
 	"github.com/AsynkronIT/protoactor-go/log"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
@@ -11,30 +12,60 @@ import (
 )
 
 type endpointReader struct {
-	suspended bool
+	suspended          bool
+	statistics         []EndpointStatistics
+	authenticator      Authenticator
+	envelopeAuthorizer EnvelopeAuthorizer
+	chunkBufferSize    int
+	chunkBufferExpiry  time.Duration
+}
+
+// authenticate returns the peer of the connection, or an Unauthenticated status if the peer is rejected
+func (s *endpointReader) authenticate(ctx context.Context) (*PeerInfo, error) {
+	peer := peerInfoFromContext(ctx)
+	if s.authenticator == nil {
+		return peer, nil
+	}
+	if err := s.authenticator(peer); err != nil {
+		plog.Info("EndpointReader rejected peer", log.String("address", peer.Address), log.Error(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return peer, nil
 }
 
 func (s *endpointReader) Connect(ctx context.Context, req *ConnectRequest) (*ConnectResponse, error) {
 	if s.suspended {
 		return nil, status.Error(codes.Canceled, "Suspended")
 	}
+	if _, err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
 
-	return &ConnectResponse{DefaultSerializerId: DefaultSerializerID}, nil
+	return &ConnectResponse{DefaultSerializerId: DefaultSerializerID, Heartbeat: true}, nil
 }
 
-func (s *endpointReader) Receive(stream Remoting_ReceiveServer) error {
+func (s *endpointReader) ReceiveBatches(ctx context.Context, recv func() (*MessageBatch, error)) error {
+	peer, err := s.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
 	targets := make([]*actor.PID, 100)
+	chunks := newChunkAssembler(s.chunkBufferSize, s.chunkBufferExpiry)
 	for {
 		if s.suspended {
 			time.Sleep(time.Millisecond * 500)
 			continue
 		}
 
-		batch, err := stream.Recv()
+		batch, err := recv()
 		if err != nil {
 			plog.Debug("EndpointReader failed to read", log.Error(err))
 			return err
 		}
+		if chaos.IsPartitioned(peer.nodeAddress()) {
+			continue
+		}
 
 		// only grow pid lookup if needed
 		if len(batch.TargetNames) > len(targets) {
@@ -47,15 +78,53 @@ func (s *endpointReader) Receive(stream Remoting_ReceiveServer) error {
 
 		for _, envelope := range batch.Envelopes {
 			pid := targets[envelope.Target]
-			message, err := Deserialize(envelope.MessageData, batch.TypeNames[envelope.TypeId], envelope.SerializerId)
+			var start time.Time
+			if len(s.statistics) > 0 {
+				start = time.Now()
+			}
+			data := envelope.MessageData
+			if envelope.Chunk != nil {
+				var complete bool
+				data, complete, err = chunks.add(envelope.Chunk, data)
+				if err == errChunkDropped {
+					plog.Info("EndpointReader dropped chunked message", log.String("address", peer.Address), log.Int64("id", envelope.Chunk.Id), log.Error(err))
+					continue
+				}
+				if err != nil {
+					plog.Debug("EndpointReader failed to reassemble chunks", log.Error(err))
+					return err
+				}
+				if !complete {
+					continue
+				}
+			}
+			typeName := batch.TypeNames[envelope.TypeId]
+			message, err := Deserialize(data, typeName, envelope.SerializerId)
 			if err != nil {
 				plog.Debug("EndpointReader failed to deserialize", log.Error(err))
 				return err
 			}
-			// if message is system message send it as sysmsg instead of usermsg
-
+			if len(s.statistics) > 0 {
+				elapsed := time.Since(start)
+				for _, stats := range s.statistics {
+					stats.MessageDeserialized(typeName, elapsed)
+				}
+			}
 			sender := envelope.Sender
 
+			if s.envelopeAuthorizer != nil {
+				var header map[string]string
+				if envelope.MessageHeader != nil {
+					header = envelope.MessageHeader.HeaderData
+				}
+				if err := s.envelopeAuthorizer(peer, pid, message, header); err != nil {
+					plog.Info("EndpointReader dropped unauthorized message", log.String("address", peer.Address), log.Stringer("target", pid), log.TypeOf("type", message), log.Error(err))
+					continue
+				}
+			}
+
+			// if message is system message send it as sysmsg instead of usermsg
+
 			switch msg := message.(type) {
 			case *actor.Terminated:
 				rt := &remoteTerminate{
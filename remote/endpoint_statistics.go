@@ -0,0 +1,173 @@
+package remote
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// EndpointState describes the connection state of a remote endpoint
+type EndpointState int32
+
+const (
+	EndpointConnecting EndpointState = iota
+	EndpointConnected
+	EndpointTerminated
+	EndpointQuarantined
+)
+
+func (s EndpointState) String() string {
+	switch s {
+	case EndpointConnecting:
+		return "Connecting"
+	case EndpointConnected:
+		return "Connected"
+	case EndpointTerminated:
+		return "Terminated"
+	case EndpointQuarantined:
+		return "Quarantined"
+	}
+	return "Unknown"
+}
+
+// EndpointStatistics receives measurements from the remote endpoint readers and writers.
+//
+// Implementations are called concurrently from different endpoints and must be thread safe
+type EndpointStatistics interface {
+	// MessageQueued is called when a message is queued for delivery to a remote address
+	MessageQueued(address string)
+	// BatchSent is called when a batch of messages has been written to a remote address
+	BatchSent(address string, size int, duration time.Duration)
+	// MessageSerialized is called when an outgoing message has been serialized
+	MessageSerialized(typeName string, duration time.Duration)
+	// MessageDeserialized is called when an incoming message has been deserialized
+	MessageDeserialized(typeName string, duration time.Duration)
+	// EndpointStateChanged is called when the connection to a remote address changes state
+	EndpointStateChanged(address string, state EndpointState)
+}
+
+// EndpointMetrics is an EndpointStatistics implementation aggregating the measurements in memory,
+// exposing them through Snapshot
+type EndpointMetrics struct {
+	mutex         sync.Mutex
+	endpoints     map[string]*EndpointMetricsSnapshot
+	serialization map[string]*SerializationMetricsSnapshot
+}
+
+// EndpointMetricsSnapshot holds the aggregated measurements of a remote address
+type EndpointMetricsSnapshot struct {
+	Address          string
+	State            EndpointState
+	MessagesQueued   int64
+	MessagesSent     int64
+	BatchesSent      int64
+	TotalSendLatency time.Duration
+}
+
+// QueueDepth returns the number of messages queued but not yet sent
+func (s *EndpointMetricsSnapshot) QueueDepth() int64 {
+	return s.MessagesQueued - s.MessagesSent
+}
+
+// AverageBatchSize returns the average number of messages per batch
+func (s *EndpointMetricsSnapshot) AverageBatchSize() float64 {
+	if s.BatchesSent == 0 {
+		return 0
+	}
+	return float64(s.MessagesSent) / float64(s.BatchesSent)
+}
+
+// SerializationMetricsSnapshot holds the aggregated serialization measurements of a message type
+type SerializationMetricsSnapshot struct {
+	TypeName                 string
+	Serialized               int64
+	TotalSerializationTime   time.Duration
+	Deserialized             int64
+	TotalDeserializationTime time.Duration
+}
+
+// NewEndpointMetrics creates an empty EndpointMetrics, pass it to WithEndpointStatistics to start collecting
+func NewEndpointMetrics() *EndpointMetrics {
+	return &EndpointMetrics{
+		endpoints:     make(map[string]*EndpointMetricsSnapshot),
+		serialization: make(map[string]*SerializationMetricsSnapshot),
+	}
+}
+
+func (m *EndpointMetrics) endpoint(address string) *EndpointMetricsSnapshot {
+	e, ok := m.endpoints[address]
+	if !ok {
+		e = &EndpointMetricsSnapshot{Address: address}
+		m.endpoints[address] = e
+	}
+	return e
+}
+
+func (m *EndpointMetrics) messageType(typeName string) *SerializationMetricsSnapshot {
+	s, ok := m.serialization[typeName]
+	if !ok {
+		s = &SerializationMetricsSnapshot{TypeName: typeName}
+		m.serialization[typeName] = s
+	}
+	return s
+}
+
+func (m *EndpointMetrics) MessageQueued(address string) {
+	m.mutex.Lock()
+	m.endpoint(address).MessagesQueued++
+	m.mutex.Unlock()
+}
+
+func (m *EndpointMetrics) BatchSent(address string, size int, duration time.Duration) {
+	m.mutex.Lock()
+	e := m.endpoint(address)
+	e.MessagesSent += int64(size)
+	e.BatchesSent++
+	e.TotalSendLatency += duration
+	m.mutex.Unlock()
+}
+
+func (m *EndpointMetrics) MessageSerialized(typeName string, duration time.Duration) {
+	m.mutex.Lock()
+	s := m.messageType(typeName)
+	s.Serialized++
+	s.TotalSerializationTime += duration
+	m.mutex.Unlock()
+}
+
+func (m *EndpointMetrics) MessageDeserialized(typeName string, duration time.Duration) {
+	m.mutex.Lock()
+	s := m.messageType(typeName)
+	s.Deserialized++
+	s.TotalDeserializationTime += duration
+	m.mutex.Unlock()
+}
+
+func (m *EndpointMetrics) EndpointStateChanged(address string, state EndpointState) {
+	m.mutex.Lock()
+	e := m.endpoint(address)
+	e.State = state
+	if state == EndpointTerminated || state == EndpointQuarantined {
+		// queued messages of a terminated endpoint are lost
+		e.MessagesQueued = e.MessagesSent
+	}
+	m.mutex.Unlock()
+}
+
+// Snapshot returns a copy of the current endpoint and serialization measurements
+func (m *EndpointMetrics) Snapshot() ([]EndpointMetricsSnapshot, []SerializationMetricsSnapshot) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	endpoints := make([]EndpointMetricsSnapshot, 0, len(m.endpoints))
+	for _, e := range m.endpoints {
+		endpoints = append(endpoints, *e)
+	}
+	serialization := make([]SerializationMetricsSnapshot, 0, len(m.serialization))
+	for _, s := range m.serialization {
+		serialization = append(serialization, *s)
+	}
+	sort.Slice(endpoints, func(i, j int) bool { return endpoints[i].Address < endpoints[j].Address })
+	sort.Slice(serialization, func(i, j int) bool { return serialization[i].TypeName < serialization[j].TypeName })
+	return endpoints, serialization
+}
@@ -0,0 +1,54 @@
+package remote
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpointMetrics_Snapshot(t *testing.T) {
+	m := NewEndpointMetrics()
+	m.EndpointStateChanged("b:1", EndpointConnecting)
+	m.EndpointStateChanged("b:1", EndpointConnected)
+	for i := 0; i < 5; i++ {
+		m.MessageQueued("b:1")
+	}
+	m.MessageQueued("a:1")
+	m.BatchSent("b:1", 2, 2*time.Millisecond)
+	m.BatchSent("b:1", 1, time.Millisecond)
+	m.MessageSerialized("actor.PID", time.Millisecond)
+	m.MessageSerialized("actor.PID", time.Millisecond)
+	m.MessageDeserialized("actor.PID", 3*time.Millisecond)
+
+	endpoints, serialization := m.Snapshot()
+	if assert.Len(t, endpoints, 2) {
+		assert.Equal(t, "a:1", endpoints[0].Address)
+		assert.Equal(t, int64(1), endpoints[0].QueueDepth())
+
+		b := endpoints[1]
+		assert.Equal(t, EndpointConnected, b.State)
+		assert.Equal(t, int64(5), b.MessagesQueued)
+		assert.Equal(t, int64(3), b.MessagesSent)
+		assert.Equal(t, int64(2), b.QueueDepth())
+		assert.Equal(t, 1.5, b.AverageBatchSize())
+		assert.Equal(t, 3*time.Millisecond, b.TotalSendLatency)
+	}
+	if assert.Len(t, serialization, 1) {
+		assert.Equal(t, int64(2), serialization[0].Serialized)
+		assert.Equal(t, 2*time.Millisecond, serialization[0].TotalSerializationTime)
+		assert.Equal(t, int64(1), serialization[0].Deserialized)
+		assert.Equal(t, 3*time.Millisecond, serialization[0].TotalDeserializationTime)
+	}
+}
+
+func TestEndpointMetrics_terminated_endpoint_drops_queue(t *testing.T) {
+	m := NewEndpointMetrics()
+	m.MessageQueued("a:1")
+	m.MessageQueued("a:1")
+	m.EndpointStateChanged("a:1", EndpointTerminated)
+
+	endpoints, _ := m.Snapshot()
+	assert.Equal(t, int64(0), endpoints[0].QueueDepth())
+	assert.Equal(t, "Terminated", endpoints[0].State.String())
+}
@@ -6,15 +6,16 @@ import (
 	"github.com/AsynkronIT/protoactor-go/actor"
 	"github.com/AsynkronIT/protoactor-go/eventstream"
 	"github.com/AsynkronIT/protoactor-go/log"
-	"golang.org/x/net/context"
-	"google.golang.org/grpc"
 )
 
 func endpointWriterProducer(address string, config *remoteConfig) actor.Producer {
+	// the outbox outlives the restarts of the writer
+	out := newOutbox()
 	return func() actor.Actor {
 		return &endpointWriter{
 			address: address,
 			config:  config,
+			out:     out,
 		}
 	}
 }
@@ -22,18 +23,95 @@ func endpointWriterProducer(address string, config *remoteConfig) actor.Producer
 type endpointWriter struct {
 	config              *remoteConfig
 	address             string
-	conn                *grpc.ClientConn
-	stream              Remoting_ReceiveClient
+	conn                TransportConnection
 	defaultSerializerId int32
+	out                 *outbox
+}
+
+// continueChunks is sent by the writer to itself to send the next chunks of the chunked messages
+type continueChunks struct{}
+
+var continueChunksMessage = &continueChunks{}
+
+// chunkedMessage is a large message sent one chunk per batch
+type chunkedMessage struct {
+	id           int64
+	target       string
+	typeName     string
+	serializerID int32
+	header       *MessageHeader
+	sender       *actor.PID
+	chunks       [][]byte
+	next         int
+}
+
+func (m *chunkedMessage) nextEnvelope() *MessageEnvelope {
+	envelope := &MessageEnvelope{
+		MessageData:  m.chunks[m.next],
+		SerializerId: m.serializerID,
+		Chunk: &MessageChunk{
+			Id:    m.id,
+			Index: int32(m.next),
+			Count: int32(len(m.chunks)),
+		},
+	}
+	// the sender and header are sent with the last chunk
+	if m.next == len(m.chunks)-1 {
+		envelope.MessageHeader = m.header
+		envelope.Sender = m.sender
+	}
+	m.next++
+	return envelope
+}
+
+// outbox holds what the writer has yet to send, it is kept across the restarts of the writer so a failed send
+// resends only the batch that failed
+type outbox struct {
+	chunkID int64
+	// failed is the batch whose send failed, without its chunks, it is sent first once reconnected
+	failed *MessageBatch
+	// pending are the messages to send with the next batch
+	pending *batchBuilder
+	// chunking are the messages being sent in chunks, every batch carries the next chunk of each
+	chunking []*chunkedMessage
+	// held are the messages to the targets of the chunking messages, sent once the chunked message is sent
+	// to keep the order of the messages to a target
+	held map[string][]*remoteDeliver
+}
+
+func newOutbox() *outbox {
+	return &outbox{
+		pending: newBatchBuilder(),
+		held:    make(map[string][]*remoteDeliver),
+	}
+}
+
+// batchBuilder builds a batch with the type and target names looked up
+type batchBuilder struct {
+	typeNames   map[string]int32
+	targetNames map[string]int32
+	batch       *MessageBatch
+}
+
+func newBatchBuilder() *batchBuilder {
+	return &batchBuilder{
+		typeNames:   make(map[string]int32),
+		targetNames: make(map[string]int32),
+		batch:       &MessageBatch{},
+	}
+}
+
+func (b *batchBuilder) add(typeName, target string, envelope *MessageEnvelope) {
+	envelope.TypeId, b.batch.TypeNames = addToLookup(b.typeNames, typeName, b.batch.TypeNames)
+	envelope.Target, b.batch.TargetNames = addToLookup(b.targetNames, target, b.batch.TargetNames)
+	b.batch.Envelopes = append(b.batch.Envelopes, envelope)
 }
 
 func (state *endpointWriter) initialize() {
 	err := state.initializeInternal()
 	if err != nil {
 		plog.Error("EndpointWriter failed to connect", log.String("address", state.address), log.Error(err))
-		// Wait 2 seconds to restart and retry
-		// Replace with Exponential Backoff
-		time.Sleep(2 * time.Second)
+		// the endpoint supervisor restarts the writer after a backoff
 		panic(err)
 	}
 }
@@ -41,25 +119,21 @@ func (state *endpointWriter) initialize() {
 func (state *endpointWriter) initializeInternal() error {
 	plog.Info("Started EndpointWriter", log.String("address", state.address))
 	plog.Info("EndpointWriter connecting", log.String("address", state.address))
-	conn, err := grpc.Dial(state.address, state.config.dialOptions...)
+	for _, stats := range state.config.endpointStatistics {
+		stats.EndpointStateChanged(state.address, EndpointConnecting)
+	}
+	ctx, err := state.config.outgoingContext(state.address)
 	if err != nil {
 		return err
 	}
-	state.conn = conn
-	c := NewRemotingClient(conn)
-	resp, err := c.Connect(context.Background(), &ConnectRequest{})
+	conn, resp, err := state.config.transport.Dial(ctx, state.address)
 	if err != nil {
 		return err
 	}
 	state.defaultSerializerId = resp.DefaultSerializerId
 
-	//	log.Printf("Getting stream from address %v", state.address)
-	stream, err := c.Receive(context.Background(), state.config.callOptions...)
-	if err != nil {
-		return err
-	}
 	go func() {
-		_, err := stream.Recv()
+		err := conn.Wait()
 		if err != nil {
 			plog.Info("EndpointWriter lost connection to address", log.String("address", state.address), log.Error(err))
 
@@ -72,74 +146,173 @@ func (state *endpointWriter) initializeInternal() error {
 	}()
 
 	plog.Info("EndpointWriter connected", log.String("address", state.address))
-	connected := &EndpointConnectedEvent{Address: state.address}
+	connected := &EndpointConnectedEvent{Address: state.address, Heartbeat: resp.Heartbeat}
 	eventstream.Publish(connected)
-	state.stream = stream
+	state.conn = conn
 	return nil
 }
 
 func (state *endpointWriter) sendEnvelopes(msg []interface{}, ctx actor.Context) {
-	envelopes := make([]*MessageEnvelope, len(msg))
-
-	// type name uniqueness map name string to type index
-	typeNames := make(map[string]int32)
-	typeNamesArr := make([]string, 0)
-	targetNames := make(map[string]int32)
-	targetNamesArr := make([]string, 0)
-	var header *MessageHeader
-	var typeID int32
-	var targetID int32
-	var serializerID int32
-	for i, tmp := range msg {
-
+	for _, tmp := range msg {
 		switch unwrapped := tmp.(type) {
 		case *EndpointTerminatedEvent, EndpointTerminatedEvent:
 			plog.Debug("Handling array wrapped terminate event", log.String("address", state.address), log.Object("msg", unwrapped))
 			ctx.Stop(ctx.Self())
 			return
+		case *continueChunks:
+			// the chunks are sent with every batch
+		case *remoteDeliver:
+			state.enqueue(unwrapped)
 		}
-		rd := tmp.(*remoteDeliver)
+	}
+	state.flush(ctx)
+}
 
-		if rd.serializerID == -1 {
-			serializerID = state.defaultSerializerId
-		} else {
-			serializerID = rd.serializerID
+// enqueue adds the message to the pending batch, or starts sending it in chunks when it is large.
+// The messages to a target with a message being chunked are held back until it is sent
+func (state *endpointWriter) enqueue(rd *remoteDeliver) {
+	out := state.out
+	if held, ok := out.held[rd.target.Id]; ok {
+		out.held[rd.target.Id] = append(held, rd)
+		return
+	}
+
+	var serializerID int32
+	if rd.serializerID == -1 {
+		serializerID = serializerIDFor(rd.message, state.defaultSerializerId)
+	} else {
+		serializerID = rd.serializerID
+	}
+
+	var header *MessageHeader
+	if rd.header != nil && rd.header.Length() > 0 {
+		header = &MessageHeader{rd.header.ToMap()}
+	}
+
+	var start time.Time
+	if len(state.config.endpointStatistics) > 0 {
+		start = time.Now()
+	}
+	bytes, typeName, err := Serialize(rd.message, serializerID)
+	if err != nil {
+		plog.Error("EndpointWriter failed to serialize message", log.String("address", state.address), log.TypeOf("type", rd.message), log.Error(err))
+		return
+	}
+	if len(state.config.endpointStatistics) > 0 {
+		elapsed := time.Since(start)
+		for _, stats := range state.config.endpointStatistics {
+			stats.MessageSerialized(typeName, elapsed)
 		}
+	}
 
-		if rd.header == nil || rd.header.Length() == 0 {
-			header = nil
-		} else {
-			header = &MessageHeader{rd.header.ToMap()}
+	if state.config.messageChunkSize > 0 && len(bytes) > state.config.messageChunkSize {
+		out.chunkID++
+		out.chunking = append(out.chunking, &chunkedMessage{
+			id:           out.chunkID,
+			target:       rd.target.Id,
+			typeName:     typeName,
+			serializerID: serializerID,
+			header:       header,
+			sender:       rd.sender,
+			chunks:       splitChunks(bytes, state.config.messageChunkSize),
+		})
+		out.held[rd.target.Id] = nil
+		return
+	}
+
+	out.pending.add(typeName, rd.target.Id, &MessageEnvelope{
+		MessageHeader: header,
+		MessageData:   bytes,
+		Sender:        rd.sender,
+		SerializerId:  serializerID,
+	})
+}
+
+// flush sends the pending messages with the next chunk of every chunked message, so a large message does not hold up
+// the messages to the other targets. The writer sends itself continueChunks while chunked messages remain
+func (state *endpointWriter) flush(ctx actor.Context) {
+	out := state.out
+	b := out.pending
+	for _, m := range out.chunking {
+		b.add(m.typeName, m.target, m.nextEnvelope())
+	}
+	if len(b.batch.Envelopes) == 0 {
+		return
+	}
+
+	if err := state.send(b.batch); err != nil {
+		// the receiver reassembles the chunks per connection, the chunked messages are sent again from the start
+		failed := newBatchBuilder()
+		for _, envelope := range b.batch.Envelopes {
+			if envelope.Chunk == nil {
+				failed.add(b.batch.TypeNames[envelope.TypeId], b.batch.TargetNames[envelope.Target], envelope)
+			}
+		}
+		for _, m := range out.chunking {
+			m.next = 0
 		}
+		if len(failed.batch.Envelopes) > 0 {
+			out.failed = failed.batch
+		}
+		out.pending = newBatchBuilder()
+		plog.Debug("gRPC Failed to send", log.String("address", state.address), log.Error(err))
+		panic("restart it")
+	}
 
-		bytes, typeName, err := Serialize(rd.message, serializerID)
-		if err != nil {
-			panic(err)
+	out.pending = newBatchBuilder()
+	chunking := out.chunking
+	out.chunking = nil
+	var sent []*chunkedMessage
+	for _, m := range chunking {
+		if m.next < len(m.chunks) {
+			out.chunking = append(out.chunking, m)
+		} else {
+			sent = append(sent, m)
 		}
-		typeID, typeNamesArr = addToLookup(typeNames, typeName, typeNamesArr)
-		targetID, targetNamesArr = addToLookup(targetNames, rd.target.Id, targetNamesArr)
-
-		envelopes[i] = &MessageEnvelope{
-			MessageHeader: header,
-			MessageData:   bytes,
-			Sender:        rd.sender,
-			Target:        targetID,
-			TypeId:        typeID,
-			SerializerId:  serializerID,
+	}
+	// release the messages held back by the chunked messages sent
+	for _, m := range sent {
+		held := out.held[m.target]
+		delete(out.held, m.target)
+		for _, rd := range held {
+			state.enqueue(rd)
 		}
 	}
+	if len(out.chunking) > 0 || len(out.pending.batch.Envelopes) > 0 {
+		ctx.Send(ctx.Self(), continueChunksMessage)
+	}
+}
 
-	batch := &MessageBatch{
-		TypeNames:   typeNamesArr,
-		TargetNames: targetNamesArr,
-		Envelopes:   envelopes,
+// resend sends the batch which failed before the writer restarted, then carries on with the chunked messages
+func (state *endpointWriter) resend(ctx actor.Context) {
+	out := state.out
+	if out.failed != nil {
+		if err := state.send(out.failed); err != nil {
+			plog.Debug("gRPC Failed to send", log.String("address", state.address), log.Error(err))
+			panic("restart it")
+		}
+		out.failed = nil
 	}
-	err := state.stream.Send(batch)
+	if len(out.chunking) > 0 || len(out.pending.batch.Envelopes) > 0 {
+		ctx.Send(ctx.Self(), continueChunksMessage)
+	}
+}
 
-	if err != nil {
-		ctx.Stash()
-		plog.Debug("gRPC Failed to send", log.String("address", state.address), log.Error(err))
-		panic("restart it")
+func (state *endpointWriter) send(batch *MessageBatch) error {
+	start := time.Now()
+	if err := state.conn.Send(batch); err != nil {
+		return err
+	}
+	for _, stats := range state.config.endpointStatistics {
+		stats.BatchSent(state.address, len(batch.Envelopes), time.Since(start))
+	}
+	return nil
+}
+
+func (state *endpointWriter) closeConnection() {
+	if state.conn != nil {
+		state.conn.Close()
+		state.conn = nil
 	}
 }
 
@@ -158,10 +331,11 @@ func (state *endpointWriter) Receive(ctx actor.Context) {
 	switch msg := ctx.Message().(type) {
 	case *actor.Started:
 		state.initialize()
+		state.resend(ctx)
 	case *actor.Stopped:
-		state.conn.Close()
+		state.closeConnection()
 	case *actor.Restarting:
-		state.conn.Close()
+		state.closeConnection()
 	case *EndpointTerminatedEvent:
 		ctx.Stop(ctx.Self())
 	case []interface{}:
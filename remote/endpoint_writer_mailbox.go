@@ -3,6 +3,7 @@ package remote
 import (
 	"runtime"
 	"sync/atomic"
+	"time"
 
 	"github.com/AsynkronIT/protoactor-go/internal/queue/goring"
 	"github.com/AsynkronIT/protoactor-go/internal/queue/mpsc"
@@ -19,15 +20,40 @@ const (
 	mailboxHasMoreMessages int32 = iota
 )
 
+// endpointWriterBatching configures how the endpoint writer mailbox batches messages
+type endpointWriterBatching struct {
+	// maxBatchSize is the maximum number of messages in a batch
+	maxBatchSize int
+	// minBatchSize enables adaptive batching when lower than maxBatchSize: the batch size starts at minBatchSize,
+	// doubles while the queue holds more messages than fit in a batch and halves when batches are less than half full
+	minBatchSize int
+	// linger is the maximum time to wait for a full batch to be queued before sending a partial one
+	linger time.Duration
+}
+
+func (b endpointWriterBatching) adaptive() bool {
+	return b.minBatchSize > 0 && b.minBatchSize < b.maxBatchSize
+}
+
+func (b endpointWriterBatching) initialBatchSize() int {
+	if b.adaptive() {
+		return b.minBatchSize
+	}
+	return b.maxBatchSize
+}
+
 type endpointWriterMailbox struct {
 	userMailbox     *goring.Queue
 	systemMailbox   *mpsc.Queue
 	schedulerStatus int32
 	hasMoreMessages int32
 	invoker         mailbox.MessageInvoker
+	batching        endpointWriterBatching
 	batchSize       int
 	dispatcher      mailbox.Dispatcher
 	suspended       bool
+	// lingerDeadline is the time the partial batch queued is sent, it is zero when not lingering
+	lingerDeadline time.Time
 }
 
 func (m *endpointWriterMailbox) PostUserMessage(message interface{}) {
@@ -103,8 +129,13 @@ func (m *endpointWriterMailbox) run() {
 			return
 		}
 
+		if m.batching.linger > 0 && !m.userMailbox.Empty() && m.lingering() {
+			return
+		}
+
 		var ok bool
 		if msg, ok = m.userMailbox.PopMany(int64(m.batchSize)); ok {
+			m.adapt(len(msg.([]interface{})))
 			m.invoker.InvokeUserMessage(msg)
 		} else {
 			return
@@ -114,7 +145,44 @@ func (m *endpointWriterMailbox) run() {
 	}
 }
 
-func endpointWriterMailboxProducer(batchSize, initialSize int) mailbox.Producer {
+// lingering returns true while waiting up to the linger time for a full batch to be queued, unless a system message arrives.
+// The mailbox is not run while lingering, it is scheduled again by the messages posted and once the linger time is up
+func (m *endpointWriterMailbox) lingering() bool {
+	if m.userMailbox.Length() >= int64(m.batchSize) || !m.systemMailbox.Empty() {
+		m.lingerDeadline = time.Time{}
+		return false
+	}
+	if m.lingerDeadline.IsZero() {
+		m.lingerDeadline = time.Now().Add(m.batching.linger)
+		time.AfterFunc(m.batching.linger, m.schedule)
+		return true
+	}
+	if time.Now().Before(m.lingerDeadline) {
+		return true
+	}
+	m.lingerDeadline = time.Time{}
+	return false
+}
+
+// adapt updates the batch size after taking a batch of size messages from the queue
+func (m *endpointWriterMailbox) adapt(size int) {
+	if !m.batching.adaptive() {
+		return
+	}
+	if size == m.batchSize && !m.userMailbox.Empty() {
+		m.batchSize *= 2
+		if m.batchSize > m.batching.maxBatchSize {
+			m.batchSize = m.batching.maxBatchSize
+		}
+	} else if size < m.batchSize/2 {
+		m.batchSize /= 2
+		if m.batchSize < m.batching.minBatchSize {
+			m.batchSize = m.batching.minBatchSize
+		}
+	}
+}
+
+func endpointWriterMailboxProducer(batching endpointWriterBatching, initialSize int) mailbox.Producer {
 	return func() mailbox.Mailbox {
 		userMailbox := goring.New(int64(initialSize))
 		systemMailbox := mpsc.New()
@@ -123,7 +191,8 @@ func endpointWriterMailboxProducer(batchSize, initialSize int) mailbox.Producer
 			systemMailbox:   systemMailbox,
 			hasMoreMessages: mailboxHasNoMessages,
 			schedulerStatus: mailboxIdle,
-			batchSize:       batchSize,
+			batching:        batching,
+			batchSize:       batching.initialBatchSize(),
 		}
 	}
 }
@@ -0,0 +1,119 @@
+package remote
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/mailbox"
+	"github.com/stretchr/testify/assert"
+)
+
+type batchRecorder struct {
+	mu      sync.Mutex
+	batches []int
+}
+
+func (r *batchRecorder) InvokeSystemMessage(interface{}) {}
+
+func (r *batchRecorder) InvokeUserMessage(msg interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.batches = append(r.batches, len(msg.([]interface{})))
+}
+
+func (r *batchRecorder) EscalateFailure(reason interface{}, message interface{}) {}
+
+func (r *batchRecorder) sizes() []int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]int{}, r.batches...)
+}
+
+// manualDispatcher leaves running the mailbox to the test
+type manualDispatcher struct{}
+
+func (manualDispatcher) Schedule(fn func()) {}
+func (manualDispatcher) Throughput() int    { return 1 }
+
+func newTestWriterMailbox(batching endpointWriterBatching) (*endpointWriterMailbox, *batchRecorder) {
+	recorder := &batchRecorder{}
+	m := endpointWriterMailboxProducer(batching, 16)().(*endpointWriterMailbox)
+	m.RegisterHandlers(recorder, manualDispatcher{})
+	return m, recorder
+}
+
+func postMessages(m *endpointWriterMailbox, count int) {
+	for i := 0; i < count; i++ {
+		m.PostUserMessage(i)
+	}
+}
+
+func TestEndpointWriterMailbox_FixedBatchSize(t *testing.T) {
+	m, recorder := newTestWriterMailbox(endpointWriterBatching{maxBatchSize: 10})
+
+	postMessages(m, 25)
+	m.run()
+
+	assert.Equal(t, []int{10, 10, 5}, recorder.sizes())
+}
+
+func TestEndpointWriterMailbox_AdaptiveBatchSize(t *testing.T) {
+	m, recorder := newTestWriterMailbox(endpointWriterBatching{maxBatchSize: 16, minBatchSize: 2})
+
+	// under load the batch size grows up to the max batch size
+	postMessages(m, 100)
+	m.run()
+	assert.Equal(t, []int{2, 4, 8, 16, 16, 16, 16, 16, 6}, recorder.sizes())
+
+	// and shrinks back when the load drops
+	for i := 0; i < 3; i++ {
+		postMessages(m, 1)
+		m.run()
+	}
+	assert.Equal(t, 2, m.batchSize)
+}
+
+func TestEndpointWriterMailbox_Linger(t *testing.T) {
+	// without linger every message trickling in is sent on its own, which gives the lowest latency
+	m, recorder := newTestWriterMailbox(endpointWriterBatching{maxBatchSize: 10})
+	for i := 0; i < 5; i++ {
+		postMessages(m, 1)
+		m.run()
+	}
+	assert.Equal(t, []int{1, 1, 1, 1, 1}, recorder.sizes())
+
+	// lingering collects the messages into fewer batches at the cost of latency
+	m, recorder = newTestWriterMailbox(endpointWriterBatching{maxBatchSize: 5, linger: 5 * time.Second})
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 5; i++ {
+			postMessages(m, 1)
+			time.Sleep(time.Millisecond)
+		}
+		close(done)
+	}()
+	for len(recorder.sizes()) == 0 {
+		m.run()
+		time.Sleep(time.Millisecond)
+	}
+	<-done
+	assert.Equal(t, []int{5}, recorder.sizes())
+
+	// the mailbox does not hold the dispatcher while lingering
+	m, recorder = newTestWriterMailbox(endpointWriterBatching{maxBatchSize: 5, linger: 5 * time.Second})
+	postMessages(m, 1)
+	start := time.Now()
+	m.run()
+	assert.Empty(t, recorder.sizes())
+	assert.True(t, time.Since(start) < time.Second)
+
+	// a partial batch is sent when the linger time is up
+	m, recorder = newTestWriterMailbox(endpointWriterBatching{maxBatchSize: 5, linger: 10 * time.Millisecond})
+	m.RegisterHandlers(recorder, mailbox.NewDefaultDispatcher(300))
+	start = time.Now()
+	postMessages(m, 2)
+	assert.Eventually(t, func() bool { return len(recorder.sizes()) > 0 }, time.Second, time.Millisecond)
+	assert.Equal(t, []int{2}, recorder.sizes())
+	assert.True(t, time.Since(start) >= 10*time.Millisecond)
+}
@@ -0,0 +1,108 @@
+package remote
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+)
+
+type testConnection struct {
+	batches []*MessageBatch
+	fail    bool
+}
+
+func (c *testConnection) Send(batch *MessageBatch) error {
+	if c.fail {
+		return errors.New("broken")
+	}
+	c.batches = append(c.batches, batch)
+	return nil
+}
+
+func (c *testConnection) Wait() error  { return nil }
+func (c *testConnection) Close() error { return nil }
+
+func newTestEndpointWriter(out *outbox, conn TransportConnection) (*endpointWriter, *mockContext) {
+	config := defaultRemoteConfig()
+	config.messageChunkSize = 16
+	ctx := &mockContext{}
+	ctx.On("Self").Return(actor.NewLocalPID("writer"))
+	ctx.On("Send").Return()
+	return &endpointWriter{config: config, address: "remote", conn: conn, defaultSerializerId: DefaultSerializerID, out: out}, ctx
+}
+
+func deliver(target string, name string) *remoteDeliver {
+	return &remoteDeliver{
+		message:      &ActorPidRequest{Name: name},
+		target:       actor.NewLocalPID(target),
+		serializerID: -1,
+	}
+}
+
+// sent returns the names of the messages sent, chunks as target:index/count
+func sent(batches []*MessageBatch) [][]string {
+	var res [][]string
+	for _, batch := range batches {
+		var names []string
+		for _, envelope := range batch.Envelopes {
+			target := batch.TargetNames[envelope.Target]
+			if envelope.Chunk != nil {
+				names = append(names, target+":chunk")
+				continue
+			}
+			msg, _ := Deserialize(envelope.MessageData, batch.TypeNames[envelope.TypeId], envelope.SerializerId)
+			names = append(names, target+":"+msg.(*ActorPidRequest).Name)
+		}
+		res = append(res, names)
+	}
+	return res
+}
+
+func TestEndpointWriter_InterleavesChunks(t *testing.T) {
+	conn := &testConnection{}
+	writer, ctx := newTestEndpointWriter(newOutbox(), conn)
+
+	writer.sendEnvelopes([]interface{}{deliver("a", strings.Repeat("x", 40)), deliver("b", "1"), deliver("a", "2")}, ctx)
+	writer.sendEnvelopes([]interface{}{deliver("b", "3"), continueChunksMessage}, ctx)
+	for len(writer.out.chunking) > 0 || len(writer.out.pending.batch.Envelopes) > 0 {
+		writer.sendEnvelopes([]interface{}{continueChunksMessage}, ctx)
+	}
+
+	// the messages to a are held back until the chunked message is sent, the messages to b are not
+	assert.Equal(t, [][]string{
+		{"b:1", "a:chunk"},
+		{"b:3", "a:chunk"},
+		{"a:chunk"},
+		{"a:2"},
+	}, sent(conn.batches))
+}
+
+func TestEndpointWriter_ResendsOnlyTheFailedBatch(t *testing.T) {
+	out := newOutbox()
+	conn := &testConnection{}
+	writer, ctx := newTestEndpointWriter(out, conn)
+
+	writer.sendEnvelopes([]interface{}{deliver("a", strings.Repeat("x", 40)), deliver("b", "1")}, ctx)
+	conn.fail = true
+	assert.Panics(t, func() {
+		writer.sendEnvelopes([]interface{}{deliver("b", "2"), deliver("a", "3")}, ctx)
+	})
+
+	// the restarted writer sends the failed batch, then the chunked message from the start
+	conn = &testConnection{}
+	writer, ctx = newTestEndpointWriter(out, conn)
+	writer.resend(ctx)
+	for len(writer.out.chunking) > 0 || len(writer.out.pending.batch.Envelopes) > 0 {
+		writer.sendEnvelopes([]interface{}{continueChunksMessage}, ctx)
+	}
+	assert.Equal(t, [][]string{
+		{"b:2"},
+		{"a:chunk"},
+		{"a:chunk"},
+		{"a:chunk"},
+		{"a:3"},
+	}, sent(conn.batches))
+}
@@ -2,6 +2,7 @@ package remote
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"reflect"
 
@@ -34,13 +35,22 @@ func (j *jsonSerializer) Serialize(msg interface{}) ([]byte, error) {
 		}
 
 		return []byte(str), nil
+	} else if _, ok := registeredTypeName(msg); ok {
+		return json.Marshal(msg)
 	}
-	return nil, fmt.Errorf("msg must be proto.Message")
+	return nil, fmt.Errorf("msg must be proto.Message or a registered message type")
 }
 
 func (j *jsonSerializer) Deserialize(typeName string, b []byte) (interface{}, error) {
 	protoType := proto.MessageType(typeName)
 	if protoType == nil {
+		if instance, unwrap, err := newRegisteredMessage(typeName); err == nil {
+			if err := json.Unmarshal(b, instance); err != nil {
+				return nil, err
+			}
+			return unwrap(instance), nil
+		}
+
 		m := &JsonMessage{
 			TypeName: typeName,
 			Json:     string(b),
@@ -67,8 +77,10 @@ func (j *jsonSerializer) GetTypeName(msg interface{}) (string, error) {
 	} else if message, ok := msg.(proto.Message); ok {
 		typeName := proto.MessageName(message)
 
+		return typeName, nil
+	} else if typeName, ok := registeredTypeName(msg); ok {
 		return typeName, nil
 	}
 
-	return "", fmt.Errorf("msg must be proto.Message")
+	return "", fmt.Errorf("msg must be proto.Message or a registered message type")
 }
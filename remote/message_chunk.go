@@ -0,0 +1,120 @@
+package remote
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// default limits of the reassembly of the chunked messages of an endpoint stream
+const (
+	defaultChunkBufferSize   = 64 * 1024 * 1024
+	defaultChunkBufferExpiry = time.Minute
+)
+
+// errChunkDropped is returned when a chunked message is dropped because it exceeds the buffer size.
+// The other chunks of the message are ignored
+var errChunkDropped = errors.New("chunked message exceeds the chunk buffer size")
+
+// splitChunks splits data into chunks of at most size bytes, the chunks share the backing array of data
+func splitChunks(data []byte, size int) [][]byte {
+	chunks := make([][]byte, 0, (len(data)+size-1)/size)
+	for len(data) > size {
+		chunks = append(chunks, data[:size:size])
+		data = data[size:]
+	}
+	return append(chunks, data)
+}
+
+type chunkBuffer struct {
+	count   int32
+	next    int32
+	data    []byte
+	dropped bool
+	updated time.Time
+}
+
+// chunkAssembler reassembles the chunked messages received on a single endpoint stream.
+// Chunks of a message are sent in order, but chunks of different messages are not required to be contiguous.
+// The buffered chunks take at most maxSize bytes, and the messages which receive no chunk for expiry are dropped
+type chunkAssembler struct {
+	buffers map[int64]*chunkBuffer
+	size    int
+	maxSize int
+	expiry  time.Duration
+	now     func() time.Time
+}
+
+func newChunkAssembler(maxSize int, expiry time.Duration) *chunkAssembler {
+	return &chunkAssembler{
+		buffers: make(map[int64]*chunkBuffer),
+		maxSize: maxSize,
+		expiry:  expiry,
+		now:     time.Now,
+	}
+}
+
+// add adds a chunk and returns the reassembled message data once the last chunk is received
+func (a *chunkAssembler) add(chunk *MessageChunk, data []byte) ([]byte, bool, error) {
+	now := a.now()
+	a.expire(now)
+
+	if chunk.Count <= 0 || chunk.Index < 0 || chunk.Index >= chunk.Count {
+		a.remove(chunk.Id)
+		return nil, false, fmt.Errorf("invalid chunk %v/%v of message %v", chunk.Index, chunk.Count, chunk.Id)
+	}
+
+	buffer, ok := a.buffers[chunk.Id]
+	if !ok {
+		buffer = &chunkBuffer{count: chunk.Count}
+		a.buffers[chunk.Id] = buffer
+	}
+	if chunk.Index != buffer.next || chunk.Count != buffer.count {
+		a.remove(chunk.Id)
+		return nil, false, fmt.Errorf("unexpected chunk %v/%v of message %v, expected chunk %v/%v", chunk.Index, chunk.Count, chunk.Id, buffer.next, buffer.count)
+	}
+
+	buffer.next++
+	buffer.updated = now
+	complete := buffer.next == buffer.count
+	if !buffer.dropped && a.maxSize > 0 && a.size+len(data) > a.maxSize {
+		a.size -= len(buffer.data)
+		buffer.data = nil
+		buffer.dropped = true
+		if complete {
+			delete(a.buffers, chunk.Id)
+		}
+		return nil, false, errChunkDropped
+	}
+	if complete {
+		a.remove(chunk.Id)
+		if buffer.dropped {
+			return nil, false, nil
+		}
+		return append(buffer.data, data...), true, nil
+	}
+	if !buffer.dropped {
+		buffer.data = append(buffer.data, data...)
+		a.size += len(data)
+	}
+	return nil, false, nil
+}
+
+func (a *chunkAssembler) remove(id int64) {
+	if buffer, ok := a.buffers[id]; ok {
+		a.size -= len(buffer.data)
+		delete(a.buffers, id)
+	}
+}
+
+// expire removes the buffers which received no chunk within the expiry
+func (a *chunkAssembler) expire(now time.Time) {
+	if a.expiry <= 0 {
+		return
+	}
+	for id, buffer := range a.buffers {
+		if now.Sub(buffer.updated) > a.expiry {
+			a.remove(id)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package remote
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitChunks(t *testing.T) {
+	data := []byte("0123456789")
+
+	chunks := splitChunks(data, 4)
+	assert.Equal(t, [][]byte{[]byte("0123"), []byte("4567"), []byte("89")}, chunks)
+
+	chunks = splitChunks(data, 5)
+	assert.Equal(t, [][]byte{[]byte("01234"), []byte("56789")}, chunks)
+}
+
+func TestChunkAssembler_Reassembles(t *testing.T) {
+	data := bytes.Repeat([]byte("abc"), 100)
+	chunks := splitChunks(data, 7)
+	a := newChunkAssembler(0, 0)
+
+	for i, chunk := range chunks {
+		// chunks of another message may be interleaved
+		_, _, err := a.add(&MessageChunk{Id: 2, Index: int32(i), Count: int32(len(chunks))}, chunk)
+		assert.NoError(t, err)
+
+		res, complete, err := a.add(&MessageChunk{Id: 1, Index: int32(i), Count: int32(len(chunks))}, chunk)
+		assert.NoError(t, err)
+		if i < len(chunks)-1 {
+			assert.False(t, complete)
+			assert.Nil(t, res)
+		} else {
+			assert.True(t, complete)
+			assert.Equal(t, data, res)
+		}
+	}
+	assert.Empty(t, a.buffers)
+}
+
+func TestChunkAssembler_OutOfOrder(t *testing.T) {
+	a := newChunkAssembler(0, 0)
+
+	_, _, err := a.add(&MessageChunk{Id: 1, Index: 0, Count: 3}, []byte("a"))
+	assert.NoError(t, err)
+	_, _, err = a.add(&MessageChunk{Id: 1, Index: 2, Count: 3}, []byte("c"))
+	assert.Error(t, err)
+	assert.Empty(t, a.buffers)
+
+	_, _, err = a.add(&MessageChunk{Id: 1, Index: 3, Count: 3}, []byte("d"))
+	assert.Error(t, err)
+}
+
+func TestChunkAssembler_DropsMessagesOverSize(t *testing.T) {
+	a := newChunkAssembler(5, 0)
+
+	_, _, err := a.add(&MessageChunk{Id: 1, Index: 0, Count: 3}, []byte("abc"))
+	assert.NoError(t, err)
+	_, _, err = a.add(&MessageChunk{Id: 2, Index: 0, Count: 2}, []byte("def"))
+	assert.Equal(t, errChunkDropped, err)
+	// the other chunks of a dropped message are ignored
+	_, complete, err := a.add(&MessageChunk{Id: 2, Index: 1, Count: 2}, []byte("f"))
+	assert.NoError(t, err)
+	assert.False(t, complete)
+
+	_, _, err = a.add(&MessageChunk{Id: 1, Index: 1, Count: 3}, []byte("d"))
+	assert.NoError(t, err)
+	res, complete, err := a.add(&MessageChunk{Id: 1, Index: 2, Count: 3}, []byte("e"))
+	assert.NoError(t, err)
+	assert.True(t, complete)
+	assert.Equal(t, []byte("abcde"), res)
+	assert.Empty(t, a.buffers)
+	assert.Equal(t, 0, a.size)
+}
+
+func TestChunkAssembler_ExpiresBuffers(t *testing.T) {
+	now := time.Now()
+	a := newChunkAssembler(0, time.Minute)
+	a.now = func() time.Time { return now }
+
+	_, _, err := a.add(&MessageChunk{Id: 1, Index: 0, Count: 2}, []byte("abc"))
+	assert.NoError(t, err)
+	now = now.Add(2 * time.Minute)
+	_, _, err = a.add(&MessageChunk{Id: 2, Index: 0, Count: 2}, []byte("d"))
+	assert.NoError(t, err)
+	assert.Len(t, a.buffers, 1)
+	assert.Equal(t, 1, a.size)
+}
@@ -0,0 +1,66 @@
+package remote
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type messageType struct {
+	t       reflect.Type // the non-pointer type
+	pointer bool
+}
+
+var (
+	messageTypes     = make(map[string]messageType)
+	messageTypeNames = make(map[reflect.Type]string)
+)
+
+// RegisterMessageType makes a plain Go type, such as a struct that is not a protobuf message,
+// known to the JSON and MessagePack serializers using its Go type name, e.g. "messages.Hello".
+//
+// Register pointer types when messages are sent as pointers.
+// Message types should be registered on every node before remoting is started
+func RegisterMessageType(msg interface{}) {
+	t := reflect.TypeOf(msg)
+	RegisterMessageTypeWithName(typeNameOf(t), msg)
+}
+
+// RegisterMessageTypeWithName makes a plain Go type known to the JSON and MessagePack serializers using the given name
+func RegisterMessageTypeWithName(typeName string, msg interface{}) {
+	t := reflect.TypeOf(msg)
+	mt := messageType{t: t}
+	if t.Kind() == reflect.Ptr {
+		mt = messageType{t: t.Elem(), pointer: true}
+	}
+	messageTypes[typeName] = mt
+	messageTypeNames[t] = typeName
+}
+
+func typeNameOf(t reflect.Type) string {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.String()
+}
+
+func registeredTypeName(msg interface{}) (string, bool) {
+	typeName, ok := messageTypeNames[reflect.TypeOf(msg)]
+	return typeName, ok
+}
+
+// newRegisteredMessage returns a pointer to a new zero value of the registered type,
+// along with a func converting that pointer back to the registered form.
+func newRegisteredMessage(typeName string) (interface{}, func(interface{}) interface{}, error) {
+	mt, ok := messageTypes[typeName]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown message type %v", typeName)
+	}
+	ptr := reflect.New(mt.t)
+	unwrap := func(v interface{}) interface{} {
+		if mt.pointer {
+			return v
+		}
+		return reflect.ValueOf(v).Elem().Interface()
+	}
+	return ptr.Interface(), unwrap, nil
+}
@@ -6,8 +6,17 @@ type EndpointTerminatedEvent struct {
 	Address string
 }
 
+// ActivationTerminatedEvent is published on the node hosting an actor spawned by the activator once it stops
+type ActivationTerminatedEvent struct {
+	PID  *actor.PID
+	Name string
+	Kind string
+}
+
 type EndpointConnectedEvent struct {
 	Address string
+	// Heartbeat tells the node answers heartbeats, older nodes do not
+	Heartbeat bool
 }
 
 type remoteWatch struct {
@@ -0,0 +1,45 @@
+package remote
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-msgpack/codec"
+)
+
+// msgPackSerializer serializes message types registered with RegisterMessageType using MessagePack
+type msgPackSerializer struct {
+	handle *codec.MsgpackHandle
+}
+
+func newMsgPackSerializer() Serializer {
+	return &msgPackSerializer{handle: &codec.MsgpackHandle{RawToString: true}}
+}
+
+func (m *msgPackSerializer) Serialize(msg interface{}) ([]byte, error) {
+	if _, ok := registeredTypeName(msg); !ok {
+		return nil, fmt.Errorf("msg must be a registered message type, got %T", msg)
+	}
+	var bytes []byte
+	if err := codec.NewEncoderBytes(&bytes, m.handle).Encode(msg); err != nil {
+		return nil, err
+	}
+	return bytes, nil
+}
+
+func (m *msgPackSerializer) Deserialize(typeName string, bytes []byte) (interface{}, error) {
+	instance, unwrap, err := newRegisteredMessage(typeName)
+	if err != nil {
+		return nil, err
+	}
+	if err := codec.NewDecoderBytes(bytes, m.handle).Decode(instance); err != nil {
+		return nil, err
+	}
+	return unwrap(instance), nil
+}
+
+func (m *msgPackSerializer) GetTypeName(msg interface{}) (string, error) {
+	if typeName, ok := registeredTypeName(msg); ok {
+		return typeName, nil
+	}
+	return "", fmt.Errorf("msg must be a registered message type, got %T", msg)
+}
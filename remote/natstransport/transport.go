@@ -0,0 +1,393 @@
+// Package natstransport carries the remoting message batches over NATS subjects instead of direct connections
+// between the nodes, for deployments where the nodes can reach a NATS server but not each other.
+//
+//	nc, _ := nats.Connect(nats.DefaultURL)
+//	remote.Start("127.0.0.1:8080", remote.WithTransport(natstransport.New(nc)))
+//
+// All nodes need to use the same transport and subject prefix. The address passed to remote.Start still names the
+// node, its listener is only kept to reserve the address and accepts no connections.
+// A node stopping without closing its connections is not noticed by NATS, use remote.WithHeartbeat to detect it.
+//
+// The delivery is at most once: NATS does not acknowledge the messages, and drops the messages of a node which does
+// not receive them fast enough (a slow consumer) or is disconnected from the server, whereas a broken gRPC stream
+// terminates the endpoint. A batch larger than the maximum payload of the server is split, and a message which alone
+// exceeds it is dropped, use remote.WithMessageChunkSize with a chunk size below the maximum payload to send them
+package natstransport
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/log"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	"github.com/gogo/protobuf/proto"
+	"github.com/nats-io/nats.go"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultSubjectPrefix prefixes the subjects of the nodes
+const DefaultSubjectPrefix = "protoactor"
+
+// DefaultDialTimeout is the time Dial waits for the connect response when the context has no deadline
+const DefaultDialTimeout = 5 * time.Second
+
+// the first byte of the messages sent to the subject of a connection
+const (
+	batchFrame byte = iota
+	closeFrame
+)
+
+// connectRequest is sent to the connect subject of a node, the dialing node is told on ClosedSubject when the
+// connection is closed
+type connectRequest struct {
+	ClosedSubject string              `json:"closed_subject"`
+	Metadata      map[string][]string `json:"metadata,omitempty"`
+	Request       []byte              `json:"request"`
+}
+
+// connectReply answers a connect request, with the subject of the connection or the status of the error
+type connectReply struct {
+	ConnectionSubject string     `json:"connection_subject,omitempty"`
+	ErrorCode         codes.Code `json:"error_code,omitempty"`
+	ErrorMessage      string     `json:"error_message,omitempty"`
+	Response          []byte     `json:"response,omitempty"`
+}
+
+// ErrConnectionClosed is returned by Wait when the remote node closed the connection
+var ErrConnectionClosed = errors.New("nats transport: connection closed by the remote node")
+
+// Transport is a remote.Transport publishing the batches to the NATS subject of the connection
+type Transport struct {
+	nc            *nats.Conn
+	subjectPrefix string
+	dialTimeout   time.Duration
+
+	mu          sync.Mutex
+	listener    net.Listener
+	connect     *nats.Subscription
+	connections map[*serverConnection]struct{}
+	stopped     chan struct{}
+}
+
+// New returns a transport using the NATS connection nc and DefaultSubjectPrefix
+func New(nc *nats.Conn) *Transport {
+	return NewWithSubjectPrefix(nc, DefaultSubjectPrefix)
+}
+
+// NewWithSubjectPrefix returns a transport using the NATS connection nc, the subjects of the nodes are prefixed by
+// subjectPrefix so several clusters can share a NATS server
+func NewWithSubjectPrefix(nc *nats.Conn, subjectPrefix string) *Transport {
+	return &Transport{
+		nc:            nc,
+		subjectPrefix: subjectPrefix,
+		dialTimeout:   DefaultDialTimeout,
+		connections:   make(map[*serverConnection]struct{}),
+		stopped:       make(chan struct{}),
+	}
+}
+
+func (t *Transport) connectSubject(address string) string {
+	return t.subjectPrefix + "." + address + ".connect"
+}
+
+// Serve answers the connect requests sent to the address of this node, it blocks until the transport is stopped
+func (t *Transport) Serve(lis net.Listener, handler remote.TransportHandler) error {
+	sub, err := t.nc.Subscribe(t.connectSubject(actor.ProcessRegistry.Address), func(msg *nats.Msg) {
+		t.accept(msg, handler)
+	})
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	t.listener = lis
+	t.connect = sub
+	t.mu.Unlock()
+
+	<-t.stopped
+	return nil
+}
+
+func (t *Transport) accept(msg *nats.Msg, handler remote.TransportHandler) {
+	req := &connectRequest{}
+	if err := json.Unmarshal(msg.Data, req); err != nil {
+		plog.Error("Invalid connect request", log.Error(err))
+		return
+	}
+	md := metadata.MD{}
+	for key, values := range req.Metadata {
+		md[strings.ToLower(key)] = values
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: natsAddr(req.ClosedSubject)})
+	ctx = metadata.NewIncomingContext(ctx, md)
+
+	connectReq := &remote.ConnectRequest{}
+	if err := connectReq.Unmarshal(req.Request); err != nil {
+		cancel()
+		respond(msg, &connectReply{ErrorCode: codes.InvalidArgument, ErrorMessage: err.Error()})
+		return
+	}
+	resp, err := handler.Connect(ctx, connectReq)
+	if err != nil {
+		cancel()
+		s := status.Convert(err)
+		respond(msg, &connectReply{ErrorCode: s.Code(), ErrorMessage: s.Message()})
+		return
+	}
+	data, err := resp.Marshal()
+	if err != nil {
+		cancel()
+		return
+	}
+
+	conn := &serverConnection{subject: nats.NewInbox(), closedSubject: req.ClosedSubject, cancel: cancel}
+	conn.sub, err = t.nc.SubscribeSync(conn.subject)
+	if err != nil {
+		cancel()
+		return
+	}
+	t.track(conn, true)
+
+	if err := respond(msg, &connectReply{ConnectionSubject: conn.subject, Response: data}); err != nil {
+		t.close(conn)
+		return
+	}
+
+	go func() {
+		handler.ReceiveBatches(ctx, func() (*remote.MessageBatch, error) {
+			return conn.recv(ctx)
+		})
+		t.close(conn)
+	}()
+}
+
+func respond(msg *nats.Msg, reply *connectReply) error {
+	data, err := json.Marshal(reply)
+	if err != nil {
+		return err
+	}
+	return msg.Respond(data)
+}
+
+func (t *Transport) track(conn *serverConnection, open bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if open {
+		t.connections[conn] = struct{}{}
+	} else {
+		delete(t.connections, conn)
+	}
+}
+
+// close stops receiving from the connection and tells the dialing node
+func (t *Transport) close(conn *serverConnection) {
+	t.track(conn, false)
+	conn.cancel()
+	conn.sub.Unsubscribe()
+	t.nc.Publish(conn.closedSubject, nil)
+}
+
+// Stop stops answering connect requests and closes the open connections
+func (t *Transport) Stop(_ bool) {
+	t.mu.Lock()
+	if t.connect != nil {
+		t.connect.Unsubscribe()
+	}
+	if t.listener != nil {
+		t.listener.Close()
+	}
+	connections := make([]*serverConnection, 0, len(t.connections))
+	for conn := range t.connections {
+		connections = append(connections, conn)
+	}
+	select {
+	case <-t.stopped:
+	default:
+		close(t.stopped)
+	}
+	t.mu.Unlock()
+
+	for _, conn := range connections {
+		t.close(conn)
+	}
+}
+
+// Dial sends a connect request to the node at address, the metadata of the context is sent as message headers
+func (t *Transport) Dial(ctx context.Context, address string) (remote.TransportConnection, *remote.ConnectResponse, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, t.dialTimeout)
+		defer cancel()
+	}
+
+	closed := make(chan *nats.Msg, 1)
+	closedSubject := nats.NewInbox()
+	closedSub, err := t.nc.ChanSubscribe(closedSubject, closed)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req := &connectRequest{ClosedSubject: closedSubject}
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		req.Metadata = md
+	}
+	req.Request, _ = (&remote.ConnectRequest{}).Marshal()
+	data, err := json.Marshal(req)
+	if err != nil {
+		closedSub.Unsubscribe()
+		return nil, nil, err
+	}
+
+	msg, err := t.nc.RequestWithContext(ctx, t.connectSubject(address), data)
+	if err != nil {
+		closedSub.Unsubscribe()
+		return nil, nil, err
+	}
+	reply := &connectReply{}
+	if err := json.Unmarshal(msg.Data, reply); err != nil {
+		closedSub.Unsubscribe()
+		return nil, nil, err
+	}
+	if reply.ErrorCode != codes.OK {
+		closedSub.Unsubscribe()
+		return nil, nil, status.Error(reply.ErrorCode, reply.ErrorMessage)
+	}
+	resp := &remote.ConnectResponse{}
+	if err := resp.Unmarshal(reply.Response); err != nil {
+		closedSub.Unsubscribe()
+		return nil, nil, err
+	}
+	return &clientConnection{
+		nc:        t.nc,
+		subject:   reply.ConnectionSubject,
+		closedSub: closedSub,
+		closed:    closed,
+		done:      make(chan struct{}),
+	}, resp, nil
+}
+
+// serverConnection is a connection accepted by this node
+type serverConnection struct {
+	subject       string
+	closedSubject string
+	sub           *nats.Subscription
+	cancel        context.CancelFunc
+}
+
+func (c *serverConnection) recv(ctx context.Context) (*remote.MessageBatch, error) {
+	for {
+		msg, err := c.sub.NextMsgWithContext(ctx)
+		if err == nats.ErrSlowConsumer {
+			// the messages are lost, the next ones are still received in order
+			dropped, _ := c.sub.Dropped()
+			plog.Error("Messages dropped by slow consumer", log.String("subject", c.subject), log.Int("dropped", dropped))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(msg.Data) == 0 || msg.Data[0] == closeFrame {
+			return nil, io.EOF
+		}
+		batch := &remote.MessageBatch{}
+		if err := batch.Unmarshal(msg.Data[1:]); err != nil {
+			return nil, err
+		}
+		return batch, nil
+	}
+}
+
+// clientConnection is a connection dialed by this node
+type clientConnection struct {
+	nc        *nats.Conn
+	subject   string
+	closedSub *nats.Subscription
+	closed    chan *nats.Msg
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (c *clientConnection) Send(batch *remote.MessageBatch) error {
+	payloads, dropped := split(batch, int(c.nc.MaxPayload()))
+	if dropped > 0 {
+		plog.Error("Messages exceeding the maximum payload dropped", log.String("subject", c.subject), log.Int("dropped", dropped),
+			log.Int64("maxPayload", c.nc.MaxPayload()))
+	}
+	for _, data := range payloads {
+		if err := c.nc.Publish(c.subject, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// split encodes the batch as payloads of at most maxPayload bytes, splitting its envelopes in several batches if needed.
+// The envelopes exceeding maxPayload alone are dropped
+func split(batch *remote.MessageBatch, maxPayload int) (payloads [][]byte, dropped int) {
+	if 1+batch.Size() <= maxPayload {
+		return [][]byte{encodeBatch(batch)}, 0
+	}
+	// the frame byte and the name tables are sent with every part
+	overhead := 1 + (&remote.MessageBatch{TypeNames: batch.TypeNames, TargetNames: batch.TargetNames}).Size()
+	part := &remote.MessageBatch{TypeNames: batch.TypeNames, TargetNames: batch.TargetNames}
+	size := overhead
+	for _, envelope := range batch.Envelopes {
+		// the envelopes are length delimited fields of the batch
+		n := envelope.Size()
+		n += 1 + proto.SizeVarint(uint64(n))
+		if overhead+n > maxPayload {
+			dropped++
+			continue
+		}
+		if size+n > maxPayload {
+			payloads = append(payloads, encodeBatch(part))
+			part = &remote.MessageBatch{TypeNames: batch.TypeNames, TargetNames: batch.TargetNames}
+			size = overhead
+		}
+		part.Envelopes = append(part.Envelopes, envelope)
+		size += n
+	}
+	if len(part.Envelopes) > 0 {
+		payloads = append(payloads, encodeBatch(part))
+	}
+	return payloads, dropped
+}
+
+func encodeBatch(batch *remote.MessageBatch) []byte {
+	data, _ := batch.Marshal()
+	return append([]byte{batchFrame}, data...)
+}
+
+func (c *clientConnection) Wait() error {
+	select {
+	case <-c.closed:
+		return ErrConnectionClosed
+	case <-c.done:
+		return nats.ErrConnectionClosed
+	}
+}
+
+func (c *clientConnection) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		close(c.done)
+		c.closedSub.Unsubscribe()
+		err = c.nc.Publish(c.subject, []byte{closeFrame})
+	})
+	return err
+}
+
+type natsAddr string
+
+func (a natsAddr) Network() string { return "nats" }
+func (a natsAddr) String() string  { return string(a) }
@@ -0,0 +1,152 @@
+package natstransport
+
+import (
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type testTransportHandler struct {
+	batches chan *remote.MessageBatch
+	peers   chan metadata.MD
+}
+
+func (h *testTransportHandler) Connect(ctx context.Context, req *remote.ConnectRequest) (*remote.ConnectResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if auth := md.Get("authorization"); len(auth) == 0 || auth[0] != "Bearer secret" {
+		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+	}
+	return &remote.ConnectResponse{DefaultSerializerId: remote.JsonSerializerID}, nil
+}
+
+func (h *testTransportHandler) ReceiveBatches(ctx context.Context, recv func() (*remote.MessageBatch, error)) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	h.peers <- md
+	for {
+		batch, err := recv()
+		if err != nil {
+			return err
+		}
+		h.batches <- batch
+	}
+}
+
+// connectNats connects to the NATS server at NATS_URL or the default URL, the test is skipped without server
+func connectNats(t *testing.T) *nats.Conn {
+	url := os.Getenv("NATS_URL")
+	if url == "" {
+		url = nats.DefaultURL
+	}
+	nc, err := nats.Connect(url)
+	if err != nil {
+		t.Skipf("no NATS server at %v: %v", url, err)
+	}
+	return nc
+}
+
+func TestTransport(t *testing.T) {
+	nc := connectNats(t)
+	defer nc.Close()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := lis.Addr().String()
+	defer func(previous string) { actor.ProcessRegistry.Address = previous }(actor.ProcessRegistry.Address)
+	actor.ProcessRegistry.Address = address
+
+	transport := New(nc)
+	handler := &testTransportHandler{
+		batches: make(chan *remote.MessageBatch, 1),
+		peers:   make(chan metadata.MD, 1),
+	}
+	go transport.Serve(lis, handler)
+	defer transport.Stop(false)
+
+	// wait for the connect subscription
+	assert.Eventually(t, func() bool {
+		_, _, err := transport.Dial(context.Background(), address)
+		return status.Code(err) == codes.Unauthenticated
+	}, 5*time.Second, 10*time.Millisecond, "connection without credentials should be rejected")
+
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("authorization", "Bearer secret"))
+	conn, resp, err := transport.Dial(ctx, address)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, remote.JsonSerializerID, resp.DefaultSerializerId)
+
+	batch := &remote.MessageBatch{TypeNames: []string{"remote.Unit"}, TargetNames: []string{"target"}}
+	assert.NoError(t, conn.Send(batch))
+
+	select {
+	case md := <-handler.peers:
+		assert.Equal(t, []string{"Bearer secret"}, md.Get("authorization"))
+	case <-time.After(5 * time.Second):
+		t.Fatal("connection was not handled")
+	}
+	select {
+	case received := <-handler.batches:
+		assert.Equal(t, batch, received)
+	case <-time.After(5 * time.Second):
+		t.Fatal("batch was not received")
+	}
+
+	lost := make(chan error, 1)
+	go func() { lost <- conn.Wait() }()
+	transport.Stop(false)
+	select {
+	case err := <-lost:
+		assert.Equal(t, ErrConnectionClosed, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("connection loss was not detected")
+	}
+	conn.Close()
+
+	_, _, err = transport.Dial(ctx, address)
+	assert.Error(t, err, "a stopped transport should not accept connections")
+}
+
+func TestSplit(t *testing.T) {
+	envelope := func(size int) *remote.MessageEnvelope {
+		return &remote.MessageEnvelope{MessageData: make([]byte, size)}
+	}
+	batch := &remote.MessageBatch{
+		TypeNames:   []string{"remote.Unit"},
+		TargetNames: []string{"target"},
+		Envelopes:   []*remote.MessageEnvelope{envelope(10), envelope(40), envelope(200), envelope(10)},
+	}
+
+	payloads, dropped := split(batch, 1000)
+	assert.Len(t, payloads, 1)
+	assert.Equal(t, 0, dropped)
+
+	// the envelopes are sent in order in batches fitting the payload, the envelope larger than the payload is dropped
+	payloads, dropped = split(batch, 80)
+	assert.Equal(t, 1, dropped)
+	var sizes []int
+	for _, data := range payloads {
+		assert.True(t, len(data) <= 80, "payload of %v bytes", len(data))
+		assert.Equal(t, batchFrame, data[0])
+		part := &remote.MessageBatch{}
+		assert.NoError(t, part.Unmarshal(data[1:]))
+		assert.Equal(t, batch.TypeNames, part.TypeNames)
+		assert.Equal(t, batch.TargetNames, part.TargetNames)
+		for _, e := range part.Envelopes {
+			sizes = append(sizes, len(e.MessageData))
+		}
+	}
+	assert.Len(t, payloads, 2)
+	assert.Equal(t, []int{10, 40, 10}, sizes)
+}
@@ -10,9 +10,12 @@
 	It has these top-level messages:
 		MessageBatch
 		MessageEnvelope
+		MessageChunk
 		MessageHeader
 		ActorPidRequest
 		ActorPidResponse
+		HeartbeatRequest
+		HeartbeatResponse
 		Unit
 		ConnectRequest
 		ConnectResponse
@@ -27,14 +30,12 @@ import actor "github.com/AsynkronIT/protoactor-go/actor"
 
 import bytes "bytes"
 
-import (
-	context "golang.org/x/net/context"
-	grpc "google.golang.org/grpc"
-)
+import context "golang.org/x/net/context"
+import grpc "google.golang.org/grpc"
 
 import strings "strings"
 import reflect "reflect"
-import github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+import sortkeys "github.com/gogo/protobuf/sortkeys"
 
 import io "io"
 
@@ -87,6 +88,7 @@ type MessageEnvelope struct {
 	Sender        *actor.PID     `protobuf:"bytes,4,opt,name=sender" json:"sender,omitempty"`
 	SerializerId  int32          `protobuf:"varint,5,opt,name=serializer_id,json=serializerId,proto3" json:"serializer_id,omitempty"`
 	MessageHeader *MessageHeader `protobuf:"bytes,6,opt,name=message_header,json=messageHeader" json:"message_header,omitempty"`
+	Chunk         *MessageChunk  `protobuf:"bytes,7,opt,name=chunk" json:"chunk,omitempty"`
 }
 
 func (m *MessageEnvelope) Reset()                    { *m = MessageEnvelope{} }
@@ -135,13 +137,51 @@ func (m *MessageEnvelope) GetMessageHeader() *MessageHeader {
 	return nil
 }
 
+func (m *MessageEnvelope) GetChunk() *MessageChunk {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+type MessageChunk struct {
+	Id    int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Index int32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Count int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *MessageChunk) Reset()                    { *m = MessageChunk{} }
+func (*MessageChunk) ProtoMessage()               {}
+func (*MessageChunk) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{2} }
+
+func (m *MessageChunk) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MessageChunk) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *MessageChunk) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 type MessageHeader struct {
 	HeaderData map[string]string `protobuf:"bytes,1,rep,name=header_data,json=headerData" json:"header_data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *MessageHeader) Reset()                    { *m = MessageHeader{} }
 func (*MessageHeader) ProtoMessage()               {}
-func (*MessageHeader) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{2} }
+func (*MessageHeader) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{3} }
 
 func (m *MessageHeader) GetHeaderData() map[string]string {
 	if m != nil {
@@ -157,7 +197,7 @@ type ActorPidRequest struct {
 
 func (m *ActorPidRequest) Reset()                    { *m = ActorPidRequest{} }
 func (*ActorPidRequest) ProtoMessage()               {}
-func (*ActorPidRequest) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{3} }
+func (*ActorPidRequest) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{4} }
 
 func (m *ActorPidRequest) GetName() string {
 	if m != nil {
@@ -180,7 +220,7 @@ type ActorPidResponse struct {
 
 func (m *ActorPidResponse) Reset()                    { *m = ActorPidResponse{} }
 func (*ActorPidResponse) ProtoMessage()               {}
-func (*ActorPidResponse) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{4} }
+func (*ActorPidResponse) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{5} }
 
 func (m *ActorPidResponse) GetPid() *actor.PID {
 	if m != nil {
@@ -196,27 +236,43 @@ func (m *ActorPidResponse) GetStatusCode() int32 {
 	return 0
 }
 
+type HeartbeatRequest struct {
+}
+
+func (m *HeartbeatRequest) Reset()                    { *m = HeartbeatRequest{} }
+func (*HeartbeatRequest) ProtoMessage()               {}
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{6} }
+
+type HeartbeatResponse struct {
+}
+
+func (m *HeartbeatResponse) Reset()                    { *m = HeartbeatResponse{} }
+func (*HeartbeatResponse) ProtoMessage()               {}
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{7} }
+
 type Unit struct {
 }
 
 func (m *Unit) Reset()                    { *m = Unit{} }
 func (*Unit) ProtoMessage()               {}
-func (*Unit) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{5} }
+func (*Unit) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{8} }
 
 type ConnectRequest struct {
 }
 
 func (m *ConnectRequest) Reset()                    { *m = ConnectRequest{} }
 func (*ConnectRequest) ProtoMessage()               {}
-func (*ConnectRequest) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{6} }
+func (*ConnectRequest) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{9} }
 
 type ConnectResponse struct {
 	DefaultSerializerId int32 `protobuf:"varint,1,opt,name=default_serializer_id,json=defaultSerializerId,proto3" json:"default_serializer_id,omitempty"`
+	// heartbeat tells the node answers HeartbeatRequest, older nodes fail to deserialize it and close the connection
+	Heartbeat bool `protobuf:"varint,2,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
 }
 
 func (m *ConnectResponse) Reset()                    { *m = ConnectResponse{} }
 func (*ConnectResponse) ProtoMessage()               {}
-func (*ConnectResponse) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{7} }
+func (*ConnectResponse) Descriptor() ([]byte, []int) { return fileDescriptorProtos, []int{10} }
 
 func (m *ConnectResponse) GetDefaultSerializerId() int32 {
 	if m != nil {
@@ -225,22 +281,29 @@ func (m *ConnectResponse) GetDefaultSerializerId() int32 {
 	return 0
 }
 
+func (m *ConnectResponse) GetHeartbeat() bool {
+	if m != nil {
+		return m.Heartbeat
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*MessageBatch)(nil), "remote.MessageBatch")
 	proto.RegisterType((*MessageEnvelope)(nil), "remote.MessageEnvelope")
+	proto.RegisterType((*MessageChunk)(nil), "remote.MessageChunk")
 	proto.RegisterType((*MessageHeader)(nil), "remote.MessageHeader")
 	proto.RegisterType((*ActorPidRequest)(nil), "remote.ActorPidRequest")
 	proto.RegisterType((*ActorPidResponse)(nil), "remote.ActorPidResponse")
+	proto.RegisterType((*HeartbeatRequest)(nil), "remote.HeartbeatRequest")
+	proto.RegisterType((*HeartbeatResponse)(nil), "remote.HeartbeatResponse")
 	proto.RegisterType((*Unit)(nil), "remote.Unit")
 	proto.RegisterType((*ConnectRequest)(nil), "remote.ConnectRequest")
 	proto.RegisterType((*ConnectResponse)(nil), "remote.ConnectResponse")
 }
 func (this *MessageBatch) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	}
 
 	that1, ok := that.(*MessageBatch)
@@ -253,10 +316,7 @@ func (this *MessageBatch) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
@@ -288,10 +348,7 @@ func (this *MessageBatch) Equal(that interface{}) bool {
 }
 func (this *MessageEnvelope) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	}
 
 	that1, ok := that.(*MessageEnvelope)
@@ -304,10 +361,7 @@ func (this *MessageEnvelope) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
@@ -329,15 +383,45 @@ func (this *MessageEnvelope) Equal(that interface{}) bool {
 	if !this.MessageHeader.Equal(that1.MessageHeader) {
 		return false
 	}
+	if !this.Chunk.Equal(that1.Chunk) {
+		return false
+	}
 	return true
 }
-func (this *MessageHeader) Equal(that interface{}) bool {
+func (this *MessageChunk) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
+		return this == nil
+	}
+
+	that1, ok := that.(*MessageChunk)
+	if !ok {
+		that2, ok := that.(MessageChunk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Index != that1.Index {
 		return false
 	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+func (this *MessageHeader) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
 	that1, ok := that.(*MessageHeader)
 	if !ok {
@@ -349,10 +433,7 @@ func (this *MessageHeader) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
@@ -368,10 +449,7 @@ func (this *MessageHeader) Equal(that interface{}) bool {
 }
 func (this *ActorPidRequest) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	}
 
 	that1, ok := that.(*ActorPidRequest)
@@ -384,10 +462,7 @@ func (this *ActorPidRequest) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
@@ -401,10 +476,7 @@ func (this *ActorPidRequest) Equal(that interface{}) bool {
 }
 func (this *ActorPidResponse) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	}
 
 	that1, ok := that.(*ActorPidResponse)
@@ -417,10 +489,7 @@ func (this *ActorPidResponse) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
@@ -432,13 +501,52 @@ func (this *ActorPidResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Unit) Equal(that interface{}) bool {
+func (this *HeartbeatRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HeartbeatRequest)
+	if !ok {
+		that2, ok := that.(HeartbeatRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *HeartbeatResponse) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
+		return this == nil
+	}
+
+	that1, ok := that.(*HeartbeatResponse)
+	if !ok {
+		that2, ok := that.(HeartbeatResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
+	return true
+}
+func (this *Unit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
 	that1, ok := that.(*Unit)
 	if !ok {
@@ -450,10 +558,7 @@ func (this *Unit) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
@@ -461,10 +566,7 @@ func (this *Unit) Equal(that interface{}) bool {
 }
 func (this *ConnectRequest) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	}
 
 	that1, ok := that.(*ConnectRequest)
@@ -477,10 +579,7 @@ func (this *ConnectRequest) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
@@ -488,10 +587,7 @@ func (this *ConnectRequest) Equal(that interface{}) bool {
 }
 func (this *ConnectResponse) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	}
 
 	that1, ok := that.(*ConnectResponse)
@@ -504,16 +600,16 @@ func (this *ConnectResponse) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
 	if this.DefaultSerializerId != that1.DefaultSerializerId {
 		return false
 	}
+	if this.Heartbeat != that1.Heartbeat {
+		return false
+	}
 	return true
 }
 
@@ -771,6 +867,49 @@ func (m *MessageEnvelope) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n2
 	}
+	if m.Chunk != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(m.Chunk.Size()))
+		n3, err := m.Chunk.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+
+func (m *MessageChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageChunk) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(m.Id))
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(m.Index))
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintProtos(dAtA, i, uint64(m.Count))
+	}
 	return i, nil
 }
 
@@ -858,11 +997,11 @@ func (m *ActorPidResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintProtos(dAtA, i, uint64(m.Pid.Size()))
-		n3, err := m.Pid.MarshalTo(dAtA[i:])
+		n4, err := m.Pid.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n4
 	}
 	if m.StatusCode != 0 {
 		dAtA[i] = 0x10
@@ -872,6 +1011,42 @@ func (m *ActorPidResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *HeartbeatRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *HeartbeatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
 func (m *Unit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -928,27 +1103,19 @@ func (m *ConnectResponse) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintProtos(dAtA, i, uint64(m.DefaultSerializerId))
 	}
+	if m.Heartbeat {
+		dAtA[i] = 0x10
+		i++
+		if m.Heartbeat {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
-func encodeFixed64Protos(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Protos(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
 func encodeVarintProtos(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -1006,6 +1173,25 @@ func (m *MessageEnvelope) Size() (n int) {
 		l = m.MessageHeader.Size()
 		n += 1 + l + sovProtos(uint64(l))
 	}
+	if m.Chunk != nil {
+		l = m.Chunk.Size()
+		n += 1 + l + sovProtos(uint64(l))
+	}
+	return n
+}
+
+func (m *MessageChunk) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovProtos(uint64(m.Id))
+	}
+	if m.Index != 0 {
+		n += 1 + sovProtos(uint64(m.Index))
+	}
+	if m.Count != 0 {
+		n += 1 + sovProtos(uint64(m.Count))
+	}
 	return n
 }
 
@@ -1050,6 +1236,18 @@ func (m *ActorPidResponse) Size() (n int) {
 	return n
 }
 
+func (m *HeartbeatRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *HeartbeatResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
 func (m *Unit) Size() (n int) {
 	var l int
 	_ = l
@@ -1068,6 +1266,9 @@ func (m *ConnectResponse) Size() (n int) {
 	if m.DefaultSerializerId != 0 {
 		n += 1 + sovProtos(uint64(m.DefaultSerializerId))
 	}
+	if m.Heartbeat {
+		n += 2
+	}
 	return n
 }
 
@@ -1107,6 +1308,19 @@ func (this *MessageEnvelope) String() string {
 		`Sender:` + strings.Replace(fmt.Sprintf("%v", this.Sender), "PID", "actor.PID", 1) + `,`,
 		`SerializerId:` + fmt.Sprintf("%v", this.SerializerId) + `,`,
 		`MessageHeader:` + strings.Replace(fmt.Sprintf("%v", this.MessageHeader), "MessageHeader", "MessageHeader", 1) + `,`,
+		`Chunk:` + strings.Replace(fmt.Sprintf("%v", this.Chunk), "MessageChunk", "MessageChunk", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MessageChunk) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MessageChunk{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1119,7 +1333,7 @@ func (this *MessageHeader) String() string {
 	for k, _ := range this.HeaderData {
 		keysForHeaderData = append(keysForHeaderData, k)
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaderData)
+	sortkeys.Strings(keysForHeaderData)
 	mapStringForHeaderData := "map[string]string{"
 	for _, k := range keysForHeaderData {
 		mapStringForHeaderData += fmt.Sprintf("%v: %v,", k, this.HeaderData[k])
@@ -1153,6 +1367,24 @@ func (this *ActorPidResponse) String() string {
 	}, "")
 	return s
 }
+func (this *HeartbeatRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HeartbeatRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HeartbeatResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HeartbeatResponse{`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Unit) String() string {
 	if this == nil {
 		return "nil"
@@ -1177,6 +1409,7 @@ func (this *ConnectResponse) String() string {
 	}
 	s := strings.Join([]string{`&ConnectResponse{`,
 		`DefaultSerializerId:` + fmt.Sprintf("%v", this.DefaultSerializerId) + `,`,
+		`Heartbeat:` + fmt.Sprintf("%v", this.Heartbeat) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1511,6 +1744,146 @@ func (m *MessageEnvelope) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Chunk == nil {
+				m.Chunk = &MessageChunk{}
+			}
+			if err := m.Chunk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProtos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtos(dAtA[iNdEx:])
@@ -1910,6 +2283,106 @@ func (m *ActorPidResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HeartbeatRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeartbeatRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProtos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeartbeatResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeartbeatResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProtos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Unit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2058,6 +2531,26 @@ func (m *ConnectResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heartbeat", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Heartbeat = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtos(dAtA[iNdEx:])
@@ -2187,44 +2680,49 @@ var (
 func init() { proto.RegisterFile("protos.proto", fileDescriptorProtos) }
 
 var fileDescriptorProtos = []byte{
-	// 620 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x3b, 0x6f, 0x13, 0x4b,
-	0x14, 0xde, 0x89, 0xe3, 0xcd, 0xf5, 0xb1, 0x13, 0x47, 0x73, 0xf3, 0xb0, 0xac, 0x7b, 0xf7, 0xfa,
-	0x2e, 0x42, 0x72, 0x41, 0xd6, 0xc8, 0x11, 0x08, 0x50, 0x28, 0xf2, 0x42, 0xb8, 0x00, 0x85, 0x05,
-	0x6a, 0x6b, 0xe2, 0x3d, 0x59, 0xaf, 0x62, 0xef, 0x98, 0x9d, 0x71, 0x24, 0x23, 0x21, 0xd1, 0xd1,
-	0x52, 0xf1, 0x1b, 0xf8, 0x29, 0x94, 0x29, 0x29, 0xc9, 0xd2, 0x50, 0x50, 0xe4, 0x27, 0xa0, 0x79,
-	0x24, 0xb1, 0x0d, 0xd5, 0x9e, 0xf3, 0x9d, 0xef, 0xbc, 0xbe, 0xb3, 0x03, 0x95, 0x51, 0xc6, 0x25,
-	0x17, 0x81, 0xfe, 0x50, 0x37, 0xc3, 0x21, 0x97, 0x58, 0xdf, 0x8a, 0x13, 0xd9, 0x1f, 0x1f, 0x07,
-	0x3d, 0x3e, 0x6c, 0xc5, 0x3c, 0xe6, 0x2d, 0x1d, 0x3e, 0x1e, 0x9f, 0x68, 0x4f, 0x3b, 0xda, 0x32,
-	0x69, 0xf5, 0xfb, 0x53, 0xf4, 0x5d, 0x31, 0x49, 0x4f, 0x33, 0x9e, 0x76, 0x5e, 0x99, 0x24, 0xd6,
-	0x93, 0x3c, 0xdb, 0x8a, 0x79, 0x4b, 0x1b, 0xad, 0xe9, 0x76, 0xfe, 0x07, 0x02, 0x95, 0x67, 0x28,
-	0x04, 0x8b, 0x71, 0x8f, 0xc9, 0x5e, 0x9f, 0xfe, 0x0b, 0x20, 0x27, 0x23, 0xec, 0xa6, 0x6c, 0x88,
-	0xa2, 0x46, 0x1a, 0x85, 0x66, 0x29, 0x2c, 0x29, 0xe4, 0xb9, 0x02, 0xe8, 0xff, 0x50, 0x91, 0x2c,
-	0x8b, 0x51, 0x5a, 0xc2, 0x82, 0x26, 0x94, 0x0d, 0x66, 0x28, 0xf7, 0xa0, 0x84, 0xe9, 0x19, 0x0e,
-	0xf8, 0x08, 0x45, 0xad, 0xd0, 0x28, 0x34, 0xcb, 0xed, 0xcd, 0xc0, 0x6c, 0x15, 0xd8, 0x56, 0x87,
-	0x36, 0x1e, 0xde, 0x30, 0xfd, 0x9f, 0x04, 0xaa, 0x73, 0x61, 0xba, 0x09, 0x4b, 0x7a, 0x98, 0x24,
-	0xaa, 0x91, 0x06, 0x69, 0x16, 0x43, 0x57, 0xb9, 0x9d, 0x48, 0x8d, 0x31, 0x34, 0xdc, 0x6e, 0xc4,
-	0x24, 0xab, 0x2d, 0x34, 0x48, 0xb3, 0x12, 0x96, 0x2d, 0x76, 0xc0, 0x24, 0xa3, 0x1b, 0xe0, 0x9a,
-	0xa9, 0x6a, 0x05, 0x9b, 0xaa, 0x3d, 0xea, 0x83, 0x2b, 0x30, 0x8d, 0x30, 0xab, 0x2d, 0x36, 0x48,
-	0xb3, 0xdc, 0x86, 0x40, 0xcb, 0x12, 0x1c, 0x75, 0x0e, 0x42, 0x1b, 0xa1, 0xb7, 0x60, 0x59, 0x60,
-	0x96, 0xb0, 0x41, 0xf2, 0x16, 0x33, 0xd5, 0xbd, 0xa8, 0x4b, 0x54, 0x6e, 0xc0, 0x4e, 0x44, 0x77,
-	0x60, 0xe5, 0x6a, 0x86, 0x3e, 0x32, 0x55, 0xd0, 0xd5, 0x05, 0xd7, 0xe7, 0x96, 0x7d, 0xaa, 0x83,
-	0xe1, 0xf2, 0x70, 0xda, 0xf5, 0x3f, 0x11, 0x58, 0x9e, 0x21, 0xd0, 0x27, 0x50, 0x36, 0x75, 0xcc,
-	0x4a, 0x44, 0x2b, 0x77, 0xfb, 0x8f, 0xc5, 0x02, 0xf3, 0x51, 0x7b, 0x1e, 0xa6, 0x32, 0x9b, 0x84,
-	0xd0, 0xbf, 0x06, 0xea, 0x8f, 0xa1, 0x3a, 0x17, 0xa6, 0xab, 0x50, 0x38, 0xc5, 0x89, 0xd6, 0xb0,
-	0x14, 0x2a, 0x93, 0xae, 0x41, 0xf1, 0x8c, 0x0d, 0xc6, 0xa8, 0x95, 0x2b, 0x85, 0xc6, 0x79, 0xb4,
-	0xf0, 0x80, 0xf8, 0x0f, 0xa1, 0xba, 0xab, 0x04, 0x39, 0x4a, 0xa2, 0x10, 0xdf, 0x8c, 0x51, 0x48,
-	0x4a, 0x61, 0x51, 0x5d, 0xdb, 0xe6, 0x6b, 0x5b, 0x61, 0xa7, 0x49, 0x1a, 0xd9, 0x7c, 0x6d, 0xfb,
-	0x2f, 0x60, 0xf5, 0x26, 0x55, 0x8c, 0x78, 0x2a, 0x90, 0xfe, 0x03, 0x85, 0x91, 0x3d, 0xdf, 0xac,
-	0xd6, 0x0a, 0xa6, 0xff, 0x41, 0x59, 0x48, 0x26, 0xc7, 0xa2, 0xdb, 0xe3, 0x91, 0x19, 0xa6, 0x18,
-	0x82, 0x81, 0xf6, 0x79, 0x84, 0xbe, 0x0b, 0x8b, 0xaf, 0xd3, 0x44, 0xfa, 0xab, 0xb0, 0xb2, 0xcf,
-	0xd3, 0x14, 0x7b, 0xd2, 0x0e, 0xe5, 0x1f, 0x42, 0xf5, 0x1a, 0xb1, 0xbd, 0xda, 0xb0, 0x1e, 0xe1,
-	0x09, 0x1b, 0x0f, 0x64, 0x77, 0xf6, 0x7c, 0xe6, 0xe7, 0xf9, 0xdb, 0x06, 0x5f, 0x4e, 0x5d, 0xb1,
-	0xfd, 0x0e, 0xfe, 0x0a, 0x95, 0xc2, 0x49, 0x1a, 0xd3, 0x1d, 0x58, 0xb2, 0x25, 0xe9, 0xc6, 0x95,
-	0xee, 0xb3, 0x5d, 0xeb, 0x9b, 0xbf, 0xe1, 0xa6, 0xb7, 0xef, 0xd0, 0x6d, 0x58, 0x0a, 0xb1, 0x87,
-	0xc9, 0x19, 0xd2, 0xb5, 0xb9, 0xab, 0xe9, 0xa7, 0x55, 0xaf, 0x5c, 0xa1, 0x7a, 0x23, 0xa7, 0x49,
-	0xee, 0x92, 0xbd, 0x3b, 0xe7, 0x17, 0x9e, 0xf3, 0xf5, 0xc2, 0x73, 0x2e, 0x2f, 0x3c, 0xe7, 0x7d,
-	0xee, 0x91, 0xcf, 0xb9, 0x47, 0xbe, 0xe4, 0x1e, 0x39, 0xcf, 0x3d, 0xf2, 0x2d, 0xf7, 0xc8, 0x8f,
-	0xdc, 0x73, 0x2e, 0x73, 0x8f, 0x7c, 0xfc, 0xee, 0x39, 0xc7, 0xae, 0x7e, 0xb4, 0xdb, 0xbf, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x32, 0xf3, 0x79, 0x19, 0x33, 0x04, 0x00, 0x00,
+	// 699 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x4b, 0x6f, 0x1a, 0x49,
+	0x10, 0xa6, 0xc1, 0x80, 0x29, 0xb0, 0x61, 0xdb, 0x2f, 0x84, 0xbc, 0xb3, 0xec, 0xac, 0x56, 0x42,
+	0xab, 0x35, 0xac, 0xb0, 0x76, 0xb5, 0xbb, 0x72, 0x0e, 0x7e, 0x45, 0x26, 0x52, 0x22, 0xa7, 0x93,
+	0x9c, 0x51, 0x33, 0x53, 0x86, 0x11, 0x30, 0x43, 0x66, 0x7a, 0xac, 0x10, 0x29, 0x52, 0x6e, 0xb9,
+	0xe6, 0x14, 0xe5, 0x27, 0xe4, 0xa7, 0xe4, 0xe8, 0x63, 0x8e, 0x31, 0xb9, 0xe4, 0xe8, 0x9f, 0x10,
+	0xf5, 0x83, 0x67, 0x72, 0x9a, 0xaa, 0xaf, 0xea, 0xeb, 0xfa, 0xea, 0xa1, 0x81, 0xc2, 0x28, 0x0c,
+	0x44, 0x10, 0xd5, 0xd5, 0x87, 0x66, 0x42, 0x1c, 0x06, 0x02, 0x2b, 0x07, 0x5d, 0x4f, 0xf4, 0xe2,
+	0x4e, 0xdd, 0x09, 0x86, 0x8d, 0x6e, 0xd0, 0x0d, 0x1a, 0x2a, 0xdc, 0x89, 0xaf, 0x94, 0xa7, 0x1c,
+	0x65, 0x69, 0x5a, 0xe5, 0x9f, 0x85, 0xf4, 0xe3, 0x68, 0xec, 0xf7, 0xc3, 0xc0, 0x6f, 0x3d, 0xd5,
+	0x24, 0xee, 0x88, 0x20, 0x3c, 0xe8, 0x06, 0x0d, 0x65, 0x34, 0x16, 0xcb, 0xd9, 0x6f, 0x08, 0x14,
+	0x1e, 0x62, 0x14, 0xf1, 0x2e, 0x9e, 0x70, 0xe1, 0xf4, 0xe8, 0xcf, 0x00, 0x62, 0x3c, 0xc2, 0xb6,
+	0xcf, 0x87, 0x18, 0x95, 0x49, 0x35, 0x55, 0xcb, 0xb1, 0x9c, 0x44, 0x1e, 0x49, 0x80, 0xfe, 0x0a,
+	0x05, 0xc1, 0xc3, 0x2e, 0x0a, 0x93, 0x90, 0x54, 0x09, 0x79, 0x8d, 0xe9, 0x94, 0xbf, 0x21, 0x87,
+	0xfe, 0x35, 0x0e, 0x82, 0x11, 0x46, 0xe5, 0x54, 0x35, 0x55, 0xcb, 0x37, 0xf7, 0xea, 0xba, 0xab,
+	0xba, 0x29, 0x75, 0x6e, 0xe2, 0x6c, 0x9e, 0x69, 0xbf, 0x4f, 0x42, 0x71, 0x25, 0x4c, 0xf7, 0x20,
+	0xab, 0xc4, 0x78, 0x6e, 0x99, 0x54, 0x49, 0x2d, 0xcd, 0x32, 0xd2, 0x6d, 0xb9, 0x52, 0xc6, 0x50,
+	0xe7, 0xb6, 0x5d, 0x2e, 0x78, 0x39, 0x59, 0x25, 0xb5, 0x02, 0xcb, 0x1b, 0xec, 0x8c, 0x0b, 0x4e,
+	0x77, 0x21, 0xa3, 0x55, 0x95, 0x53, 0x86, 0xaa, 0x3c, 0x6a, 0x43, 0x26, 0x42, 0xdf, 0xc5, 0xb0,
+	0xbc, 0x56, 0x25, 0xb5, 0x7c, 0x13, 0xea, 0x6a, 0x2c, 0xf5, 0xcb, 0xd6, 0x19, 0x33, 0x11, 0xfa,
+	0x1b, 0x6c, 0x44, 0x18, 0x7a, 0x7c, 0xe0, 0xbd, 0xc4, 0x50, 0x56, 0x4f, 0xab, 0x27, 0x0a, 0x73,
+	0xb0, 0xe5, 0xd2, 0x23, 0xd8, 0x9c, 0x6a, 0xe8, 0x21, 0x97, 0x0f, 0x66, 0xd4, 0x83, 0x3b, 0x2b,
+	0xcd, 0x5e, 0xa8, 0x20, 0xdb, 0x18, 0x2e, 0xba, 0xf4, 0x0f, 0x48, 0x3b, 0xbd, 0xd8, 0xef, 0x97,
+	0xb3, 0x8a, 0xb4, 0xbd, 0x42, 0x3a, 0x95, 0x31, 0xa6, 0x53, 0xec, 0x07, 0xb3, 0x1d, 0x29, 0x98,
+	0x6e, 0x42, 0xd2, 0x4c, 0x24, 0xc5, 0x92, 0x9e, 0x4b, 0xb7, 0x21, 0xed, 0xf9, 0x2e, 0xbe, 0x50,
+	0x63, 0x48, 0x33, 0xed, 0x48, 0xd4, 0x09, 0x62, 0x7f, 0xda, 0xbf, 0x76, 0xec, 0x77, 0x04, 0x36,
+	0x96, 0x84, 0xd1, 0xfb, 0x90, 0xd7, 0xfa, 0xf5, 0x28, 0x89, 0xda, 0xd8, 0xef, 0x3f, 0x6c, 0xa2,
+	0xae, 0x3f, 0x72, 0xbe, 0xe7, 0xbe, 0x08, 0xc7, 0x0c, 0x7a, 0x33, 0xa0, 0x72, 0x0f, 0x8a, 0x2b,
+	0x61, 0x5a, 0x82, 0x54, 0x1f, 0xc7, 0x4a, 0x69, 0x8e, 0x49, 0x53, 0x8a, 0xba, 0xe6, 0x83, 0x18,
+	0x95, 0xd4, 0x1c, 0xd3, 0xce, 0xff, 0xc9, 0x7f, 0x89, 0xfd, 0x1f, 0x14, 0x8f, 0xe5, 0x22, 0x2e,
+	0x3d, 0x97, 0xe1, 0xf3, 0x18, 0x23, 0x41, 0x29, 0xac, 0xc9, 0x2b, 0x33, 0x7c, 0x65, 0x4b, 0xac,
+	0xef, 0xf9, 0xae, 0xe1, 0x2b, 0xdb, 0x7e, 0x0c, 0xa5, 0x39, 0x35, 0x1a, 0x05, 0x7e, 0x84, 0x74,
+	0x1f, 0x52, 0x23, 0x33, 0xa4, 0xe5, 0x1d, 0x4b, 0x98, 0xfe, 0x02, 0xf9, 0x48, 0x70, 0x11, 0x47,
+	0x6d, 0x27, 0x70, 0xd1, 0xcc, 0x0d, 0x34, 0x74, 0x1a, 0xb8, 0x68, 0x53, 0x28, 0x5d, 0x20, 0x0f,
+	0x45, 0x07, 0xb9, 0x30, 0x72, 0xec, 0x2d, 0xf8, 0x69, 0x01, 0xd3, 0x75, 0xec, 0x0c, 0xac, 0x3d,
+	0xf3, 0x3d, 0x61, 0x97, 0x60, 0xf3, 0x34, 0xf0, 0x7d, 0x74, 0x66, 0xe9, 0x0e, 0x14, 0x67, 0x88,
+	0x11, 0xd5, 0x84, 0x1d, 0x17, 0xaf, 0x78, 0x3c, 0x10, 0xed, 0xe5, 0xfb, 0xd2, 0xd7, 0xbd, 0x65,
+	0x82, 0x4f, 0x16, 0xcf, 0x6c, 0x1f, 0x72, 0xbd, 0x69, 0x55, 0x25, 0x74, 0x9d, 0xcd, 0x81, 0xe6,
+	0x2b, 0x58, 0x67, 0x72, 0x51, 0x9e, 0xdf, 0xa5, 0x47, 0x90, 0x35, 0x05, 0xe9, 0xee, 0x74, 0x7d,
+	0xcb, 0x9a, 0x2a, 0x7b, 0xdf, 0xe1, 0xa6, 0x8d, 0x04, 0x3d, 0x84, 0x2c, 0x43, 0x07, 0xbd, 0x6b,
+	0xa4, 0xab, 0xc7, 0xa8, 0xfe, 0x0c, 0x95, 0xc2, 0x14, 0x55, 0xfd, 0x26, 0x6a, 0xe4, 0x2f, 0x72,
+	0xf2, 0xe7, 0xcd, 0xad, 0x95, 0xf8, 0x74, 0x6b, 0x25, 0xee, 0x6e, 0xad, 0xc4, 0xeb, 0x89, 0x45,
+	0x3e, 0x4c, 0x2c, 0xf2, 0x71, 0x62, 0x91, 0x9b, 0x89, 0x45, 0x3e, 0x4f, 0x2c, 0xf2, 0x75, 0x62,
+	0x25, 0xee, 0x26, 0x16, 0x79, 0xfb, 0xc5, 0x4a, 0x74, 0x32, 0xea, 0x9f, 0x73, 0xf8, 0x2d, 0x00,
+	0x00, 0xff, 0xff, 0x2c, 0x91, 0x20, 0xd0, 0xf2, 0x04, 0x00, 0x00,
 }
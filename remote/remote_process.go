@@ -19,6 +19,8 @@ func (ref *process) SendUserMessage(pid *actor.PID, message interface{}) {
 	SendMessage(pid, header, msg, sender, -1)
 }
 
+// SendMessage sends a message to a remote PID using the given serializer.
+// A serializerID of -1 uses the serializer registered for the message type, or the default serializer of the remote node
 func SendMessage(pid *actor.PID, header actor.ReadonlyMessageHeader, message interface{}, sender *actor.PID, serializerID int32) {
 	rd := &remoteDeliver{
 		header:       header,
@@ -0,0 +1,49 @@
+// Package remotetest simulates network failures in process, so the failover of remote actors and clusters can be
+// tested without manipulating the network. The failures only apply to the node running the test
+package remotetest
+
+import (
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/eventstream"
+	"github.com/AsynkronIT/protoactor-go/internal/chaos"
+	"github.com/AsynkronIT/protoactor-go/remote"
+)
+
+// SimulatePartition drops the messages to and from the node at the address and terminates its endpoint,
+// so the watchers of its actors are notified, until HealPartition is called
+func SimulatePartition(address string) {
+	chaos.Partition(address)
+	eventstream.Publish(&remote.EndpointTerminatedEvent{Address: address})
+}
+
+// HealPartition ends the partition simulated by SimulatePartition
+func HealPartition(address string) {
+	chaos.Heal(address)
+}
+
+// SimulateConnectionLoss terminates the endpoint of the address as if its connection was lost,
+// the endpoint connects again when the next message is sent to the address
+func SimulateConnectionLoss(address string) {
+	eventstream.Publish(&remote.EndpointTerminatedEvent{Address: address})
+}
+
+// SimulateDelay delays the messages to the address, messages may be reordered
+func SimulateDelay(address string, delay time.Duration) {
+	chaos.SetDelay(address, delay)
+}
+
+// SimulateMessageLoss drops the given fraction, between 0 and 1, of the messages to the address
+func SimulateMessageLoss(address string, rate float64) {
+	chaos.SetLossRate(address, rate)
+}
+
+// IsPartitioned returns true while a partition from the address is simulated
+func IsPartitioned(address string) bool {
+	return chaos.IsPartitioned(address)
+}
+
+// Reset ends all simulated failures
+func Reset() {
+	chaos.Reset()
+}
@@ -8,6 +8,7 @@ const (
 	ResponseStatusCodeTIMEOUT
 	ResponseStatusCodePROCESSNAMEALREADYEXIST
 	ResponseStatusCodeERROR
+	ResponseStatusCodeUNKNOWNKIND
 )
 
 func (c ResponseStatusCode) ToInt32() int32 {
@@ -1,20 +1,57 @@
 package remote
 
+import (
+	"fmt"
+	"reflect"
+)
+
+// Built-in serializer IDs, in registration order
+const (
+	ProtoSerializerID int32 = iota
+	JsonSerializerID
+	MsgPackSerializerID
+)
+
 var DefaultSerializerID int32
 var serializers []Serializer
+var serializerIDByType = make(map[reflect.Type]int32)
 
 func init() {
 	RegisterSerializer(newProtoSerializer())
 	RegisterSerializer(newJsonSerializer())
+	RegisterSerializer(newMsgPackSerializer())
 }
 
-func RegisterSerializerAsDefault(serializer Serializer) {
-	serializers = append(serializers, serializer)
-	DefaultSerializerID = int32(len(serializers) - 1)
+func RegisterSerializerAsDefault(serializer Serializer) int32 {
+	DefaultSerializerID = RegisterSerializer(serializer)
+	return DefaultSerializerID
 }
 
-func RegisterSerializer(serializer Serializer) {
+// RegisterSerializer adds a serializer to the registry and returns its ID
+func RegisterSerializer(serializer Serializer) int32 {
 	serializers = append(serializers, serializer)
+	return int32(len(serializers) - 1)
+}
+
+// RegisterSerializerForType makes messages of the same type as msg use the given serializer,
+// unless a serializer is passed explicitly to SendMessage.
+//
+// Serializers should be registered before remoting is started
+func RegisterSerializerForType(msg interface{}, serializerID int32) {
+	serializerIDByType[reflect.TypeOf(msg)] = serializerID
+}
+
+// SerializerIDFor returns the serializer used for the message when no serializer is passed explicitly
+func SerializerIDFor(message interface{}) int32 {
+	return serializerIDFor(message, DefaultSerializerID)
+}
+
+// serializerIDFor returns the serializer registered for the type of the message, or defaultID if there is none
+func serializerIDFor(message interface{}, defaultID int32) int32 {
+	if id, ok := serializerIDByType[reflect.TypeOf(message)]; ok {
+		return id
+	}
+	return defaultID
 }
 
 type Serializer interface {
@@ -24,11 +61,29 @@ type Serializer interface {
 }
 
 func Serialize(message interface{}, serializerID int32) ([]byte, string, error) {
-	res, err := serializers[serializerID].Serialize(message)
-	typeName, err := serializers[serializerID].GetTypeName(message)
+	serializer, err := getSerializer(serializerID)
+	if err != nil {
+		return nil, "", err
+	}
+	res, err := serializer.Serialize(message)
+	if err != nil {
+		return nil, "", err
+	}
+	typeName, err := serializer.GetTypeName(message)
 	return res, typeName, err
 }
 
 func Deserialize(message []byte, typeName string, serializerID int32) (interface{}, error) {
-	return serializers[serializerID].Deserialize(typeName, message)
+	serializer, err := getSerializer(serializerID)
+	if err != nil {
+		return nil, err
+	}
+	return serializer.Deserialize(typeName, message)
+}
+
+func getSerializer(serializerID int32) (Serializer, error) {
+	if serializerID < 0 || int(serializerID) >= len(serializers) {
+		return nil, fmt.Errorf("unknown serializer id %v", serializerID)
+	}
+	return serializers[serializerID], nil
 }
@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/AsynkronIT/protoactor-go/actor"
@@ -43,3 +44,71 @@ func TestJsonSerializer_Serialize_PID(t *testing.T) {
 	assert.Equal(t, "actor.PID", typeName)
 	assert.Equal(t, m, typed)
 }
+
+type plainMessage struct {
+	Name  string
+	Count int
+	Tags  []string
+}
+
+type plainValueMessage struct {
+	Name string
+}
+
+func init() {
+	RegisterMessageType(&plainMessage{})
+	RegisterMessageTypeWithName("test.PlainValue", plainValueMessage{})
+}
+
+func TestMsgPackSerializer_round_trip(t *testing.T) {
+	m := &plainMessage{Name: "abc", Count: 3, Tags: []string{"a", "b"}}
+	b, typeName, err := Serialize(m, MsgPackSerializerID)
+	assert.NoError(t, err)
+	assert.Equal(t, "remote.plainMessage", typeName)
+
+	res, err := Deserialize(b, typeName, MsgPackSerializerID)
+	assert.NoError(t, err)
+	assert.Equal(t, m, res)
+}
+
+func TestMsgPackSerializer_value_type_round_trip(t *testing.T) {
+	m := plainValueMessage{Name: "abc"}
+	b, typeName, err := Serialize(m, MsgPackSerializerID)
+	assert.NoError(t, err)
+	assert.Equal(t, "test.PlainValue", typeName)
+
+	res, err := Deserialize(b, typeName, MsgPackSerializerID)
+	assert.NoError(t, err)
+	assert.Equal(t, m, res)
+}
+
+func TestMsgPackSerializer_unregistered_type(t *testing.T) {
+	_, _, err := Serialize(&actor.PID{}, MsgPackSerializerID)
+	assert.Error(t, err)
+}
+
+func TestJsonSerializer_registered_type_round_trip(t *testing.T) {
+	m := &plainMessage{Name: "abc", Count: 3}
+	b, typeName, err := Serialize(m, JsonSerializerID)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Name":"abc","Count":3,"Tags":null}`, string(b))
+
+	res, err := Deserialize(b, typeName, JsonSerializerID)
+	assert.NoError(t, err)
+	assert.Equal(t, m, res)
+}
+
+func TestSerialize_unknown_serializer(t *testing.T) {
+	_, _, err := Serialize(&actor.PID{}, 42)
+	assert.Error(t, err)
+	_, err = Deserialize([]byte{}, "actor.PID", -1)
+	assert.Error(t, err)
+}
+
+func TestSerializerIDFor(t *testing.T) {
+	RegisterSerializerForType(&plainMessage{}, MsgPackSerializerID)
+	defer delete(serializerIDByType, reflect.TypeOf(&plainMessage{}))
+
+	assert.Equal(t, MsgPackSerializerID, serializerIDFor(&plainMessage{}, ProtoSerializerID))
+	assert.Equal(t, ProtoSerializerID, serializerIDFor(&actor.PID{}, ProtoSerializerID))
+}
@@ -8,12 +8,11 @@ import (
 
 	"github.com/AsynkronIT/protoactor-go/actor"
 	"github.com/AsynkronIT/protoactor-go/log"
-	"google.golang.org/grpc"
 	"google.golang.org/grpc/grpclog"
 )
 
 var (
-	s         *grpc.Server
+	s         Transport
 	edpReader *endpointReader
 )
 
@@ -33,22 +32,52 @@ func Start(address string, options ...RemotingOption) {
 		option(config)
 	}
 
-	if config.advertisedAddress != "" {
-		address = config.advertisedAddress
-	} else {
-		address = lis.Addr().String()
-	}
+	address = resolveAdvertisedAddress(config.advertisedAddress, lis.Addr())
 	actor.ProcessRegistry.RegisterAddressResolver(remoteHandler)
 	actor.ProcessRegistry.Address = address
 
 	spawnActivatorActor()
 	startEndpointManager(config)
 
-	s = grpc.NewServer(config.serverOptions...)
-	edpReader = &endpointReader{}
-	RegisterRemotingServer(s, edpReader)
+	if config.transport == nil {
+		config.transport = newGrpcTransport(config)
+	}
+	s = config.transport
+	edpReader = &endpointReader{
+		statistics:         config.endpointStatistics,
+		authenticator:      config.authenticator,
+		envelopeAuthorizer: config.envelopeAuthorizer,
+		chunkBufferSize:    config.chunkBufferSize,
+		chunkBufferExpiry:  config.chunkBufferExpiry,
+	}
 	plog.Info("Starting Proto.Actor server", log.String("address", address))
-	go s.Serve(lis)
+	go s.Serve(lis, edpReader)
+}
+
+// resolveAdvertisedAddress returns the address other nodes use to reach this node.
+// The advertised address may omit the port or use port 0, in which case the bound port is used.
+func resolveAdvertisedAddress(advertisedAddress string, bound net.Addr) string {
+	boundAddress := bound.String()
+	if advertisedAddress == "" {
+		if host, _, err := net.SplitHostPort(boundAddress); err == nil {
+			if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+				plog.Info("Remoting is bound to an unspecified address and no advertised address is configured, other nodes may not be able to reach this node", log.String("address", boundAddress))
+			}
+		}
+		return boundAddress
+	}
+
+	host, port, err := net.SplitHostPort(advertisedAddress)
+	if err != nil {
+		// no port given
+		host, port = advertisedAddress, "0"
+	}
+	if port == "0" || port == "" {
+		if _, boundPort, err := net.SplitHostPort(boundAddress); err == nil {
+			port = boundPort
+		}
+	}
+	return net.JoinHostPort(host, port)
 }
 
 func Shutdown(graceful bool) {
@@ -62,7 +91,7 @@ func Shutdown(graceful bool) {
 		// TODO: grpc not stopping
 		c := make(chan bool, 1)
 		go func() {
-			s.GracefulStop()
+			s.Stop(true)
 			c <- true
 		}()
 
@@ -70,11 +99,11 @@ func Shutdown(graceful bool) {
 		case <-c:
 			plog.Info("Stopped Proto.Actor server")
 		case <-time.After(time.Second * 10):
-			s.Stop()
+			s.Stop(false)
 			plog.Info("Stopped Proto.Actor server", log.String("err", "timeout"))
 		}
 	} else {
-		s.Stop()
+		s.Stop(false)
 		plog.Info("Killed Proto.Actor server")
 	}
 }
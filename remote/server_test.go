@@ -1,6 +1,7 @@
 package remote
 
 import (
+	"fmt"
 	"net"
 	"sync"
 	"testing"
@@ -10,7 +11,6 @@ import (
 	"github.com/AsynkronIT/protoactor-go/eventstream"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
-	"google.golang.org/grpc"
 )
 
 type ServerTestSuite struct {
@@ -34,7 +34,7 @@ func (suite *ServerTestSuite) SetupTest() {
 
 func (suite *ServerTestSuite) TearDownTest() {
 	if s != nil {
-		s.Stop() // Stop currently running gRPC server
+		s.Stop(false) // Stop currently running server
 	}
 
 	// Reset package scoped variables so those tests run after this test suite won't be affected.
@@ -98,6 +98,30 @@ func (suite *ServerTestSuite) TestStart_AdvertisedAddress() {
 	suite.NotNil(s, "gRPC server should be started on server start")
 }
 
+func (suite *ServerTestSuite) TestStart_AdvertisedHostWithBoundPort() {
+	// Find available port
+	lis, err := net.Listen("tcp", "127.0.0.1:0") // use :0 to choose available port
+	if err != nil {
+		panic(err)
+	}
+	address := lis.Addr().(*net.TCPAddr)
+	lis.Close()
+
+	Start(address.String(), WithAdvertisedAddress("192.0.2.1"))
+
+	suite.Equal(fmt.Sprintf("192.0.2.1:%v", address.Port), actor.ProcessRegistry.Address, "Bound port should be advertised when no port is given")
+}
+
+func (suite *ServerTestSuite) TestResolveAdvertisedAddress() {
+	bound := &net.TCPAddr{IP: net.IPv4zero, Port: 8080}
+
+	suite.Equal("0.0.0.0:8080", resolveAdvertisedAddress("", bound))
+	suite.Equal("node1.example:9090", resolveAdvertisedAddress("node1.example:9090", bound))
+	suite.Equal("node1.example:8080", resolveAdvertisedAddress("node1.example", bound))
+	suite.Equal("node1.example:8080", resolveAdvertisedAddress("node1.example:0", bound))
+	suite.Equal("[2001:db8::1]:8080", resolveAdvertisedAddress("[2001:db8::1]:0", bound))
+}
+
 func (suite *ServerTestSuite) TestShutdown_Graceful() {
 	edpReader = &endpointReader{}
 	suite.False(edpReader.suspended, "EndpointReader should not be suspended at beginning")
@@ -155,9 +179,9 @@ func (suite *ServerTestSuite) TestShutdown_Graceful() {
 	defer lis.Close()
 
 	grpcStopped := make(chan struct{}, 1)
-	s = grpc.NewServer()
+	s = newGrpcTransport(defaultRemoteConfig())
 	go func() {
-		s.Serve(lis)
+		s.Serve(lis, edpReader)
 		grpcStopped <- struct{}{}
 	}()
 
@@ -201,9 +225,9 @@ func (suite *ServerTestSuite) TestShutdown() {
 	defer lis.Close()
 
 	grpcStopped := make(chan struct{}, 1)
-	s = grpc.NewServer()
+	s = newGrpcTransport(defaultRemoteConfig())
 	go func() {
-		s.Serve(lis)
+		s.Serve(lis, edpReader)
 		grpcStopped <- struct{}{}
 	}()
 
@@ -0,0 +1,121 @@
+package remote
+
+import (
+	"net"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// Transport carries the message batches between the endpoints of two nodes.
+// The default transport uses gRPC, see WithTransport to use another one
+type Transport interface {
+	// Serve accepts connections on lis and hands them to handler, it blocks until the transport is stopped
+	Serve(lis net.Listener, handler TransportHandler) error
+	// Stop stops accepting connections and closes the open ones.
+	// A graceful stop lets the open connections finish what they are doing
+	Stop(graceful bool)
+	// Dial connects to the node at address. The context carries the credentials as outgoing gRPC metadata,
+	// the returned response is the one of the remote node's TransportHandler
+	Dial(ctx context.Context, address string) (TransportConnection, *ConnectResponse, error)
+}
+
+// TransportHandler processes the connections accepted by a Transport
+type TransportHandler interface {
+	// Connect is invoked when a node connects, the context carries the peer and its metadata as incoming gRPC metadata
+	Connect(ctx context.Context, req *ConnectRequest) (*ConnectResponse, error)
+	// ReceiveBatches reads batches using recv until it returns an error, it blocks for the lifetime of the connection
+	ReceiveBatches(ctx context.Context, recv func() (*MessageBatch, error)) error
+}
+
+// TransportConnection is an outgoing connection to another node
+type TransportConnection interface {
+	// Send sends a batch to the remote node
+	Send(batch *MessageBatch) error
+	// Wait blocks until the connection is lost and returns the cause
+	Wait() error
+	// Close closes the connection
+	Close() error
+}
+
+// WithTransport replaces the gRPC transport.
+// The gRPC specific options, such as WithDialOptions or WithCompression, only apply to the gRPC transport
+func WithTransport(transport Transport) RemotingOption {
+	return func(config *remoteConfig) {
+		config.transport = transport
+	}
+}
+
+type grpcTransport struct {
+	config *remoteConfig
+	server *grpc.Server
+}
+
+func newGrpcTransport(config *remoteConfig) *grpcTransport {
+	return &grpcTransport{
+		config: config,
+		server: grpc.NewServer(config.serverOptionsWithTuning()...),
+	}
+}
+
+func (t *grpcTransport) Serve(lis net.Listener, handler TransportHandler) error {
+	RegisterRemotingServer(t.server, &grpcRemotingServer{handler: handler})
+	return t.server.Serve(lis)
+}
+
+func (t *grpcTransport) Stop(graceful bool) {
+	if graceful {
+		t.server.GracefulStop()
+	} else {
+		t.server.Stop()
+	}
+}
+
+func (t *grpcTransport) Dial(ctx context.Context, address string) (TransportConnection, *ConnectResponse, error) {
+	conn, err := grpc.Dial(address, t.config.dialOptionsWithTuning()...)
+	if err != nil {
+		return nil, nil, err
+	}
+	c := NewRemotingClient(conn)
+	resp, err := c.Connect(ctx, &ConnectRequest{})
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	stream, err := c.Receive(ctx, t.config.callOptionsWithTuning()...)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	return &grpcConnection{conn: conn, stream: stream}, resp, nil
+}
+
+type grpcRemotingServer struct {
+	handler TransportHandler
+}
+
+func (s *grpcRemotingServer) Connect(ctx context.Context, req *ConnectRequest) (*ConnectResponse, error) {
+	return s.handler.Connect(ctx, req)
+}
+
+func (s *grpcRemotingServer) Receive(stream Remoting_ReceiveServer) error {
+	return s.handler.ReceiveBatches(stream.Context(), stream.Recv)
+}
+
+type grpcConnection struct {
+	conn   *grpc.ClientConn
+	stream Remoting_ReceiveClient
+}
+
+func (c *grpcConnection) Send(batch *MessageBatch) error {
+	return c.stream.Send(batch)
+}
+
+func (c *grpcConnection) Wait() error {
+	_, err := c.stream.Recv()
+	return err
+}
+
+func (c *grpcConnection) Close() error {
+	return c.conn.Close()
+}
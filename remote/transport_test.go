@@ -0,0 +1,99 @@
+package remote
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type testTransportHandler struct {
+	batches chan *MessageBatch
+	peers   chan *PeerInfo
+}
+
+func (h *testTransportHandler) Connect(ctx context.Context, req *ConnectRequest) (*ConnectResponse, error) {
+	peer := peerInfoFromContext(ctx)
+	if err := BearerTokenAuthenticator("secret")(peer); err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return &ConnectResponse{DefaultSerializerId: JsonSerializerID}, nil
+}
+
+func (h *testTransportHandler) ReceiveBatches(ctx context.Context, recv func() (*MessageBatch, error)) error {
+	h.peers <- peerInfoFromContext(ctx)
+	for {
+		batch, err := recv()
+		if err != nil {
+			return err
+		}
+		h.batches <- batch
+	}
+}
+
+func TestTransports(t *testing.T) {
+	transports := map[string]func() Transport{
+		"grpc":      func() Transport { return newGrpcTransport(defaultRemoteConfig()) },
+		"websocket": func() Transport { return NewWebSocketTransport(nil) },
+	}
+
+	for name, newTransport := range transports {
+		t.Run(name, func(t *testing.T) {
+			lis, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				panic(err)
+			}
+			transport := newTransport()
+			handler := &testTransportHandler{
+				batches: make(chan *MessageBatch, 1),
+				peers:   make(chan *PeerInfo, 1),
+			}
+			go transport.Serve(lis, handler)
+			defer transport.Stop(false)
+			address := lis.Addr().String()
+
+			_, _, err = transport.Dial(context.Background(), address)
+			assert.Error(t, err, "connection without credentials should be rejected")
+
+			ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("authorization", "Bearer secret"))
+			conn, resp, err := transport.Dial(ctx, address)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, JsonSerializerID, resp.DefaultSerializerId)
+
+			batch := &MessageBatch{TypeNames: []string{"remote.Unit"}, TargetNames: []string{"target"}}
+			assert.NoError(t, conn.Send(batch))
+
+			select {
+			case peer := <-handler.peers:
+				assert.Equal(t, []string{"Bearer secret"}, peer.Metadata.Get("authorization"))
+				assert.NotEmpty(t, peer.Address)
+			case <-time.After(5 * time.Second):
+				t.Fatal("connection was not handled")
+			}
+			select {
+			case received := <-handler.batches:
+				assert.Equal(t, batch, received)
+			case <-time.After(5 * time.Second):
+				t.Fatal("batch was not received")
+			}
+
+			lost := make(chan error, 1)
+			go func() { lost <- conn.Wait() }()
+			transport.Stop(false)
+			select {
+			case err := <-lost:
+				assert.Error(t, err)
+			case <-time.After(5 * time.Second):
+				t.Fatal("connection loss was not detected")
+			}
+			conn.Close()
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package remote
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const webSocketPath = "/protoactor"
+
+type webSocketTransport struct {
+	tlsConfig *tls.Config
+	server    *http.Server
+	upgrader  websocket.Upgrader
+	dialer    websocket.Dialer
+	mu        sync.Mutex
+	conns     map[*websocket.Conn]struct{}
+}
+
+// NewWebSocketTransport creates a transport carrying the endpoint streams over WebSocket connections,
+// which pass through HTTP/1.1 proxies and load balancers where gRPC does not.
+// The connections use TLS if tlsConfig is not nil, all nodes need to use the same transport
+func NewWebSocketTransport(tlsConfig *tls.Config) Transport {
+	return &webSocketTransport{
+		tlsConfig: tlsConfig,
+		server:    &http.Server{},
+		dialer: websocket.Dialer{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		},
+		conns: make(map[*websocket.Conn]struct{}),
+	}
+}
+
+func (t *webSocketTransport) Serve(lis net.Listener, handler TransportHandler) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc(webSocketPath, func(w http.ResponseWriter, r *http.Request) {
+		t.serveConnection(w, r, handler)
+	})
+	t.server.Handler = mux
+	if t.tlsConfig != nil {
+		lis = tls.NewListener(lis, t.tlsConfig)
+	}
+	err := t.server.Serve(lis)
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
+
+func (t *webSocketTransport) serveConnection(w http.ResponseWriter, r *http.Request, handler TransportHandler) {
+	md := metadata.MD{}
+	for key, values := range r.Header {
+		md[strings.ToLower(key)] = values
+	}
+	ctx := peer.NewContext(r.Context(), &peer.Peer{Addr: webSocketAddr(r.RemoteAddr)})
+	ctx = metadata.NewIncomingContext(ctx, md)
+
+	resp, err := handler.Connect(ctx, &ConnectRequest{})
+	if err != nil {
+		code := http.StatusServiceUnavailable
+		if status.Code(err) == codes.Unauthenticated {
+			code = http.StatusUnauthorized
+		}
+		http.Error(w, status.Convert(err).Message(), code)
+		return
+	}
+
+	// Upgrade replies with an error itself
+	conn, err := t.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	t.track(conn, true)
+	defer t.track(conn, false)
+	defer conn.Close()
+
+	data, err := resp.Marshal()
+	if err != nil {
+		return
+	}
+	if err := conn.WriteMessage(websocket.BinaryMessage, data); err != nil {
+		return
+	}
+
+	handler.ReceiveBatches(ctx, func() (*MessageBatch, error) {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return nil, err
+		}
+		batch := &MessageBatch{}
+		if err := batch.Unmarshal(data); err != nil {
+			return nil, err
+		}
+		return batch, nil
+	})
+}
+
+func (t *webSocketTransport) track(conn *websocket.Conn, open bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if open {
+		t.conns[conn] = struct{}{}
+	} else {
+		delete(t.conns, conn)
+	}
+}
+
+func (t *webSocketTransport) Stop(graceful bool) {
+	if graceful {
+		t.server.Shutdown(context.Background())
+	} else {
+		t.server.Close()
+	}
+
+	// the connections are hijacked from the http server, which does not close them
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for conn := range t.conns {
+		conn.Close()
+	}
+}
+
+func (t *webSocketTransport) Dial(ctx context.Context, address string) (TransportConnection, *ConnectResponse, error) {
+	scheme := "ws"
+	if t.tlsConfig != nil {
+		scheme = "wss"
+	}
+	header := http.Header{}
+	if md, ok := metadata.FromOutgoingContext(ctx); ok {
+		for key, values := range md {
+			for _, value := range values {
+				header.Add(key, value)
+			}
+		}
+	}
+
+	conn, httpResp, err := t.dialer.DialContext(ctx, scheme+"://"+address+webSocketPath, header)
+	if err != nil {
+		if httpResp != nil {
+			return nil, nil, fmt.Errorf("websocket handshake with %v failed with status %v: %w", address, httpResp.Status, err)
+		}
+		return nil, nil, err
+	}
+
+	_, data, err := conn.ReadMessage()
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	resp := &ConnectResponse{}
+	if err := resp.Unmarshal(data); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	return &webSocketConnection{conn: conn}, resp, nil
+}
+
+type webSocketConnection struct {
+	conn *websocket.Conn
+}
+
+func (c *webSocketConnection) Send(batch *MessageBatch) error {
+	data, err := batch.Marshal()
+	if err != nil {
+		return err
+	}
+	return c.conn.WriteMessage(websocket.BinaryMessage, data)
+}
+
+func (c *webSocketConnection) Wait() error {
+	// the server does not send anything after the connect response, reading only detects the connection loss
+	for {
+		if _, _, err := c.conn.ReadMessage(); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *webSocketConnection) Close() error {
+	return c.conn.Close()
+}
+
+type webSocketAddr string
+
+func (a webSocketAddr) Network() string { return "tcp" }
+func (a webSocketAddr) String() string  { return string(a) }
@@ -51,6 +51,11 @@ func (m *mockContext) ReceiveTimeout() time.Duration {
 	return args.Get(0).(time.Duration)
 }
 
+func (m *mockContext) ReceiveTimeoutRemaining() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
 func (m *mockContext) Children() []*actor.PID {
 	args := m.Called()
 	return args.Get(0).([]*actor.PID)
@@ -60,6 +65,10 @@ func (m *mockContext) Respond(response interface{}) {
 	m.Called(response)
 }
 
+func (m *mockContext) RespondErr(err error) {
+	m.Called(err)
+}
+
 func (m *mockContext) Stash() {
 	m.Called()
 }
@@ -84,6 +93,10 @@ func (m *mockContext) Forward(pid *actor.PID) {
 	m.Called()
 }
 
+func (m *mockContext) Unhandled() {
+	m.Called()
+}
+
 func (m *mockContext) AwaitFuture(f *actor.Future, cont func(res interface{}, err error)) {
 	m.Called(f, cont)
 }
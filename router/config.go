@@ -63,8 +63,7 @@ func spawn(id string, config RouterConfig, props *actor.Props, parentContext act
 		return proxy, actor.ErrNameExists
 	}
 
-	var pc = *props
-	pc.WithSpawnFunc(nil)
+	pc := props.WithSpawnFunc(nil)
 	ref.state = config.CreateRouterState()
 
 	if config.RouterType() == GroupRouterType {
@@ -72,7 +71,7 @@ func spawn(id string, config RouterConfig, props *actor.Props, parentContext act
 		wg.Add(1)
 		ref.router, _ = actor.DefaultSpawner(id+"/router", actor.PropsFromProducer(func() actor.Actor {
 			return &groupRouterActor{
-				props:  &pc,
+				props:  pc,
 				config: config,
 				state:  ref.state,
 				wg:     wg,
@@ -84,7 +83,7 @@ func spawn(id string, config RouterConfig, props *actor.Props, parentContext act
 		wg.Add(1)
 		ref.router, _ = actor.DefaultSpawner(id+"/router", actor.PropsFromProducer(func() actor.Actor {
 			return &poolRouterActor{
-				props:  &pc,
+				props:  pc,
 				config: config,
 				state:  ref.state,
 				wg:     wg,
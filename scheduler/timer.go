@@ -20,10 +20,10 @@ const (
 	stateDone
 )
 
-func startTimer(delay, interval time.Duration, fn func()) CancelFunc {
-	var t *time.Timer
+func startTimer(clock actor.Clock, delay, interval time.Duration, fn func()) CancelFunc {
+	var t actor.Timer
 	var state int32
-	t = time.AfterFunc(delay, func() {
+	t = clock.AfterFunc(delay, func() {
 		state := atomic.LoadInt32(&state)
 		for state == stateInit {
 			runtime.Gosched()
@@ -51,7 +51,8 @@ func startTimer(delay, interval time.Duration, fn func()) CancelFunc {
 
 // A scheduler utilizing timers to send messages in the future and at regular intervals.
 type TimerScheduler struct {
-	ctx actor.SenderContext
+	ctx   actor.SenderContext
+	clock actor.Clock
 }
 
 type timerOptionFunc func(*TimerScheduler)
@@ -64,10 +65,18 @@ func WithContext(ctx actor.SenderContext) timerOptionFunc {
 	}
 }
 
+// WithClock configures the scheduler to use clock rather than the default,
+// actor.SystemClock.
+func WithClock(clock actor.Clock) timerOptionFunc {
+	return func(s *TimerScheduler) {
+		s.clock = clock
+	}
+}
+
 // NewTimerScheduler creates a new scheduler using the EmptyRootContext.
 // Additional options may be specified to override the default behavior.
 func NewTimerScheduler(opts ...timerOptionFunc) *TimerScheduler {
-	s := &TimerScheduler{ctx: actor.EmptyRootContext}
+	s := &TimerScheduler{ctx: actor.EmptyRootContext, clock: actor.SystemClock}
 	for _, opt := range opts {
 		opt(s)
 	}
@@ -76,7 +85,7 @@ func NewTimerScheduler(opts ...timerOptionFunc) *TimerScheduler {
 
 // SendOnce waits for the duration to elapse and then calls actor.SenderContext.Send to forward the message to pid.
 func (s *TimerScheduler) SendOnce(delay time.Duration, pid *actor.PID, message interface{}) CancelFunc {
-	t := time.AfterFunc(delay, func() {
+	t := s.clock.AfterFunc(delay, func() {
 		s.ctx.Send(pid, message)
 	})
 
@@ -86,7 +95,7 @@ func (s *TimerScheduler) SendOnce(delay time.Duration, pid *actor.PID, message i
 // SendRepeatedly waits for the initial duration to elapse and then calls Send to forward the message to pid
 // repeatedly for each interval.
 func (s *TimerScheduler) SendRepeatedly(initial, interval time.Duration, pid *actor.PID, message interface{}) CancelFunc {
-	return startTimer(initial, interval, func() {
+	return startTimer(s.clock, initial, interval, func() {
 		s.ctx.Send(pid, message)
 	})
 }
@@ -94,7 +103,7 @@ func (s *TimerScheduler) SendRepeatedly(initial, interval time.Duration, pid *ac
 // RequestOnce waits for the duration to elapse and then calls actor.SenderContext.Request to forward the message to
 // pid.
 func (s *TimerScheduler) RequestOnce(delay time.Duration, pid *actor.PID, message interface{}) CancelFunc {
-	t := time.AfterFunc(delay, func() {
+	t := s.clock.AfterFunc(delay, func() {
 		s.ctx.Request(pid, message)
 	})
 
@@ -104,7 +113,7 @@ func (s *TimerScheduler) RequestOnce(delay time.Duration, pid *actor.PID, messag
 // RequestRepeatedly waits for the initial duration to elapse and then calls Request to forward the message to pid
 // repeatedly for each interval.
 func (s *TimerScheduler) RequestRepeatedly(delay, interval time.Duration, pid *actor.PID, message interface{}) CancelFunc {
-	return startTimer(delay, interval, func() {
+	return startTimer(s.clock, delay, interval, func() {
 		s.ctx.Request(pid, message)
 	})
 }
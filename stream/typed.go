@@ -0,0 +1,220 @@
+package stream
+
+import (
+	"sync"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+)
+
+// OverflowStrategy decides what a stream does with a message when its buffer is full
+type OverflowStrategy int
+
+const (
+	// Block makes the stream actor wait for the consumer, the messages queue up in its mailbox
+	Block OverflowStrategy = iota
+	// DropNewest drops the message received
+	DropNewest
+	// DropOldest drops the oldest buffered message to make room for the message received
+	DropOldest
+)
+
+// Subscribe is sent to a stream by the actor producing its messages, the stream answers with Demand
+type Subscribe struct{}
+
+// Demand is sent by a stream to its subscribed producer, the producer may send Count more messages without
+// overrunning the buffer of the stream
+type Demand struct {
+	Count int
+}
+
+// Complete is sent to a stream to complete it, the consumer receives the buffered messages before the channel
+// is closed. Err is returned by TypedStream.Err
+type Complete struct {
+	Err error
+}
+
+// granted is sent by the pump to the stream actor for every message taken by the consumer
+type granted struct{}
+
+type typedConfig struct {
+	bufferSize int
+	overflow   OverflowStrategy
+}
+
+// TypedOption configures a TypedStream
+type TypedOption func(config *typedConfig)
+
+// WithBuffer sets the number of messages buffered for the consumer, the default is 1
+func WithBuffer(size int) TypedOption {
+	return func(config *typedConfig) {
+		config.bufferSize = size
+	}
+}
+
+// WithOverflow sets what the stream does when a producer overruns its buffer, the default is Block
+func WithOverflow(strategy OverflowStrategy) TypedOption {
+	return func(config *typedConfig) {
+		config.overflow = strategy
+	}
+}
+
+// TypedStream passes the messages of type T sent to its PID to a Go channel, the other messages are ignored.
+//
+// A producer sending Subscribe to the stream receives Demand messages telling how many messages it may send,
+// so it cannot overrun a slow consumer. Other producers are handled by the overflow strategy
+type TypedStream[T any] struct {
+	pid       *actor.PID
+	buffer    chan T
+	c         chan T
+	closing   chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+	bufferMu  sync.Mutex
+	closed    bool
+	mu        sync.Mutex
+	err       error
+}
+
+// NewTypedStream spawns a stream of the messages of type T
+func NewTypedStream[T any](options ...TypedOption) *TypedStream[T] {
+	config := &typedConfig{bufferSize: 1}
+	for _, option := range options {
+		option(config)
+	}
+
+	s := &TypedStream[T]{
+		buffer:  make(chan T, config.bufferSize),
+		c:       make(chan T),
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	s.pid = actor.EmptyRootContext.Spawn(actor.PropsFromProducer(func() actor.Actor {
+		return &typedStreamActor[T]{stream: s, overflow: config.overflow}
+	}))
+	go s.pump()
+	return s
+}
+
+// C returns the channel of the messages, it is closed when the stream completes or is closed
+func (s *TypedStream[T]) C() <-chan T {
+	return s.c
+}
+
+// PID returns the PID the messages are sent to
+func (s *TypedStream[T]) PID() *actor.PID {
+	return s.pid
+}
+
+// Done is closed when the stream completed and the consumer received the buffered messages, or when it is closed
+func (s *TypedStream[T]) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns the error the stream was completed with
+func (s *TypedStream[T]) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Drain completes the stream, the consumer receives the buffered messages before the channel is closed
+func (s *TypedStream[T]) Drain() {
+	actor.EmptyRootContext.Send(s.pid, &Complete{})
+}
+
+// Close stops the stream and closes the channel, the buffered messages are discarded.
+// Close the streams when done with them, completed streams included
+func (s *TypedStream[T]) Close() {
+	s.closeOnce.Do(func() {
+		close(s.closing)
+	})
+	actor.EmptyRootContext.StopFuture(s.pid).Wait()
+	s.closeBuffer()
+	<-s.done
+}
+
+func (s *TypedStream[T]) closeBuffer() {
+	s.bufferMu.Lock()
+	defer s.bufferMu.Unlock()
+	if !s.closed {
+		s.closed = true
+		close(s.buffer)
+	}
+}
+
+// pump passes the buffered messages to the consumer and grants demand for the messages taken
+func (s *TypedStream[T]) pump() {
+	defer close(s.done)
+	defer close(s.c)
+	for msg := range s.buffer {
+		select {
+		case s.c <- msg:
+			actor.EmptyRootContext.Send(s.pid, &granted{})
+		case <-s.closing:
+			return
+		}
+	}
+}
+
+type typedStreamActor[T any] struct {
+	stream    *TypedStream[T]
+	overflow  OverflowStrategy
+	producer  *actor.PID
+	completed bool
+}
+
+func (state *typedStreamActor[T]) Receive(ctx actor.Context) {
+	switch msg := ctx.Message().(type) {
+	case *Subscribe:
+		state.producer = ctx.Sender()
+		ctx.Send(state.producer, &Demand{Count: cap(state.stream.buffer) - len(state.stream.buffer)})
+	case *granted:
+		if state.producer != nil && !state.completed {
+			ctx.Send(state.producer, &Demand{Count: 1})
+		}
+	case *Complete:
+		if !state.completed {
+			state.completed = true
+			state.stream.mu.Lock()
+			state.stream.err = msg.Err
+			state.stream.mu.Unlock()
+			state.stream.closeBuffer()
+		}
+	case *actor.Stopped:
+		state.stream.closeBuffer()
+	case actor.AutoReceiveMessage, actor.SystemMessage:
+	// ignore terminate
+	case T:
+		if !state.completed {
+			state.push(msg)
+		}
+	}
+}
+
+func (state *typedStreamActor[T]) push(msg T) {
+	buffer := state.stream.buffer
+	switch state.overflow {
+	case DropNewest:
+		select {
+		case buffer <- msg:
+		default:
+		}
+	case DropOldest:
+		for {
+			select {
+			case buffer <- msg:
+				return
+			default:
+			}
+			select {
+			case <-buffer:
+			default:
+			}
+		}
+	default:
+		select {
+		case buffer <- msg:
+		case <-state.stream.closing:
+		}
+	}
+}
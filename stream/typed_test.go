@@ -0,0 +1,92 @@
+package stream
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/stretchr/testify/assert"
+)
+
+var rootContext = actor.EmptyRootContext
+
+func TestTypedStream_ReceivesMessagesOfType(t *testing.T) {
+	s := NewTypedStream[string]()
+	defer s.Close()
+
+	rootContext.Send(s.PID(), 1)
+	rootContext.Send(s.PID(), "hello")
+	assert.Equal(t, "hello", <-s.C())
+}
+
+func TestTypedStream_DrainDeliversBufferedMessages(t *testing.T) {
+	s := NewTypedStream[int](WithBuffer(10))
+	defer s.Close()
+
+	for i := 0; i < 5; i++ {
+		rootContext.Send(s.PID(), i)
+	}
+	rootContext.Send(s.PID(), &Complete{Err: errors.New("done")})
+	rootContext.Send(s.PID(), 5)
+
+	var received []int
+	for i := range s.C() {
+		received = append(received, i)
+	}
+	<-s.Done()
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, received)
+	assert.EqualError(t, s.Err(), "done")
+}
+
+func TestTypedStream_DropNewest(t *testing.T) {
+	s := NewTypedStream[int](WithBuffer(2), WithOverflow(DropNewest))
+	defer s.Close()
+
+	// the pump takes the first message and waits for the consumer, the buffer holds the next two
+	for i := 0; i < 10; i++ {
+		rootContext.Send(s.PID(), i)
+	}
+	s.Drain()
+
+	var received []int
+	for i := range s.C() {
+		received = append(received, i)
+	}
+	assert.True(t, len(received) <= 3, "received %v", received)
+	assert.Equal(t, 0, received[0])
+}
+
+func TestTypedStream_DemandPreventsOverrun(t *testing.T) {
+	s := NewTypedStream[int](WithBuffer(3), WithOverflow(DropNewest))
+	defer s.Close()
+
+	// the producer sends as many messages as demanded, so none of them is dropped
+	const total = 50
+	next := 0
+	producer := rootContext.Spawn(actor.PropsFromFunc(func(ctx actor.Context) {
+		switch msg := ctx.Message().(type) {
+		case *actor.Started:
+			ctx.Request(s.PID(), &Subscribe{})
+		case *Demand:
+			for i := 0; i < msg.Count && next < total; i++ {
+				ctx.Send(s.PID(), next)
+				next++
+				if next == total {
+					ctx.Send(s.PID(), &Complete{})
+				}
+			}
+		}
+	}))
+	defer rootContext.Stop(producer)
+
+	var received []int
+	for i := range s.C() {
+		time.Sleep(time.Millisecond)
+		received = append(received, i)
+	}
+	assert.Len(t, received, total)
+	for i, v := range received {
+		assert.Equal(t, i, v)
+	}
+}